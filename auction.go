@@ -4,7 +4,11 @@
 package auction
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"auction-bidding-algorithm/internal"
 	"auction-bidding-algorithm/internal/models"
@@ -21,50 +25,159 @@ type BiddingEngine interface {
 	ProcessBids(bidders []models.Bidder) (*models.BidResult, error)
 }
 
+// ContextBiddingEngine is a BiddingEngine that can be cancelled through a context
+// The service hands its context to engines that implement it; other engines are only checked
+// for cancellation before and after they run
+type ContextBiddingEngine interface {
+	BiddingEngine
+	ProcessBidsContext(ctx context.Context, bidders []models.Bidder) (*models.BidResult, error)
+}
+
+// Format settles bids under a set of auction rules other than English proxy bidding, such as sealed bids
+// Implement it to plug in a custom format; the built-in engine prepares the bids and records the format's name
+type Format = internal.Format
+
+// NewFirstPriceSealedFormat creates the first-price sealed-bid format: every bidder submits MaxBid as a single
+// sealed bid, and the highest bid wins and pays its own amount
+func NewFirstPriceSealedFormat() Format {
+	return internal.NewFirstPriceSealedFormat()
+}
+
+// NewVickreyFormat creates the sealed second-price format: the highest bid wins and pays the second-highest
+// bid, or one tick above it under models.SecondPricePlusTick, but never less than the reserve
+func NewVickreyFormat(rule models.SecondPriceRule) (Format, error) {
+	return internal.NewVickreyFormat(rule)
+}
+
+// ResolutionStrategy selects how the bidding engine settles proxy bids
+type ResolutionStrategy = internal.ResolutionStrategy
+
+const (
+	// StrategyIterative increments losing bidders round by round, bounded by the round limit
+	StrategyIterative = internal.StrategyIterative
+	// StrategyClosedForm computes the settled bids directly, independent of increment size; results differ from StrategyIterative only in BiddingRounds and the audit trail
+	StrategyClosedForm = internal.StrategyClosedForm
+)
+
 // AuctionService orchestrates the entire auction process including validation and bid processing
 type AuctionService struct {
 	validator validation.BidValidator
+	rules     *validation.RuleSet // Configurable rules run on top of the default validator (optional)
+	format    Format              // Auction rules for the built-in engine (optional, English proxy bidding when nil)
 	engine    BiddingEngine
+	config    models.AuctionConfig // Lot-level settings, also consulted by live auctions
+	clock     models.Clock         // Source of the current time for the engine and live auctions
+	logger    *slog.Logger         // Receives validation, processing and observer failures (optional)
+
+	observers      []AuctionObserver    // Notified of validation failures, bidding progress and results
+	observerErrors ObserverErrorHandler // Receives observer errors and panics (optional)
+
+	engineOptions []internal.EngineOption // Settings for the built-in engine, unused when a custom engine is given
+	quiet         *AuctionService         // Copy without observers, for provisional settlement of live auctions
 }
 
-// NewAuctionService creates a new AuctionService with default validator and engine
-func NewAuctionService() *AuctionService {
-	return &AuctionService{
-		validator: validation.NewBidValidator(),
-		engine:    internal.NewBiddingEngine(),
+// NewAuctionService creates a new AuctionService with default validator and engine, configured by the given options
+func NewAuctionService(opts ...ServiceOption) *AuctionService {
+	service := &AuctionService{}
+	for _, opt := range opts {
+		opt(service)
+	}
+	if service.format != nil {
+		service.config.Format = service.format.Name()
+		service.engineOptions = append(service.engineOptions, internal.WithFormat(service.format))
+	}
+
+	if service.validator == nil {
+		service.validator = validation.NewBidValidatorWithConfig(service.config)
+		if service.rules != nil {
+			service.validator = validation.NewRuleValidator(service.validator, service.rules.WithClock(service.Clock()))
+		}
+	}
+	quiet := *service
+	quiet.observers = nil
+	if service.engine == nil {
+		engineOptions := []internal.EngineOption{internal.WithConfig(service.config), internal.WithClock(service.clock)}
+		if service.logger != nil {
+			engineOptions = append(engineOptions, internal.WithLogger(service.logger))
+		}
+		quiet.engine = internal.NewBiddingEngine(append(engineOptions, service.engineOptions...)...)
+		service.engine = quiet.engine
+		if len(service.observers) > 0 {
+			engineOptions = append(engineOptions, internal.WithObserver(engineEvents{service: service}))
+			service.engine = internal.NewBiddingEngine(append(engineOptions, service.engineOptions...)...)
+		}
 	}
+	service.quiet = &quiet
+	return service
+}
+
+// Config returns the auction configuration the service was created with
+func (as *AuctionService) Config() models.AuctionConfig {
+	return as.config
+}
+
+// Clock returns the clock the service reads the current time from (the wall clock unless one was given)
+func (as *AuctionService) Clock() models.Clock {
+	if as.clock == nil {
+		return models.NewSystemClock()
+	}
+	return as.clock
 }
 
 // DetermineWinner validates inputs and processes bids to determine the auction winner
 // This method implements the main orchestration logic for the auction process
+// Bidders without a sequence number are numbered at ingestion in slice order, after the highest sequence
+// already given, so the same bidders are numbered the same way on every call; the caller's slice is not modified
 func (as *AuctionService) DetermineWinner(bidders []models.Bidder) (*models.BidResult, error) {
+	return as.DetermineWinnerContext(context.Background(), bidders)
+}
+
+// DetermineWinnerContext determines the auction winner like DetermineWinner, stopping when the context ends
+// A passed deadline fails with a TimeoutError cause and a cancellation with a ProcessingError cause. The
+// context's request fields, such as a trace ID set with models.WithTraceID, are added to the error's context.
+func (as *AuctionService) DetermineWinnerContext(ctx context.Context, bidders []models.Bidder) (*models.BidResult, error) {
+	result, err := as.determineWinner(ctx, bidders)
+	if err != nil {
+		return nil, models.AddRequestContext(ctx, err)
+	}
+	return result, nil
+}
+
+// determineWinner settles the bidders for DetermineWinnerContext and reports the result to observers
+func (as *AuctionService) determineWinner(ctx context.Context, bidders []models.Bidder) (*models.BidResult, error) {
+	result, err := as.settle(ctx, bidders)
+	if err != nil {
+		return nil, err
+	}
+	as.announce(result)
+	return result, nil
+}
+
+// settle runs the validation and processing pipeline without reporting the result to observers
+func (as *AuctionService) settle(ctx context.Context, bidders []models.Bidder) (*models.BidResult, error) {
+	started := time.Now()
+	bidders = as.ingest(bidders)
+
 	// Validate all bidders first (Requirement 1.1)
-	if err := as.validator.ValidateBidders(bidders); err != nil {
-		// Wrap validation error with additional context
-		if auctionErr, ok := err.(*models.AuctionError); ok {
-			auctionErr.WithOperation("DetermineWinner.Validation")
-			auctionErr.AddContext("service", "AuctionService")
-			return nil, auctionErr
-		}
-		// Handle unexpected error types
-		wrappedErr := models.NewAuctionErrorWithCause(models.ErrorTypeValidation, "unexpected validation error", err)
-		wrappedErr.WithOperation("DetermineWinner.Validation")
-		wrappedErr.AddContext("service", "AuctionService")
-		return nil, wrappedErr
+	warnings, err := as.checkBidders(bidders, "DetermineWinner.Validation")
+	if err != nil {
+		return nil, err
 	}
 
 	// Process the bids using the bidding engine (Requirement 1.2)
-	result, err := as.engine.ProcessBids(bidders)
+	result, err := as.processBids(ctx, started, bidders)
 	if err != nil {
-		// Wrap processing error with additional context
-		if auctionErr, ok := err.(*models.AuctionError); ok {
+		as.warn("bid processing failed", "bidders", len(bidders), "error", err)
+		// Annotate auction errors in place so timeouts stay *models.TimeoutError for errors.As
+		var auctionErr *models.AuctionError
+		if errors.As(err, &auctionErr) {
 			auctionErr.WithOperation("DetermineWinner.Processing")
 			auctionErr.AddContext("service", "AuctionService")
-			return nil, auctionErr
+			return nil, err
 		}
 		// Handle unexpected error types
 		wrappedErr := models.NewAuctionErrorWithCause(models.ErrorTypeProcessing, "unexpected processing error", err)
-		wrappedErr.WithOperation("DetermineWinner.Processing")
+		wrappedErr.WithOperation("DetermineWinner.Processing").WithCode(models.CodeServiceUnexpectedError)
 		wrappedErr.AddContext("service", "AuctionService")
 		return nil, wrappedErr
 	}
@@ -72,11 +185,96 @@ func (as *AuctionService) DetermineWinner(bidders []models.Bidder) (*models.BidR
 	// Ensure proper result formatting (Requirement 1.3)
 	if result == nil {
 		processingErr := models.NewAuctionError(models.ErrorTypeProcessing, "failed to process bids: result is nil", nil)
-		processingErr.WithOperation("DetermineWinner.ResultValidation")
+		processingErr.WithOperation("DetermineWinner.ResultValidation").WithCode(models.CodeServiceNilResult)
 		processingErr.AddContext("service", "AuctionService")
 		processingErr.AddContext("bidder_count", fmt.Sprintf("%d", len(bidders)))
 		return nil, processingErr
 	}
 
+	if len(warnings) > 0 {
+		result.Warnings = warnings
+	}
+	return result, nil
+}
+
+// announce reports a settled result to observers
+func (as *AuctionService) announce(result *models.BidResult) {
+	as.notify(EventAuctionResolved, func(observer AuctionObserver) error {
+		return observer.OnAuctionResolved(result.Clone())
+	})
+}
+
+// checkBidders validates the bidders, annotating a failure with the operation and reporting it to observers
+func (as *AuctionService) checkBidders(bidders []models.Bidder, operation string) ([]*models.ValidationError, error) {
+	warnings, err := as.validateBidders(bidders)
+	if err != nil {
+		// Annotate the validation error in place so callers keep its concrete type
+		var auctionErr *models.AuctionError
+		if !errors.As(err, &auctionErr) {
+			// Handle unexpected error types
+			auctionErr = models.NewAuctionErrorWithCause(models.ErrorTypeValidation, "unexpected validation error", err)
+			auctionErr.WithCode(models.CodeServiceUnexpectedError)
+			err = auctionErr
+		}
+		auctionErr.WithOperation(operation)
+		auctionErr.AddContext("service", "AuctionService")
+		as.warn("bid validation failed", "bidders", len(bidders), "error", err)
+		as.notify(EventValidationFailed, func(observer AuctionObserver) error {
+			return observer.OnValidationFailed(models.CloneError(err))
+		})
+		return nil, err
+	}
+	return warnings, nil
+}
+
+// validateBidders runs the validator, collecting warnings from validators that report them
+func (as *AuctionService) validateBidders(bidders []models.Bidder) ([]*models.ValidationError, error) {
+	if validator, ok := as.validator.(validation.WarningValidator); ok {
+		return validator.ValidateBiddersWithWarnings(bidders)
+	}
+	return nil, as.validator.ValidateBidders(bidders)
+}
+
+// processBids runs the engine, passing the context to engines that support it
+func (as *AuctionService) processBids(ctx context.Context, started time.Time, bidders []models.Bidder) (*models.BidResult, error) {
+	if engine, ok := as.engine.(ContextBiddingEngine); ok {
+		return engine.ProcessBidsContext(ctx, bidders)
+	}
+
+	if err := internal.ContextError(ctx, started, len(bidders), 0); err != nil {
+		return nil, err
+	}
+	result, err := as.engine.ProcessBids(bidders)
+	if err != nil {
+		return nil, err
+	}
+	if err := internal.ContextError(ctx, started, len(bidders), 0); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
+
+// ingest returns a copy of the bidders with sequence numbers assigned to any that have none
+// Numbering starts afresh on every call, after the highest sequence already present
+func (as *AuctionService) ingest(bidders []models.Bidder) []models.Bidder {
+	if bidders == nil {
+		return nil
+	}
+	ingested := make([]models.Bidder, len(bidders))
+	copy(ingested, bidders)
+	var last uint64
+	for _, bidder := range ingested {
+		if bidder.Sequence > last {
+			last = bidder.Sequence
+		}
+	}
+	models.NewSequencer(last).Assign(ingested)
+	return ingested
+}
+
+// warn writes a warning record when the service has a logger
+func (as *AuctionService) warn(msg string, args ...any) {
+	if as.logger != nil {
+		as.logger.Warn(msg, args...)
+	}
+}
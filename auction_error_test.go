@@ -1,6 +1,8 @@
 package auction
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -98,9 +100,9 @@ func TestRealWorldErrorScenarios(t *testing.T) {
 				{
 					ID:            "",
 					Name:          "",
-					StartingBid:   -100.0,
-					MaxBid:        -500.0,
-					AutoIncrement: 0.0,
+					StartingBid:   models.Dollars(-100.0),
+					MaxBid:        models.Dollars(-500.0),
+					AutoIncrement: models.Dollars(0.0),
 				},
 			},
 			expectError:       true,
@@ -117,16 +119,16 @@ func TestRealWorldErrorScenarios(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "Alice",
-					StartingBid:   100.0,
-					MaxBid:        200.0,
-					AutoIncrement: 10.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(200.0),
+					AutoIncrement: models.Dollars(10.0),
 				},
 				{
 					ID:            "bidder1", // Duplicate
 					Name:          "Bob",
-					StartingBid:   110.0,
-					MaxBid:        220.0,
-					AutoIncrement: 15.0,
+					StartingBid:   models.Dollars(110.0),
+					MaxBid:        models.Dollars(220.0),
+					AutoIncrement: models.Dollars(15.0),
 				},
 			},
 			expectError:       true,
@@ -143,9 +145,9 @@ func TestRealWorldErrorScenarios(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "Alice",
-					StartingBid:   100.0,
-					MaxBid:        200.0,
-					AutoIncrement: 10.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(200.0),
+					AutoIncrement: models.Dollars(10.0),
 					EntryTime:     time.Now(),
 				},
 			},
@@ -206,9 +208,9 @@ func TestErrorMessageFormatting(t *testing.T) {
 		{
 			ID:            "",
 			Name:          "",
-			StartingBid:   -100.0,
-			MaxBid:        -500.0,
-			AutoIncrement: 0.0,
+			StartingBid:   models.Dollars(-100.0),
+			MaxBid:        models.Dollars(-500.0),
+			AutoIncrement: models.Dollars(0.0),
 		},
 	}
 
@@ -256,6 +258,142 @@ func TestErrorMessageFormatting(t *testing.T) {
 	}
 }
 
+// TestErrorSentinels_TimeoutVersusValidation tests that callers can tell failure kinds apart with errors.Is and errors.As
+func TestErrorSentinels_TimeoutVersusValidation(t *testing.T) {
+	invalid := observedBidders()
+	invalid[0].MaxBid = models.Dollars(50)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, stop := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer stop()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		service  *AuctionService
+		bidders  []models.Bidder
+		sentinel error
+	}{
+		{"validation failure", context.Background(), NewAuctionService(), invalid, models.ErrValidation},
+		{"context deadline", expired, NewAuctionService(), observedBidders(), models.ErrTimeout},
+		{"canceled context", canceled, NewAuctionService(), observedBidders(), models.ErrProcessing},
+		{"round limit", context.Background(), NewAuctionService(), longBidders(), models.ErrTimeout},
+		{"engine failure", context.Background(), NewAuctionService(WithValidator(&MockBidValidator{}), WithEngine(&MockBiddingEngine{shouldError: true, errorType: models.ErrorTypeSystem, errorMsg: "engine down"})), observedBidders(), models.ErrSystem},
+	}
+
+	sentinels := []error{models.ErrValidation, models.ErrProcessing, models.ErrSystem, models.ErrInput, models.ErrTimeout}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.service.DetermineWinnerContext(tt.ctx, tt.bidders)
+			if !errors.Is(err, tt.sentinel) {
+				t.Fatalf("Expected %v, got %T: %v", tt.sentinel, err, err)
+			}
+			for _, other := range sentinels {
+				if other != tt.sentinel && errors.Is(err, other) {
+					t.Errorf("Expected %T not to match %v", err, other)
+				}
+			}
+
+			// Every service error exposes the shared AuctionError fields
+			var auctionErr *models.AuctionError
+			if !errors.As(err, &auctionErr) {
+				t.Fatalf("Expected an AuctionError in the chain, got %T", err)
+			}
+			if service, _ := auctionErr.GetContext("service"); service != "AuctionService" {
+				t.Errorf("Expected service context, got %q", service)
+			}
+		})
+	}
+}
+
+// TestErrorSentinels_ValidationDetails tests that validation details are reachable with errors.As
+func TestErrorSentinels_ValidationDetails(t *testing.T) {
+	invalid := observedBidders()
+	invalid[1].Name = ""
+
+	_, err := NewAuctionService().DetermineWinner(invalid)
+	var detail *models.ValidationError
+	if !errors.As(err, &detail) {
+		t.Fatalf("Expected a ValidationError detail, got %T: %v", err, err)
+	}
+	if detail.BidderID != invalid[1].ID || detail.Field != "Name" {
+		t.Errorf("Expected the Name detail for %s, got %+v", invalid[1].ID, detail)
+	}
+
+	var timeoutErr *models.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		t.Error("Expected a validation failure not to be a TimeoutError")
+	}
+}
+
+// TestErrorCodes_PublicAPI tests that clients can branch on stable codes and read them from the JSON envelope
+func TestErrorCodes_PublicAPI(t *testing.T) {
+	startAboveMax := observedBidders()
+	startAboveMax[0].StartingBid = models.Dollars(1000)
+	duplicate := observedBidders()
+	duplicate[1].ID = duplicate[0].ID
+
+	tests := []struct {
+		name     string
+		service  *AuctionService
+		bidders  []models.Bidder
+		code     models.ErrorCode
+		notCodes []models.ErrorCode
+	}{
+		{"start above max", NewAuctionService(), startAboveMax, models.CodeBidStartGreaterThanMax, []models.ErrorCode{models.CodeBidderDuplicateID}},
+		{"duplicate ID", NewAuctionService(), duplicate, models.CodeBidderDuplicateID, []models.ErrorCode{models.CodeBidStartGreaterThanMax}},
+		{"round limit", NewAuctionService(), longBidders(), models.CodeEngineRoundLimit, []models.ErrorCode{models.CodeAuctionInvalid}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.service.DetermineWinner(tt.bidders)
+			if !models.HasCode(err, tt.code) {
+				t.Fatalf("Expected code %s, got %v", tt.code, err)
+			}
+			for _, code := range tt.notCodes {
+				if models.HasCode(err, code) {
+					t.Errorf("Expected no %s code", code)
+				}
+			}
+
+			data, marshalErr := models.MarshalError(err)
+			if marshalErr != nil {
+				t.Fatalf("Expected no error, got: %v", marshalErr)
+			}
+			if !contains(string(data), string(tt.code)) {
+				t.Errorf("Expected the envelope to contain %s: %s", tt.code, data)
+			}
+		})
+	}
+}
+
+// TestLocalizedValidationMessages tests rendering a service validation error for a storefront locale
+func TestLocalizedValidationMessages(t *testing.T) {
+	catalog := models.NewStaticMessageCatalog()
+	catalog.SetMessages("fr", map[models.MessageKey]string{
+		models.MsgBidStartAboveMax: "l'enchère de départ de {value} dépasse votre maximum de {limit} (enchérisseur n°{position})",
+	})
+
+	bidders := observedBidders()
+	bidders[1].StartingBid = models.Dollars(250)
+
+	_, err := NewAuctionService().DetermineWinner(bidders)
+	var detail *models.ValidationError
+	if !errors.As(err, &detail) {
+		t.Fatalf("Expected a ValidationError, got %T: %v", err, err)
+	}
+
+	want := "l'enchère de départ de 250.00 dépasse votre maximum de 200.00 (enchérisseur n°2)"
+	if got := detail.Localize(catalog, "fr-FR"); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	if got := detail.Localize(catalog, "it"); got != "starting bid cannot be greater than maximum bid" {
+		t.Errorf("Expected the English fallback, got %q", got)
+	}
+}
+
 // Helper function to check if a string contains a substring
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(substr) == 0 ||
@@ -277,9 +415,9 @@ func BenchmarkAuctionService_ErrorHandling(b *testing.B) {
 		{
 			ID:            "",
 			Name:          "",
-			StartingBid:   -100.0,
-			MaxBid:        500.0,
-			AutoIncrement: 0.0,
+			StartingBid:   models.Dollars(-100.0),
+			MaxBid:        models.Dollars(500.0),
+			AutoIncrement: models.Dollars(0.0),
 		},
 	}
 
@@ -299,9 +437,9 @@ func BenchmarkAuctionService_SuccessPath(b *testing.B) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
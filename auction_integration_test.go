@@ -71,9 +71,9 @@ func TestDetermineWinner_ValidationErrorWrapping(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -118,9 +118,9 @@ func TestDetermineWinner_UnexpectedValidationError(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -173,9 +173,9 @@ func TestDetermineWinner_ProcessingErrorWrapping(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -220,9 +220,9 @@ func TestDetermineWinner_UnexpectedProcessingError(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -275,9 +275,9 @@ func TestDetermineWinner_NilResultError(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -330,17 +330,17 @@ func TestDetermineWinner_SuccessfulPath(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        300.0,
-			AutoIncrement: 25.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(300.0),
+			AutoIncrement: models.Dollars(25.0),
 			EntryTime:     baseTime,
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Bob",
-			StartingBid:   110.0,
-			MaxBid:        250.0,
-			AutoIncrement: 20.0,
+			StartingBid:   models.Dollars(110.0),
+			MaxBid:        models.Dollars(250.0),
+			AutoIncrement: models.Dollars(20.0),
 			EntryTime:     baseTime.Add(1 * time.Second),
 		},
 	}
@@ -388,9 +388,9 @@ func TestDetermineWinner_EdgeCaseScenarios(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "Alice",
-					StartingBid:   0.01,
-					MaxBid:        0.01,
-					AutoIncrement: 0.01,
+					StartingBid:   models.Dollars(0.01),
+					MaxBid:        models.Dollars(0.01),
+					AutoIncrement: models.Dollars(0.01),
 					EntryTime:     time.Now(),
 				},
 			},
@@ -403,17 +403,17 @@ func TestDetermineWinner_EdgeCaseScenarios(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "Alice",
-					StartingBid:   100.0,
-					MaxBid:        200.0,
-					AutoIncrement: 10.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(200.0),
+					AutoIncrement: models.Dollars(10.0),
 					EntryTime:     time.Now(),
 				},
 				{
 					ID:            "bidder2",
 					Name:          "Bob",
-					StartingBid:   100.0,
-					MaxBid:        180.0,
-					AutoIncrement: 5.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(180.0),
+					AutoIncrement: models.Dollars(5.0),
 					EntryTime:     time.Now().Add(1 * time.Second),
 				},
 			},
@@ -426,9 +426,9 @@ func TestDetermineWinner_EdgeCaseScenarios(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "Alice",
-					StartingBid:   999999.99,
-					MaxBid:        1000000.00,
-					AutoIncrement: 0.01,
+					StartingBid:   models.Dollars(999999.99),
+					MaxBid:        models.Dollars(1000000.00),
+					AutoIncrement: models.Dollars(0.01),
 					EntryTime:     time.Now(),
 				},
 			},
@@ -478,17 +478,17 @@ func TestDetermineWinner_PrecisionHandling(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   10.01,
-			MaxBid:        20.99,
-			AutoIncrement: 0.25,
+			StartingBid:   models.Dollars(10.01),
+			MaxBid:        models.Dollars(20.99),
+			AutoIncrement: models.Dollars(0.25),
 			EntryTime:     baseTime,
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Bob",
-			StartingBid:   10.02,
-			MaxBid:        19.98,
-			AutoIncrement: 0.33,
+			StartingBid:   models.Dollars(10.02),
+			MaxBid:        models.Dollars(19.98),
+			AutoIncrement: models.Dollars(0.33),
 			EntryTime:     baseTime.Add(1 * time.Second),
 		},
 	}
@@ -514,7 +514,7 @@ func TestDetermineWinner_PrecisionHandling(t *testing.T) {
 
 	// Verify winning bid is reasonable (should be Bob's max + Alice's increment)
 	expectedWinningBid := 19.98 + 0.25 // 20.23
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 }
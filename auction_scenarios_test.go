@@ -32,9 +32,9 @@ func TestAuctionScenario1(t *testing.T) {
 	// Create bidders based on the scenario data
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("sasha", "Sasha", 50.00, 80.00, 3.00),
-		*models.NewBidder("john", "John", 60.00, 82.00, 2.00),
-		*models.NewBidder("pat", "Pat", 55.00, 85.00, 5.00),
+		*models.NewBidder("sasha", "Sasha", models.Dollars(50.00), models.Dollars(80.00), models.Dollars(3.00)),
+		*models.NewBidder("john", "John", models.Dollars(60.00), models.Dollars(82.00), models.Dollars(2.00)),
+		*models.NewBidder("pat", "Pat", models.Dollars(55.00), models.Dollars(85.00), models.Dollars(5.00)),
 	}
 
 	// Set entry times (order of entry)
@@ -65,8 +65,8 @@ func TestAuctionScenario1(t *testing.T) {
 
 	// Sasha pays their starting bid since they're the only bidder at the winning level
 	expectedWinningBid := 80.00 // Sasha's max bid (minimum winning amount)
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	// Verify precision handling
@@ -81,7 +81,7 @@ func TestAuctionScenario1(t *testing.T) {
 	}
 
 	t.Logf("Auction #1 Result: Winner=%s, WinningBid=%.2f, Rounds=%d",
-		result.Winner.Name, result.WinningBid, result.BiddingRounds)
+		result.Winner.Name, result.WinningBid.Float64(), result.BiddingRounds)
 }
 
 // TestAuctionScenario2 tests the second auction scenario with Riley, Morgan, and Charlie
@@ -91,9 +91,9 @@ func TestAuctionScenario2(t *testing.T) {
 	// Create bidders based on the scenario data
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("riley", "Riley", 700.00, 725.00, 2.00),
-		*models.NewBidder("morgan", "Morgan", 599.00, 725.00, 15.00),
-		*models.NewBidder("charlie", "Charlie", 625.00, 725.00, 8.00),
+		*models.NewBidder("riley", "Riley", models.Dollars(700.00), models.Dollars(725.00), models.Dollars(2.00)),
+		*models.NewBidder("morgan", "Morgan", models.Dollars(599.00), models.Dollars(725.00), models.Dollars(15.00)),
+		*models.NewBidder("charlie", "Charlie", models.Dollars(625.00), models.Dollars(725.00), models.Dollars(8.00)),
 	}
 
 	// Set entry times (order of entry)
@@ -119,8 +119,8 @@ func TestAuctionScenario2(t *testing.T) {
 
 	// With all having same max bid, Riley should pay $725.00 (their max)
 	expectedWinningBid := 725.00
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	// Verify precision handling
@@ -135,7 +135,7 @@ func TestAuctionScenario2(t *testing.T) {
 	}
 
 	t.Logf("Auction #2 Result: Winner=%s, WinningBid=%.2f, Rounds=%d",
-		result.Winner.Name, result.WinningBid, result.BiddingRounds)
+		result.Winner.Name, result.WinningBid.Float64(), result.BiddingRounds)
 }
 
 // TestAuctionScenario3 tests the third auction scenario with Alex, Jesse, and Drew
@@ -145,9 +145,9 @@ func TestAuctionScenario3(t *testing.T) {
 	// Create bidders based on the scenario data
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("alex", "Alex", 2500.00, 3000.00, 500.00),
-		*models.NewBidder("jesse", "Jesse", 2800.00, 3100.00, 201.00),
-		*models.NewBidder("drew", "Drew", 2501.00, 3200.00, 247.00),
+		*models.NewBidder("alex", "Alex", models.Dollars(2500.00), models.Dollars(3000.00), models.Dollars(500.00)),
+		*models.NewBidder("jesse", "Jesse", models.Dollars(2800.00), models.Dollars(3100.00), models.Dollars(201.00)),
+		*models.NewBidder("drew", "Drew", models.Dollars(2501.00), models.Dollars(3200.00), models.Dollars(247.00)),
 	}
 
 	// Set entry times (order of entry)
@@ -173,8 +173,8 @@ func TestAuctionScenario3(t *testing.T) {
 
 	// Jesse pays their max bid as the minimum winning amount
 	expectedWinningBid := 3100.00
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	// Verify precision handling
@@ -189,7 +189,7 @@ func TestAuctionScenario3(t *testing.T) {
 	}
 
 	t.Logf("Auction #3 Result: Winner=%s, WinningBid=%.2f, Rounds=%d",
-		result.Winner.Name, result.WinningBid, result.BiddingRounds)
+		result.Winner.Name, result.WinningBid.Float64(), result.BiddingRounds)
 }
 
 // TestAuctionScenario1_DetailedBiddingProcess tests the detailed bidding process for scenario 1
@@ -199,9 +199,9 @@ func TestAuctionScenario1_DetailedBiddingProcess(t *testing.T) {
 	// Create bidders based on the scenario data
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("sasha", "Sasha", 50.00, 80.00, 3.00),
-		*models.NewBidder("john", "John", 60.00, 82.00, 2.00),
-		*models.NewBidder("pat", "Pat", 55.00, 85.00, 5.00),
+		*models.NewBidder("sasha", "Sasha", models.Dollars(50.00), models.Dollars(80.00), models.Dollars(3.00)),
+		*models.NewBidder("john", "John", models.Dollars(60.00), models.Dollars(82.00), models.Dollars(2.00)),
+		*models.NewBidder("pat", "Pat", models.Dollars(55.00), models.Dollars(85.00), models.Dollars(5.00)),
 	}
 
 	// Set entry times
@@ -237,14 +237,14 @@ func TestAuctionScenario1_DetailedBiddingProcess(t *testing.T) {
 
 	// Verify the actual final state based on the algorithm behavior
 	// All bidders should have the same current bid ($80.00) when Sasha reached their max
-	if sasha.CurrentBid != 80.00 {
-		t.Errorf("Expected Sasha's final bid to be 80.00, got %.2f", sasha.CurrentBid)
+	if sasha.CurrentBid.Float64() != 80.00 {
+		t.Errorf("Expected Sasha's final bid to be 80.00, got %.2f", sasha.CurrentBid.Float64())
 	}
-	if john.CurrentBid != 80.00 {
-		t.Errorf("Expected John's final bid to be 80.00, got %.2f", john.CurrentBid)
+	if john.CurrentBid.Float64() != 80.00 {
+		t.Errorf("Expected John's final bid to be 80.00, got %.2f", john.CurrentBid.Float64())
 	}
-	if pat.CurrentBid != 80.00 {
-		t.Errorf("Expected Pat's final bid to be 80.00, got %.2f", pat.CurrentBid)
+	if pat.CurrentBid.Float64() != 80.00 {
+		t.Errorf("Expected Pat's final bid to be 80.00, got %.2f", pat.CurrentBid.Float64())
 	}
 
 	// Verify activity status - only Sasha should be inactive (reached max bid)
@@ -259,7 +259,7 @@ func TestAuctionScenario1_DetailedBiddingProcess(t *testing.T) {
 	}
 
 	t.Logf("Final bids - Sasha: %.2f, John: %.2f, Pat: %.2f",
-		sasha.CurrentBid, john.CurrentBid, pat.CurrentBid)
+		sasha.CurrentBid.Float64(), john.CurrentBid.Float64(), pat.CurrentBid.Float64())
 }
 
 // TestAuctionScenario2_TieResolution tests tie resolution when all bidders have same max bid
@@ -269,9 +269,9 @@ func TestAuctionScenario2_TieResolution(t *testing.T) {
 	// Create bidders with different entry times but same max bid
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("riley", "Riley", 700.00, 725.00, 2.00),
-		*models.NewBidder("morgan", "Morgan", 599.00, 725.00, 15.00),
-		*models.NewBidder("charlie", "Charlie", 625.00, 725.00, 8.00),
+		*models.NewBidder("riley", "Riley", models.Dollars(700.00), models.Dollars(725.00), models.Dollars(2.00)),
+		*models.NewBidder("morgan", "Morgan", models.Dollars(599.00), models.Dollars(725.00), models.Dollars(15.00)),
+		*models.NewBidder("charlie", "Charlie", models.Dollars(625.00), models.Dollars(725.00), models.Dollars(8.00)),
 	}
 
 	// Set different entry times to test tie resolution
@@ -293,8 +293,8 @@ func TestAuctionScenario2_TieResolution(t *testing.T) {
 
 	// Verify winning bid is still $725.00
 	expectedWinningBid := 725.00
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	t.Logf("Tie resolution test - Winner: %s (earliest entry)", result.Winner.Name)
@@ -307,9 +307,9 @@ func TestAuctionScenario3_LargeIncrements(t *testing.T) {
 	// Create bidders with large increments
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("alex", "Alex", 2500.00, 3000.00, 500.00),
-		*models.NewBidder("jesse", "Jesse", 2800.00, 3100.00, 201.00),
-		*models.NewBidder("drew", "Drew", 2501.00, 3200.00, 247.00),
+		*models.NewBidder("alex", "Alex", models.Dollars(2500.00), models.Dollars(3000.00), models.Dollars(500.00)),
+		*models.NewBidder("jesse", "Jesse", models.Dollars(2800.00), models.Dollars(3100.00), models.Dollars(201.00)),
+		*models.NewBidder("drew", "Drew", models.Dollars(2501.00), models.Dollars(3200.00), models.Dollars(247.00)),
 	}
 
 	// Set entry times
@@ -334,8 +334,8 @@ func TestAuctionScenario3_LargeIncrements(t *testing.T) {
 	}
 
 	// Verify precision is maintained with large amounts
-	if result.WinningBid != 3100.00 {
-		t.Errorf("Expected winning bid 3100.00, got %.2f", result.WinningBid)
+	if result.WinningBid.Float64() != 3100.00 {
+		t.Errorf("Expected winning bid 3100.00, got %.2f", result.WinningBid.Float64())
 	}
 
 	// Test that cents calculation is accurate for large amounts
@@ -345,5 +345,5 @@ func TestAuctionScenario3_LargeIncrements(t *testing.T) {
 	}
 
 	t.Logf("Large increment test - Winner: %s, Amount: %.2f, Rounds: %d",
-		result.Winner.Name, result.WinningBid, result.BiddingRounds)
+		result.Winner.Name, result.WinningBid.Float64(), result.BiddingRounds)
 }
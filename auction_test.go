@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"auction-bidding-algorithm/internal/clocktest"
 	"auction-bidding-algorithm/internal/models"
 )
 
@@ -15,9 +16,9 @@ func TestAuctionService_DetermineWinner_SingleBidder(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -41,8 +42,8 @@ func TestAuctionService_DetermineWinner_SingleBidder(t *testing.T) {
 	}
 
 	// Single bidder should pay their starting bid
-	if result.WinningBid != 100.0 {
-		t.Errorf("Expected winning bid 100.0, got %f", result.WinningBid)
+	if result.WinningBid.Float64() != 100.0 {
+		t.Errorf("Expected winning bid 100.0, got %f", result.WinningBid.Float64())
 	}
 
 	if result.TotalBidders != 1 {
@@ -58,17 +59,17 @@ func TestAuctionService_DetermineWinner_MultipleBidders_NoIncrements(t *testing.
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        150.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(150.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     baseTime,
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Bob",
-			StartingBid:   80.0,
-			MaxBid:        120.0,
-			AutoIncrement: 5.0,
+			StartingBid:   models.Dollars(80.0),
+			MaxBid:        models.Dollars(120.0),
+			AutoIncrement: models.Dollars(5.0),
 			EntryTime:     baseTime.Add(1 * time.Second),
 		},
 	}
@@ -85,8 +86,8 @@ func TestAuctionService_DetermineWinner_MultipleBidders_NoIncrements(t *testing.
 
 	// Winner should pay just enough to beat the second bidder
 	expectedWinningBid := 120.0 + 10.0 // Bob's max + Alice's increment
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %f, got %f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %f, got %f", expectedWinningBid, result.WinningBid.Float64())
 	}
 }
 
@@ -98,25 +99,25 @@ func TestAuctionService_DetermineWinner_ComplexIncrementingScenario(t *testing.T
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        300.0,
-			AutoIncrement: 25.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(300.0),
+			AutoIncrement: models.Dollars(25.0),
 			EntryTime:     baseTime,
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Bob",
-			StartingBid:   110.0,
-			MaxBid:        250.0,
-			AutoIncrement: 20.0,
+			StartingBid:   models.Dollars(110.0),
+			MaxBid:        models.Dollars(250.0),
+			AutoIncrement: models.Dollars(20.0),
 			EntryTime:     baseTime.Add(1 * time.Second),
 		},
 		{
 			ID:            "bidder3",
 			Name:          "Charlie",
-			StartingBid:   90.0,
-			MaxBid:        200.0,
-			AutoIncrement: 15.0,
+			StartingBid:   models.Dollars(90.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(15.0),
 			EntryTime:     baseTime.Add(2 * time.Second),
 		},
 	}
@@ -133,8 +134,8 @@ func TestAuctionService_DetermineWinner_ComplexIncrementingScenario(t *testing.T
 
 	// Alice should win and pay just enough to beat Bob
 	expectedWinningBid := 250.0 + 25.0 // Bob's max + Alice's increment
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %f, got %f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %f, got %f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	if result.BiddingRounds == 0 {
@@ -150,17 +151,17 @@ func TestAuctionService_DetermineWinner_TieResolution(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        150.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(150.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     baseTime.Add(1 * time.Second), // Later entry
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Bob",
-			StartingBid:   100.0,
-			MaxBid:        150.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(150.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     baseTime, // Earlier entry
 		},
 	}
@@ -185,17 +186,17 @@ func TestAuctionService_DetermineWinner_ValidationErrors(t *testing.T) {
 		{
 			ID:            "", // Invalid: empty ID
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Bob",
-			StartingBid:   200.0,
-			MaxBid:        100.0, // Invalid: starting bid > max bid
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(200.0),
+			MaxBid:        models.Dollars(100.0), // Invalid: starting bid > max bid
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -255,9 +256,9 @@ func TestAuctionService_DetermineWinner_NegativeBids(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   -100.0, // Invalid: negative starting bid
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(-100.0), // Invalid: negative starting bid
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -280,9 +281,9 @@ func TestAuctionService_DetermineWinner_ZeroAutoIncrement(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 0.0, // Invalid: zero auto-increment
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(0.0), // Invalid: zero auto-increment
 			EntryTime:     time.Now(),
 		},
 	}
@@ -305,17 +306,17 @@ func TestAuctionService_DetermineWinner_DuplicateBidderIDs(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        200.0,
-			AutoIncrement: 10.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
 			EntryTime:     time.Now(),
 		},
 		{
 			ID:            "bidder1", // Duplicate ID
 			Name:          "Bob",
-			StartingBid:   110.0,
-			MaxBid:        220.0,
-			AutoIncrement: 15.0,
+			StartingBid:   models.Dollars(110.0),
+			MaxBid:        models.Dollars(220.0),
+			AutoIncrement: models.Dollars(15.0),
 			EntryTime:     time.Now(),
 		},
 	}
@@ -342,9 +343,9 @@ func TestAuctionService_DetermineWinner_LargeBidderSet(t *testing.T) {
 		bidders[i] = models.Bidder{
 			ID:            fmt.Sprintf("bidder%d", i+1),
 			Name:          fmt.Sprintf("Bidder %d", i+1),
-			StartingBid:   float64(100 + i),
-			MaxBid:        float64(200 + i*2),
-			AutoIncrement: float64(5 + i%10),
+			StartingBid:   models.Dollars(float64(100 + i)),
+			MaxBid:        models.Dollars(float64(200 + i*2)),
+			AutoIncrement: models.Dollars(float64(5 + i%10)),
 			EntryTime:     baseTime.Add(time.Duration(i) * time.Millisecond),
 		}
 	}
@@ -372,3 +373,270 @@ func TestAuctionService_DetermineWinner_LargeBidderSet(t *testing.T) {
 		t.Errorf("Expected winner 'bidder100', got '%s'", result.Winner.ID)
 	}
 }
+
+func TestAuctionService_DetermineWinner_ClosedFormStrategy(t *testing.T) {
+	iterative := NewAuctionService()
+	closedForm := NewAuctionService(WithStrategy(StrategyClosedForm))
+
+	// Tiny increments relative to the max bids exceed the iterative round limit
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		{
+			ID:            "bidder1",
+			Name:          "Alice",
+			StartingBid:   models.Dollars(1.00),
+			MaxBid:        models.Dollars(5000.00),
+			AutoIncrement: models.Dollars(0.02),
+			EntryTime:     baseTime,
+		},
+		{
+			ID:            "bidder2",
+			Name:          "Bob",
+			StartingBid:   models.Dollars(1.01),
+			MaxBid:        models.Dollars(4999.00),
+			AutoIncrement: models.Dollars(0.02),
+			EntryTime:     baseTime.Add(time.Second),
+		},
+	}
+
+	if _, err := iterative.DetermineWinner(bidders); err == nil {
+		t.Fatal("Expected iterative strategy to exceed the round limit")
+	}
+
+	result, err := closedForm.DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.Winner == nil || result.Winner.ID != "bidder1" {
+		t.Fatalf("Expected winner 'bidder1', got %+v", result.Winner)
+	}
+
+	// Bob's max bid plus Alice's increment
+	if result.WinningBid.Float64() != 4999.02 {
+		t.Errorf("Expected winning bid 4999.02, got %.2f", result.WinningBid.Float64())
+	}
+}
+
+func TestAuctionService_DetermineWinner_ReservePrice(t *testing.T) {
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		{
+			ID:            "bidder1",
+			Name:          "Alice",
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(200.0),
+			AutoIncrement: models.Dollars(10.0),
+			EntryTime:     baseTime,
+		},
+		{
+			ID:            "bidder2",
+			Name:          "Bob",
+			StartingBid:   models.Dollars(90.0),
+			MaxBid:        models.Dollars(150.0),
+			AutoIncrement: models.Dollars(10.0),
+			EntryTime:     baseTime.Add(time.Second),
+		},
+	}
+
+	met, err := NewAuctionService(WithConfig(models.NewAuctionConfigWithReserve(17500))).DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !met.ReserveMet || met.Winner == nil {
+		t.Fatal("Expected reserve to be met with a winner")
+	}
+	if met.WinningBid.Float64() != 175.0 {
+		t.Errorf("Expected winning bid 175.00, got %.2f", met.WinningBid.Float64())
+	}
+
+	unmet, err := NewAuctionService(WithConfig(models.NewAuctionConfigWithReserve(30000))).DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if unmet.ReserveMet || unmet.Winner != nil {
+		t.Error("Expected reserve not met and no winner")
+	}
+}
+
+func TestAuctionService_DetermineWinner_MultiCurrency(t *testing.T) {
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		{
+			ID:            "bidder1",
+			Name:          "Alice",
+			StartingBid:   models.NewMoney(10000, models.EUR),
+			MaxBid:        models.NewMoney(20000, models.EUR),
+			AutoIncrement: models.NewMoney(1000, models.EUR),
+			EntryTime:     baseTime,
+		},
+		{
+			ID:            "bidder2",
+			Name:          "Kenji",
+			StartingBid:   models.NewMoney(15000, models.JPY),
+			MaxBid:        models.NewMoney(30000, models.JPY),
+			AutoIncrement: models.NewMoney(500, models.JPY),
+			EntryTime:     baseTime.Add(time.Second),
+		},
+	}
+
+	// Without exchange rates the bidders cannot be compared
+	if _, err := NewAuctionService().DetermineWinner(bidders); err == nil {
+		t.Fatal("Expected validation error for mixed currencies")
+	}
+
+	rates := models.NewStaticRateProvider()
+	_ = rates.SetRate(models.EUR, models.USD, "1.10")
+	_ = rates.SetRate(models.JPY, models.USD, "0.0065")
+
+	result, err := NewAuctionService(WithConfig(models.NewAuctionConfigWithSettlement(models.USD, rates))).DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner == nil || result.Winner.ID != "bidder1" {
+		t.Fatalf("Expected Alice to win, got %+v", result.Winner)
+	}
+	if result.WinningBid.Currency() != models.USD || result.WinningBidLocal.Currency() != models.EUR {
+		t.Errorf("Expected USD settlement and EUR local bids, got %s and %s", result.WinningBid, result.WinningBidLocal)
+	}
+	if result.WinningBidLocal.Amount() > 20000 {
+		t.Errorf("Expected local winning bid within Alice's maximum, got %s", result.WinningBidLocal)
+	}
+}
+
+func TestAuctionService_WithClock(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	service := NewAuctionService(WithClock(clock))
+	if service.Clock() != clock {
+		t.Fatal("Expected service to use the given clock")
+	}
+	if _, ok := NewAuctionService().Clock().(models.SystemClock); !ok {
+		t.Error("Expected the default service to use the system clock")
+	}
+
+	// A live auction created from the service follows its clock
+	auction := NewAuctionWithService("lot-1", clock.Now().Add(time.Minute), service)
+	bidder := models.Bidder{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10)}
+	if _, err := auction.PlaceBid(bidder); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !auction.Bidders()[0].EntryTime.Equal(clock.Now()) {
+		t.Errorf("Expected entry time from the fake clock, got %v", auction.Bidders()[0].EntryTime)
+	}
+
+	clock.Advance(time.Minute)
+	if _, err := auction.PlaceBid(bidder); err == nil {
+		t.Error("Expected the auction to close when the fake clock reaches the close time")
+	}
+}
+
+// TestAuctionService_SequencesAtIngestion tests that bids entered at the same instant are numbered
+// in arrival order and the tie is recorded as broken by sequence
+func TestAuctionService_SequencesAtIngestion(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bidders := []models.Bidder{
+		{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: now},
+		{ID: "bidder2", Name: "Bob", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: now},
+	}
+
+	result, err := NewAuctionService().DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != "bidder1" {
+		t.Errorf("Expected first arrival Alice to win, got %s", result.Winner.Name)
+	}
+	if result.TieBreak != models.TieBreakEarliestSequence {
+		t.Errorf("Expected tie broken by %q, got %q", models.TieBreakEarliestSequence, result.TieBreak)
+	}
+	if result.AllBidders[0].Sequence == 0 || result.AllBidders[0].Sequence >= result.AllBidders[1].Sequence {
+		t.Errorf("Expected increasing sequence numbers, got %d and %d", result.AllBidders[0].Sequence, result.AllBidders[1].Sequence)
+	}
+	if bidders[0].Sequence != 0 || bidders[1].Sequence != 0 {
+		t.Error("Expected the caller's bidders to be unchanged")
+	}
+}
+
+// TestAuctionService_SequencesAreReproducible tests that every call numbers the same bidders the same way,
+// after any sequence numbers the caller already gave
+func TestAuctionService_SequencesAreReproducible(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bidders := []models.Bidder{
+		{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: now},
+		{ID: "bidder2", Name: "Bob", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: now, Sequence: 7},
+		{ID: "bidder3", Name: "Carol", StartingBid: models.Dollars(100), MaxBid: models.Dollars(120), AutoIncrement: models.Dollars(10), EntryTime: now},
+	}
+	service := NewAuctionService()
+
+	first, err := service.DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	second, err := service.DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := map[string]uint64{"bidder1": 8, "bidder2": 7, "bidder3": 9}
+	for i := range first.AllBidders {
+		id := first.AllBidders[i].ID
+		if first.AllBidders[i].Sequence != expected[id] || second.AllBidders[i].Sequence != expected[id] {
+			t.Errorf("Expected %s to be numbered %d on both calls, got %d and %d", id, expected[id], first.AllBidders[i].Sequence, second.AllBidders[i].Sequence)
+		}
+	}
+	if first.Winner.ID != "bidder2" || second.Winner.ID != "bidder2" {
+		t.Errorf("Expected Bob's lower sequence to win both times, got %s and %s", first.Winner.ID, second.Winner.ID)
+	}
+}
+
+func TestAuctionService_WithTieBreaker(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bidders := []models.Bidder{
+		{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: now},
+		{ID: "bidder2", Name: "Bob", StartingBid: models.Dollars(100), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10), EntryTime: now.Add(time.Second)},
+	}
+
+	result, err := NewAuctionService(WithTieBreaker(models.NewHighestMaxBidTieBreaker())).DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != "bidder2" || result.TieBreak != models.TieBreakHighestMaxBid {
+		t.Errorf("Expected Bob to win by highest max bid, got %s by %q", result.Winner.Name, result.TieBreak)
+	}
+
+	// A seeded draw gives the same winner on every run
+	service := NewAuctionService(WithTieBreaker(models.NewSeededRandomTieBreaker(7)))
+	first, err := service.DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	for run := 0; run < 5; run++ {
+		again, err := service.DetermineWinner(bidders)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if again.Winner.ID != first.Winner.ID || again.TieBreak != models.TieBreakRandom {
+			t.Fatalf("Expected %s by random draw, got %s by %q", first.Winner.ID, again.Winner.ID, again.TieBreak)
+		}
+	}
+}
+
+func TestAuctionService_WithAudit(t *testing.T) {
+	bidders := []models.Bidder{
+		{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: time.Now()},
+		{ID: "bidder2", Name: "Bob", StartingBid: models.Dollars(105), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10), EntryTime: time.Now().Add(time.Second)},
+	}
+
+	var streamed int
+	service := NewAuctionService(WithAudit(models.AuditLeaderChanges, func(models.AuditEntry) { streamed++ }))
+	result, err := service.DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(result.AuditTrail) == 0 || streamed != len(result.AuditTrail) {
+		t.Fatalf("Expected the trail to be attached and streamed, got %d entries and %d streamed", len(result.AuditTrail), streamed)
+	}
+	if last := result.AuditTrail[len(result.AuditTrail)-1]; last.LeaderID != result.Winner.ID {
+		t.Errorf("Expected the last leader change to the winner %s, got %s", result.Winner.ID, last.LeaderID)
+	}
+}
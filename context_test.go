@@ -0,0 +1,92 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// blockingObserver waits for the context to end after the given round
+type blockingObserver struct {
+	BaseObserver
+	ctx   context.Context
+	round int
+}
+
+func (bo blockingObserver) OnRoundCompleted(round int, bidders []models.Bidder) error {
+	if round == bo.round {
+		<-bo.ctx.Done()
+	}
+	return nil
+}
+
+func TestDetermineWinnerContext_Success(t *testing.T) {
+	result, err := NewAuctionService().DetermineWinnerContext(context.Background(), observedBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != "bob" {
+		t.Errorf("Expected Bob to win, got %s", result.Winner.ID)
+	}
+}
+
+func TestDetermineWinnerContext_DeadlineExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	service := NewAuctionService(WithMaxRounds(100000), WithObserver(blockingObserver{ctx: ctx, round: 2}))
+
+	_, err := service.DetermineWinnerContext(models.WithTraceID(ctx, "trace-42"), longBidders())
+	var timeoutErr *models.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("Expected a TimeoutError, got %T: %v", err, err)
+	}
+	var auctionErr *models.AuctionError
+	if !errors.As(err, &auctionErr) {
+		t.Fatalf("Expected the TimeoutError to expose its AuctionError, got %T", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("Expected the error chain to reach context.DeadlineExceeded")
+	}
+	if traceID, _ := auctionErr.GetContext(models.TraceIDKey); traceID != "trace-42" {
+		t.Errorf("Expected trace ID in the error context, got %q", traceID)
+	}
+}
+
+func TestDetermineWinnerContext_TraceIDOnValidationError(t *testing.T) {
+	invalid := observedBidders()
+	invalid[0].MaxBid = models.Dollars(50)
+
+	ctx := models.WithRequestField(models.WithTraceID(context.Background(), "trace-7"), "tenant", "acme")
+	_, err := NewAuctionService().DetermineWinnerContext(ctx, invalid)
+	auctionErr, ok := err.(*models.AuctionError)
+	if !ok {
+		t.Fatalf("Expected AuctionError, got %T: %v", err, err)
+	}
+	if auctionErr.Type != models.ErrorTypeValidation {
+		t.Errorf("Expected a validation error, got %s", auctionErr.Type)
+	}
+	if traceID, _ := auctionErr.GetContext(models.TraceIDKey); traceID != "trace-7" {
+		t.Errorf("Expected trace ID in the error context, got %q", traceID)
+	}
+	if tenant, _ := auctionErr.GetContext("tenant"); tenant != "acme" {
+		t.Errorf("Expected tenant in the error context, got %q", tenant)
+	}
+}
+
+// TestDetermineWinnerContext_EngineWithoutContext tests that engines without context support are
+// still bounded by the context before they run
+func TestDetermineWinnerContext_EngineWithoutContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewAuctionService(WithEngine(&MockEngine{})).DetermineWinnerContext(ctx, observedBidders())
+	if err == nil {
+		t.Fatal("Expected an error for a canceled context")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the error chain to reach context.Canceled, got %v", err)
+	}
+}
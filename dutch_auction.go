@@ -0,0 +1,232 @@
+package auction
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// DutchStatus is a snapshot of a Dutch auction
+type DutchStatus struct {
+	LotID        string         `json:"lot_id"`        // Lot the auction is selling
+	CurrentPrice models.Money   `json:"current_price"` // Price a bidder accepting now would pay
+	NextDrop     time.Time      `json:"next_drop"`     // When the price next falls (zero at the floor or once closed)
+	Winner       *models.Bidder `json:"winner"`        // Bidder who accepted (nil while open or if unsold)
+	Closed       bool           `json:"closed"`        // Whether the auction has ended
+}
+
+// DutchAuction sells a single lot at a falling price: the asking price follows the auction's
+// DutchSchedule from its start time, and the first bidder to accept wins at the current price
+// The auction ends when a bidder accepts, when Close is called, or when the floor price has been
+// offered for one interval. Unlike Auction, a DutchAuction is safe for concurrent use, so bidders
+// racing to accept are settled in the order their calls take effect
+//
+// The service's observers hear OnAuctionResolved once when the auction ends: on a sale, on Close,
+// or, for a withdrawal at the floor, on the first call that finds the auction expired. A bidder
+// rejected by the validator is reported through OnValidationFailed and the service's logger
+type DutchAuction struct {
+	mu        sync.Mutex
+	lotID     string
+	startTime time.Time
+	schedule  models.DutchSchedule
+	service   *AuctionService
+	clock     models.Clock
+	result    *models.BidResult // Final outcome, set when the auction ends
+	ended     *models.BidResult // Final outcome not yet reported to observers
+}
+
+// NewDutchAuction creates a new Dutch auction for a lot that opens at startTime, reading the wall clock
+// Bidders are checked by a default validator configured for the Dutch format
+func NewDutchAuction(lotID string, startTime time.Time, schedule models.DutchSchedule) (*DutchAuction, error) {
+	service := NewAuctionService(WithConfig(models.NewAuctionConfigWithFormat(models.FormatDutch)))
+	return NewDutchAuctionWithService(lotID, startTime, schedule, service)
+}
+
+// NewDutchAuctionWithService creates a new Dutch auction that validates bidders with the given service
+// and reads the current time from the service's clock. The service's default validator only accepts
+// bidders without an auto-increment when its configuration names models.FormatDutch
+func NewDutchAuctionWithService(lotID string, startTime time.Time, schedule models.DutchSchedule, service *AuctionService) (*DutchAuction, error) {
+	return NewDutchAuctionWithClock(lotID, startTime, schedule, service, service.Clock())
+}
+
+// NewDutchAuctionWithClock creates a new Dutch auction that reads the current time from the given clock
+// It returns an InputError with code DUTCH_SCHEDULE_INVALID if the schedule is invalid
+func NewDutchAuctionWithClock(lotID string, startTime time.Time, schedule models.DutchSchedule, service *AuctionService, clock models.Clock) (*DutchAuction, error) {
+	if err := schedule.Validate(); err != nil {
+		return nil, err
+	}
+	return &DutchAuction{
+		lotID:     lotID,
+		startTime: startTime,
+		schedule:  schedule,
+		service:   service,
+		clock:     clock,
+	}, nil
+}
+
+// LotID returns the lot the auction is selling
+func (d *DutchAuction) LotID() string {
+	return d.lotID
+}
+
+// Schedule returns the auction's price schedule
+func (d *DutchAuction) Schedule() models.DutchSchedule {
+	return d.schedule
+}
+
+// StartTime returns when the auction opens at the start price
+func (d *DutchAuction) StartTime() time.Time {
+	return d.startTime
+}
+
+// EndTime returns when the lot is withdrawn if nobody accepts
+func (d *DutchAuction) EndTime() time.Time {
+	return d.startTime.Add(d.schedule.Duration())
+}
+
+// CurrentPrice returns the price a bidder accepting now would pay
+// Before the start time this is the start price
+func (d *DutchAuction) CurrentPrice() models.Money {
+	return d.schedule.PriceAt(d.clock.Now().Sub(d.startTime))
+}
+
+// IsClosed returns true once a bidder has accepted, the auction was closed or the lot was withdrawn
+func (d *DutchAuction) IsClosed() bool {
+	d.mu.Lock()
+	defer d.unlock()
+	d.expire(d.clock.Now())
+	return d.result != nil
+}
+
+// Status returns the current price and, once the auction has ended, its winner
+func (d *DutchAuction) Status() *DutchStatus {
+	d.mu.Lock()
+	defer d.unlock()
+	now := d.clock.Now()
+	d.expire(now)
+
+	status := &DutchStatus{LotID: d.lotID, Closed: d.result != nil}
+	if d.result != nil {
+		status.Winner = d.result.Winner
+		status.CurrentPrice = d.result.WinningBid
+		return status
+	}
+	status.CurrentPrice = d.schedule.PriceAt(now.Sub(d.startTime))
+	if elapsed := now.Sub(d.startTime); elapsed < d.schedule.FloorAfter() {
+		if elapsed < 0 {
+			status.NextDrop = d.startTime.Add(d.schedule.Interval)
+		} else {
+			status.NextDrop = d.startTime.Add((elapsed/d.schedule.Interval + 1) * d.schedule.Interval)
+		}
+	}
+	return status
+}
+
+// Result returns the final result, or nil while the auction is open
+func (d *DutchAuction) Result() *models.BidResult {
+	d.mu.Lock()
+	defer d.unlock()
+	d.expire(d.clock.Now())
+	return d.result
+}
+
+// Accept sells the lot to the bidder at the current price and returns the result
+// The bidder's starting, maximum and current bids are set to that price and their entry time to the
+// moment of acceptance; the bidder is then checked by the service's validator. Accepting before the
+// start time fails with code DUTCH_NOT_STARTED, and after the auction has ended with DUTCH_CLOSED
+func (d *DutchAuction) Accept(bidder models.Bidder) (*models.BidResult, error) {
+	d.mu.Lock()
+	defer d.unlock()
+	now := d.clock.Now()
+	d.expire(now)
+	if err := d.checkOpen(now); err != nil {
+		return nil, err
+	}
+
+	price := d.schedule.PriceAt(now.Sub(d.startTime))
+	bidder.StartingBid, bidder.MaxBid, bidder.CurrentBid = price, price, price
+	bidder.AutoIncrement = models.NewMoney(0, price.Currency())
+	bidder.EntryTime = now
+	bidders := d.service.ingest([]models.Bidder{bidder})
+
+	warnings, err := d.service.checkBidders(bidders, "Accept")
+	if err != nil {
+		var auctionErr *models.AuctionError
+		if errors.As(err, &auctionErr) {
+			auctionErr.AddContext("lot_id", d.lotID)
+		}
+		return nil, err
+	}
+
+	result := models.NewBidResult(&bidders[0], price, 1, 0, bidders)
+	result.Format = models.FormatDutch
+	if len(warnings) > 0 {
+		result.Warnings = warnings
+	}
+	d.result = result.WithReserve(d.schedule.FloorPrice.Amount(), true)
+	d.ended = d.result
+	return d.result, nil
+}
+
+// Close ends the auction and returns the final result
+// If nobody has accepted, the lot goes unsold: the result has no winner and ReserveMet is false
+func (d *DutchAuction) Close() *models.BidResult {
+	d.mu.Lock()
+	defer d.unlock()
+	d.expire(d.clock.Now())
+	if d.result == nil {
+		d.withdraw()
+	}
+	return d.result
+}
+
+// expire withdraws the lot once the floor price has been offered for one interval
+func (d *DutchAuction) expire(now time.Time) {
+	if d.result == nil && !now.Before(d.EndTime()) {
+		d.withdraw()
+	}
+}
+
+// withdraw ends the auction without a sale, recording the floor price as the unmet reserve
+func (d *DutchAuction) withdraw() {
+	floor := d.schedule.FloorPrice
+	result := models.NewBidResultInCurrency(nil, 0, floor.Currency(), 0, 0, nil)
+	result.Format = models.FormatDutch
+	d.result = result.WithReserve(floor.Amount(), false)
+	d.ended = d.result
+}
+
+// unlock releases the auction and then reports a newly ended auction to the service's observers,
+// outside the lock so observers may read the auction's status
+func (d *DutchAuction) unlock() {
+	result := d.ended
+	d.ended = nil
+	d.mu.Unlock()
+	if result != nil {
+		d.service.announce(result)
+	}
+}
+
+// checkOpen returns an error if the auction does not accept bidders at the given time
+func (d *DutchAuction) checkOpen(now time.Time) error {
+	var auctionErr *models.AuctionError
+	switch {
+	case d.result != nil:
+		auctionErr = models.NewAuctionError(models.ErrorTypeValidation, "auction is closed", nil)
+		auctionErr.WithCode(models.CodeDutchClosed)
+		if d.result.Winner != nil {
+			auctionErr.AddContext("winner_id", d.result.Winner.ID)
+		}
+	case now.Before(d.startTime):
+		auctionErr = models.NewAuctionError(models.ErrorTypeValidation, "auction has not started", nil)
+		auctionErr.WithCode(models.CodeDutchNotStarted)
+		auctionErr.AddContext("start_time", d.startTime.Format(time.RFC3339))
+	default:
+		return nil
+	}
+	auctionErr.WithOperation("Accept")
+	auctionErr.AddContext("lot_id", d.lotID)
+	return auctionErr
+}
@@ -0,0 +1,324 @@
+package auction
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/clocktest"
+	"auction-bidding-algorithm/internal/models"
+)
+
+// newTestDutchAuction opens a Dutch auction at the clock's time, falling from 100.00 by 15.00 a minute to 50.00
+func newTestDutchAuction(t *testing.T, clock models.Clock) *DutchAuction {
+	t.Helper()
+	schedule, err := models.NewDutchSchedule(models.Dollars(100), models.Dollars(50), models.Dollars(15), time.Minute)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	service := NewAuctionService(WithConfig(models.NewAuctionConfigWithFormat(models.FormatDutch)))
+	auction, err := NewDutchAuctionWithClock("lot-7", clock.Now(), schedule, service, clock)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	return auction
+}
+
+func TestDutchAuction_Accept(t *testing.T) {
+	tests := []struct {
+		name     string
+		wait     time.Duration
+		expected models.Money
+	}{
+		{"at opening", 0, models.Dollars(100)},
+		{"after one drop", 90 * time.Second, models.Dollars(85)},
+		{"after three drops", 3 * time.Minute, models.Dollars(55)},
+		{"at the floor", 4*time.Minute + 30*time.Second, models.Dollars(50)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC))
+			auction := newTestDutchAuction(t, clock)
+			accepted := clock.Advance(tt.wait)
+
+			result, err := auction.Accept(models.Bidder{ID: "grocer", Name: "Corner Grocer"})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result.Winner == nil || result.Winner.ID != "grocer" {
+				t.Fatalf("Expected the grocer to win, got %+v", result.Winner)
+			}
+			if !result.WinningBid.Equal(tt.expected) || !result.Winner.MaxBid.Equal(tt.expected) {
+				t.Errorf("Expected clearing price %s, got %s", tt.expected, result.WinningBid)
+			}
+			if !result.Winner.EntryTime.Equal(accepted) {
+				t.Errorf("Expected the entry time to be the acceptance time, got %s", result.Winner.EntryTime)
+			}
+			if result.Format != models.FormatDutch || !result.ReserveMet || result.TotalBidders != 1 {
+				t.Errorf("Unexpected result: %+v", result)
+			}
+			if !auction.IsClosed() || auction.Result() != result {
+				t.Error("Expected the auction to close on acceptance")
+			}
+		})
+	}
+}
+
+func TestDutchAuction_Status(t *testing.T) {
+	start := time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC)
+	clock := clocktest.NewFakeClock(start.Add(-time.Minute))
+	schedule, _ := models.NewDutchSchedule(models.Dollars(100), models.Dollars(50), models.Dollars(15), time.Minute)
+	auction, err := NewDutchAuctionWithClock("lot-7", start, schedule, NewAuctionService(), clock)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	steps := []struct {
+		at       time.Duration
+		price    models.Money
+		nextDrop time.Duration // Zero once the floor is reached
+	}{
+		{-time.Minute, models.Dollars(100), time.Minute},
+		{30 * time.Second, models.Dollars(100), time.Minute},
+		{time.Minute, models.Dollars(85), 2 * time.Minute},
+		{4 * time.Minute, models.Dollars(50), 0},
+	}
+	for _, step := range steps {
+		clock.Set(start.Add(step.at))
+		status := auction.Status()
+		if !status.CurrentPrice.Equal(step.price) || !auction.CurrentPrice().Equal(step.price) {
+			t.Errorf("Expected price %s at %s, got %s", step.price, step.at, status.CurrentPrice)
+		}
+		expectedDrop := time.Time{}
+		if step.nextDrop > 0 {
+			expectedDrop = start.Add(step.nextDrop)
+		}
+		if !status.NextDrop.Equal(expectedDrop) {
+			t.Errorf("Expected next drop %s at %s, got %s", expectedDrop, step.at, status.NextDrop)
+		}
+		if status.Closed || status.Winner != nil {
+			t.Errorf("Expected the auction to be open at %s, got %+v", step.at, status)
+		}
+	}
+}
+
+func TestDutchAuction_AcceptRefused(t *testing.T) {
+	start := time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC)
+	schedule, _ := models.NewDutchSchedule(models.Dollars(100), models.Dollars(50), models.Dollars(15), time.Minute)
+
+	tests := []struct {
+		name    string
+		at      time.Duration
+		prepare func(auction *DutchAuction)
+		code    models.ErrorCode
+	}{
+		{"before the start", -time.Second, nil, models.CodeDutchNotStarted},
+		{"after the floor interval", 5 * time.Minute, nil, models.CodeDutchClosed},
+		{"after another bidder accepted", time.Minute, func(auction *DutchAuction) {
+			auction.Accept(models.Bidder{ID: "first", Name: "First"})
+		}, models.CodeDutchClosed},
+		{"after close", time.Minute, func(auction *DutchAuction) { auction.Close() }, models.CodeDutchClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := clocktest.NewFakeClock(start.Add(tt.at))
+			auction, err := NewDutchAuctionWithClock("lot-7", start, schedule, NewAuctionService(WithConfig(models.NewAuctionConfigWithFormat(models.FormatDutch))), clock)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if tt.prepare != nil {
+				tt.prepare(auction)
+			}
+
+			_, err = auction.Accept(models.Bidder{ID: "late", Name: "Late"})
+			if !errors.Is(err, models.ErrValidation) {
+				t.Fatalf("Expected a validation error, got: %v", err)
+			}
+			if !models.HasCode(err, tt.code) {
+				t.Errorf("Expected code %s, got %s", tt.code, models.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestDutchAuction_AcceptRejectsInvalidBidder(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC))
+	auction := newTestDutchAuction(t, clock)
+
+	_, err := auction.Accept(models.Bidder{ID: "anonymous"})
+	if !models.HasCode(err, models.CodeBidderNameRequired) {
+		t.Fatalf("Expected code %s, got: %v", models.CodeBidderNameRequired, err)
+	}
+	if auction.IsClosed() {
+		t.Error("Expected a rejected acceptance to leave the auction open")
+	}
+	if _, err := auction.Accept(models.Bidder{ID: "named", Name: "Named"}); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
+
+func TestDutchAuction_Unsold(t *testing.T) {
+	start := time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		at    time.Duration
+		close bool
+	}{
+		{"closed early", time.Minute, true},
+		{"withdrawn after the floor interval", 5 * time.Minute, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := clocktest.NewFakeClock(start)
+			auction := newTestDutchAuction(t, clock)
+			clock.Set(start.Add(tt.at))
+
+			result := auction.Result()
+			if tt.close {
+				if result != nil {
+					t.Fatalf("Expected no result while open, got %+v", result)
+				}
+				result = auction.Close()
+			}
+			if result == nil || result.Winner != nil || result.ReserveMet {
+				t.Fatalf("Expected the lot to go unsold, got %+v", result)
+			}
+			if !result.ReservePrice.Equal(models.Dollars(50)) || result.Format != models.FormatDutch {
+				t.Errorf("Expected the floor as the unmet reserve, got %+v", result)
+			}
+			if !auction.Status().Closed {
+				t.Error("Expected the auction to be closed")
+			}
+		})
+	}
+}
+
+// statusObserver reads the auction's status when it hears the result, counting resolutions
+type statusObserver struct {
+	recordingObserver
+	auction  *DutchAuction
+	resolved []*models.BidResult
+	statuses []*DutchStatus
+}
+
+func (so *statusObserver) OnAuctionResolved(result *models.BidResult) error {
+	so.resolved = append(so.resolved, result)
+	so.statuses = append(so.statuses, so.auction.Status())
+	return nil
+}
+
+func TestDutchAuction_Observers(t *testing.T) {
+	start := time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC)
+	schedule, _ := models.NewDutchSchedule(models.Dollars(100), models.Dollars(50), models.Dollars(15), time.Minute)
+
+	tests := []struct {
+		name   string
+		end    func(auction *DutchAuction, clock *clocktest.FakeClock)
+		winner string
+	}{
+		{"accepted", func(auction *DutchAuction, clock *clocktest.FakeClock) {
+			clock.Advance(time.Minute)
+			auction.Accept(models.Bidder{ID: "anonymous"})
+			auction.Accept(models.Bidder{ID: "grocer", Name: "Grocer"})
+			auction.Accept(models.Bidder{ID: "late", Name: "Late"})
+			auction.Close()
+		}, "grocer"},
+		{"closed", func(auction *DutchAuction, clock *clocktest.FakeClock) {
+			auction.Close()
+			auction.Close()
+		}, ""},
+		{"withdrawn at the floor", func(auction *DutchAuction, clock *clocktest.FakeClock) {
+			clock.Advance(5 * time.Minute)
+			auction.IsClosed()
+			auction.Result()
+		}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := clocktest.NewFakeClock(start)
+			observer := &statusObserver{}
+			service := NewAuctionService(WithConfig(models.NewAuctionConfigWithFormat(models.FormatDutch)), WithObserver(observer))
+			auction, err := NewDutchAuctionWithClock("lot-7", start, schedule, service, clock)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			observer.auction = auction
+
+			tt.end(auction, clock)
+
+			if len(observer.resolved) != 1 {
+				t.Fatalf("Expected one resolution, got %d", len(observer.resolved))
+			}
+			resolved := observer.resolved[0]
+			if resolved.Format != models.FormatDutch {
+				t.Errorf("Expected format %s, got %s", models.FormatDutch, resolved.Format)
+			}
+			if tt.winner == "" {
+				if resolved.Winner != nil || resolved.ReserveMet {
+					t.Errorf("Expected observers to hear an unsold lot, got %+v", resolved)
+				}
+			} else if resolved.Winner == nil || resolved.Winner.ID != tt.winner {
+				t.Errorf("Expected observers to hear winner %s, got %+v", tt.winner, resolved.Winner)
+			}
+			if !observer.statuses[0].Closed {
+				t.Error("Expected observers to see the auction closed")
+			}
+			if tt.name == "accepted" && observer.validationFailures != 1 {
+				t.Errorf("Expected one validation failure, got %d", observer.validationFailures)
+			}
+		})
+	}
+}
+
+func TestDutchAuction_ConcurrentAccept(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 6, 0, 0, 0, time.UTC))
+	auction := newTestDutchAuction(t, clock)
+	clock.Advance(2 * time.Minute)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	winners := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := auction.Accept(models.Bidder{ID: string(rune('a' + i)), Name: "Bidder"}); err == nil {
+				mu.Lock()
+				winners++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if winners != 1 {
+		t.Errorf("Expected exactly one bidder to win, got %d", winners)
+	}
+	if result := auction.Result(); result == nil || !result.WinningBid.Equal(models.Dollars(70)) {
+		t.Errorf("Expected the lot to sell at 70.00, got %+v", result)
+	}
+}
+
+func TestNewDutchAuction(t *testing.T) {
+	start := time.Now()
+	auction, err := NewDutchAuction("lot-7", start, models.DutchSchedule{StartPrice: models.Dollars(10), FloorPrice: models.Dollars(1), Step: models.Dollars(1), Interval: time.Hour})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if auction.LotID() != "lot-7" || !auction.StartTime().Equal(start) || !auction.EndTime().Equal(start.Add(10*time.Hour)) {
+		t.Errorf("Unexpected auction: %s from %s to %s", auction.LotID(), auction.StartTime(), auction.EndTime())
+	}
+	if _, err := auction.Accept(models.Bidder{ID: "a", Name: "A"}); err != nil {
+		t.Errorf("Expected the default validator to accept bidders without an increment, got: %v", err)
+	}
+
+	_, err = NewDutchAuction("lot-8", start, models.DutchSchedule{StartPrice: models.Dollars(10)})
+	if !models.HasCode(err, models.CodeDutchScheduleInvalid) {
+		t.Errorf("Expected code %s, got: %v", models.CodeDutchScheduleInvalid, err)
+	}
+}
@@ -0,0 +1,139 @@
+// Package analysis flags suspicious bidding patterns, such as shill bidding and collusion, in settled auctions
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// DefaultThreshold is the lowest score an alert needs to be reported, unless WithThreshold changes it
+const DefaultThreshold = 0.5
+
+// AlertKind identifies the pattern an alert reports
+type AlertKind string
+
+const (
+	// AlertSellerLinked reports a bidder linked to the lot's seller
+	AlertSellerLinked AlertKind = "SELLER_LINKED_BIDDER"
+	// AlertShadowBid reports a losing maximum bid exactly one increment below the winner's maximum
+	AlertShadowBid AlertKind = "SHADOW_MAX_BID"
+	// AlertClosedCluster reports a group of bidders that only ever bid against each other
+	AlertClosedCluster AlertKind = "CLOSED_BIDDER_CLUSTER"
+)
+
+// AuctionRecord is one settled auction as the analyzer consumes it
+type AuctionRecord struct {
+	LotID    string            `json:"lot_id"`
+	SellerID string            `json:"seller_id,omitempty"` // Account that listed the lot (empty when unknown)
+	Result   *models.BidResult `json:"result"`              // Final result, including the audit trail when one was recorded
+}
+
+// Alert is a scored finding; a higher Score means a stronger signal, from 0 to 1
+type Alert struct {
+	Detector  string    `json:"detector"`            // Name of the detector that raised the alert
+	Kind      AlertKind `json:"kind"`                // Pattern found
+	Score     float64   `json:"score"`               // Strength of the signal, from 0 to 1
+	LotIDs    []string  `json:"lot_ids"`             // Auctions the pattern was found in
+	BidderIDs []string  `json:"bidder_ids"`          // Bidders involved
+	Reason    string    `json:"reason"`              // Human-readable explanation
+	SellerID  string    `json:"seller_id,omitempty"` // Seller involved, for seller-linked alerts
+}
+
+// Detector looks for one suspicious pattern across a set of auctions
+type Detector interface {
+	// Name identifies the detector in the alerts it raises
+	Name() string
+	// Detect returns every finding in records, whatever its score
+	Detect(records []AuctionRecord) []Alert
+}
+
+// Analyzer runs detectors over settled auctions and reports the alerts that reach its threshold
+type Analyzer struct {
+	detectors []Detector
+	threshold float64
+}
+
+// AnalyzerOption configures an Analyzer created by NewAnalyzer
+type AnalyzerOption func(*Analyzer)
+
+// WithDetector adds a detector; detectors run in the order they were added
+func WithDetector(detector Detector) AnalyzerOption {
+	return func(a *Analyzer) {
+		if detector != nil {
+			a.detectors = append(a.detectors, detector)
+		}
+	}
+}
+
+// WithThreshold sets the lowest score reported; values outside 0 to 1 keep DefaultThreshold
+func WithThreshold(threshold float64) AnalyzerOption {
+	return func(a *Analyzer) {
+		if threshold >= 0 && threshold <= 1 {
+			a.threshold = threshold
+		}
+	}
+}
+
+// NewAnalyzer creates a new Analyzer configured by the given options
+// Without WithDetector it runs DefaultDetectors
+func NewAnalyzer(opts ...AnalyzerOption) *Analyzer {
+	analyzer := &Analyzer{threshold: DefaultThreshold}
+	for _, opt := range opts {
+		opt(analyzer)
+	}
+	if len(analyzer.detectors) == 0 {
+		analyzer.detectors = DefaultDetectors()
+	}
+	return analyzer
+}
+
+// DefaultDetectors returns the detectors that need no outside data: shadow bids and closed clusters
+// Seller links come from the marketplace, so NewSellerLinkDetector must be added explicitly
+func DefaultDetectors() []Detector {
+	return []Detector{NewShadowBidDetector(), NewClusterDetector(DefaultMinSharedAuctions, DefaultMaxClusterSize)}
+}
+
+// Threshold returns the lowest score the analyzer reports
+func (a *Analyzer) Threshold() float64 {
+	return a.threshold
+}
+
+// Analyze runs every detector over records and returns the alerts scoring at least the threshold
+// Records without a result are skipped. Alerts are ordered by descending score, then by kind, lot and bidders.
+func (a *Analyzer) Analyze(records []AuctionRecord) []Alert {
+	settled := make([]AuctionRecord, 0, len(records))
+	for _, record := range records {
+		if record.Result != nil {
+			settled = append(settled, record)
+		}
+	}
+
+	var alerts []Alert
+	for _, detector := range a.detectors {
+		for _, alert := range detector.Detect(settled) {
+			if alert.Score < a.threshold {
+				continue
+			}
+			if alert.Detector == "" {
+				alert.Detector = detector.Name()
+			}
+			alerts = append(alerts, alert)
+		}
+	}
+
+	sort.SliceStable(alerts, func(i, j int) bool {
+		if alerts[i].Score != alerts[j].Score {
+			return alerts[i].Score > alerts[j].Score
+		}
+		if alerts[i].Kind != alerts[j].Kind {
+			return alerts[i].Kind < alerts[j].Kind
+		}
+		if lotsI, lotsJ := strings.Join(alerts[i].LotIDs, ","), strings.Join(alerts[j].LotIDs, ","); lotsI != lotsJ {
+			return lotsI < lotsJ
+		}
+		return strings.Join(alerts[i].BidderIDs, ",") < strings.Join(alerts[j].BidderIDs, ",")
+	})
+	return alerts
+}
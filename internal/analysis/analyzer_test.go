@@ -0,0 +1,93 @@
+package analysis
+
+import "testing"
+
+// fixedDetector raises the alerts it was given
+type fixedDetector struct {
+	alerts []Alert
+}
+
+func (d fixedDetector) Name() string { return "fixed" }
+
+func (d fixedDetector) Detect(records []AuctionRecord) []Alert { return d.alerts }
+
+func TestNewAnalyzer_Defaults(t *testing.T) {
+	analyzer := NewAnalyzer()
+	if analyzer.Threshold() != DefaultThreshold {
+		t.Errorf("Expected threshold %v, got %v", DefaultThreshold, analyzer.Threshold())
+	}
+	if len(analyzer.detectors) != len(DefaultDetectors()) {
+		t.Errorf("Expected the default detectors, got %d", len(analyzer.detectors))
+	}
+
+	for _, threshold := range []float64{-0.1, 1.5} {
+		if got := NewAnalyzer(WithThreshold(threshold)).Threshold(); got != DefaultThreshold {
+			t.Errorf("Expected threshold %v to keep the default, got %v", threshold, got)
+		}
+	}
+}
+
+func TestAnalyzer_Threshold(t *testing.T) {
+	detector := fixedDetector{alerts: []Alert{
+		{Kind: AlertShadowBid, Score: 0.4, LotIDs: []string{"lot1"}},
+		{Kind: AlertShadowBid, Score: 0.9, LotIDs: []string{"lot2"}},
+		{Kind: AlertClosedCluster, Score: 0.9, LotIDs: []string{"lot3"}},
+		{Kind: AlertSellerLinked, Score: 0.7, LotIDs: []string{"lot4"}, Detector: "custom"},
+	}}
+
+	tests := []struct {
+		name         string
+		threshold    float64
+		expectedLots []string
+	}{
+		{"default threshold", DefaultThreshold, []string{"lot3", "lot2", "lot4"}},
+		{"strict threshold", 0.8, []string{"lot3", "lot2"}},
+		{"report everything", 0, []string{"lot3", "lot2", "lot4", "lot1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts := NewAnalyzer(WithDetector(detector), WithThreshold(tt.threshold)).Analyze([]AuctionRecord{{LotID: "lot1"}})
+			if len(alerts) != len(tt.expectedLots) {
+				t.Fatalf("Expected %d alerts, got %d: %+v", len(tt.expectedLots), len(alerts), alerts)
+			}
+			for i, lotID := range tt.expectedLots {
+				if alerts[i].LotIDs[0] != lotID {
+					t.Errorf("Expected alert %d for %s, got %s", i, lotID, alerts[i].LotIDs[0])
+				}
+			}
+			for _, alert := range alerts {
+				expected := "fixed"
+				if alert.LotIDs[0] == "lot4" {
+					expected = "custom"
+				}
+				if alert.Detector != expected {
+					t.Errorf("Expected detector %q, got %q", expected, alert.Detector)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyzer_Analyze(t *testing.T) {
+	analyzer := NewAnalyzer(
+		WithDetector(NewSellerLinkDetector(StaticSellerLinks{"seller1": {"shill"}})),
+		WithDetector(NewShadowBidDetector()),
+	)
+	records := []AuctionRecord{
+		record("lot1", "seller1", 200, bidder{"alice", 200, 5}, bidder{"shill", 195, 1}),
+		record("lot2", "seller2", 105, bidder{"bob", 200, 5}, bidder{"carol", 100, 5}),
+		{LotID: "unsettled"},
+	}
+
+	alerts := analyzer.Analyze(records)
+	if len(alerts) != 2 {
+		t.Fatalf("Expected 2 alerts, got %d: %+v", len(alerts), alerts)
+	}
+	if alerts[0].Kind != AlertSellerLinked || alerts[0].Score != 1 {
+		t.Errorf("Expected the seller-linked underbidder first, got %+v", alerts[0])
+	}
+	if alerts[1].Kind != AlertShadowBid || alerts[1].Detector != DetectorShadowBid {
+		t.Errorf("Expected the shadow bid second, got %+v", alerts[1])
+	}
+}
@@ -0,0 +1,105 @@
+package analysis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// LoadRecords reads auction records from JSON files for offline analysis
+// A file holds one AuctionRecord or an array of them; a directory contributes its *.json files in name order
+func LoadRecords(paths ...string) ([]AuctionRecord, error) {
+	var records []AuctionRecord
+	for _, path := range paths {
+		files, err := recordFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			fileRecords, err := loadRecordFile(file)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, fileRecords...)
+		}
+	}
+	return records, nil
+}
+
+// AnalyzeFiles loads the records in paths, as LoadRecords does, and analyzes them together
+func (a *Analyzer) AnalyzeFiles(paths ...string) ([]Alert, error) {
+	records, err := LoadRecords(paths...)
+	if err != nil {
+		return nil, err
+	}
+	return a.Analyze(records), nil
+}
+
+// recordFiles returns path itself, or the JSON files in it when path is a directory
+func recordFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, unreadableFileError(path, err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return nil, unreadableFileError(path, err)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// loadRecordFile decodes the records in one file
+func loadRecordFile(path string) ([]AuctionRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, unreadableFileError(path, err)
+	}
+
+	var records []AuctionRecord
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &records)
+	} else {
+		var record AuctionRecord
+		err = json.Unmarshal(trimmed, &record)
+		records = []AuctionRecord{record}
+	}
+	if err != nil {
+		return nil, malformedRecordError(path, -1, "malformed auction record file", err)
+	}
+
+	for i, record := range records {
+		if record.Result == nil {
+			return nil, malformedRecordError(path, i, "auction record has no result", nil)
+		}
+	}
+	return records, nil
+}
+
+// unreadableFileError reports a path that could not be read
+func unreadableFileError(path string, cause error) error {
+	inputErr := models.NewInputError("cannot read auction records", "path", path)
+	inputErr.Cause = cause
+	inputErr.WithOperation("LoadRecords").WithCode(models.CodeAnalysisUnreadableFile)
+	return inputErr
+}
+
+// malformedRecordError reports a file whose content is not a valid record set; index is -1 for the whole file
+func malformedRecordError(path string, index int, msg string, cause error) error {
+	inputErr := models.NewInputError(msg, "path", path)
+	inputErr.Cause = cause
+	inputErr.WithOperation("LoadRecords").WithCode(models.CodeAnalysisMalformedRecord)
+	if index >= 0 {
+		inputErr.AddContext("record_index", fmt.Sprintf("%d", index))
+	}
+	return inputErr
+}
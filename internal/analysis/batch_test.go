@@ -0,0 +1,121 @@
+package analysis
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// writeRecords writes v as JSON to name in dir
+func writeRecords(t *testing.T, dir, name string, v any) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	return path
+}
+
+func TestLoadRecords(t *testing.T) {
+	dir := t.TempDir()
+	single := writeRecords(t, dir, "a.json", record("lot1", "seller1", 200, bidder{"alice", 200, 5}, bidder{"shill", 195, 1}))
+	writeRecords(t, dir, "b.json", []AuctionRecord{
+		record("lot2", "", 80, bidder{"bob", 80, 10}, bidder{"shill", 70, 1}),
+		record("lot3", "", 105, bidder{"carol", 200, 5}, bidder{"dave", 100, 5}),
+	})
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a record"), 0o600); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	records, err := LoadRecords(single)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(records) != 1 || records[0].SellerID != "seller1" || records[0].Result.Winner.ID != "alice" {
+		t.Errorf("Expected the single record to round trip, got %+v", records)
+	}
+	if !records[0].Result.AllBidders[1].MaxBid.Equal(models.Dollars(195)) {
+		t.Errorf("Expected bidder amounts to round trip, got %s", records[0].Result.AllBidders[1].MaxBid)
+	}
+
+	records, err = LoadRecords(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(records) != 3 || records[0].LotID != "lot1" || records[2].LotID != "lot3" {
+		t.Errorf("Expected the directory's JSON files in name order, got %d records", len(records))
+	}
+}
+
+func TestLoadRecords_Errors(t *testing.T) {
+	dir := t.TempDir()
+	malformed := filepath.Join(dir, "malformed.json")
+	if err := os.WriteFile(malformed, []byte(`{"lot_id": `), 0o600); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	missingResult := writeRecords(t, dir, "missing.json", []AuctionRecord{{LotID: "lot1"}})
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedCode models.ErrorCode
+	}{
+		{"missing file", filepath.Join(dir, "absent.json"), models.CodeAnalysisUnreadableFile},
+		{"malformed file", malformed, models.CodeAnalysisMalformedRecord},
+		{"record without result", missingResult, models.CodeAnalysisMalformedRecord},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRecords(tt.path)
+			if !errors.Is(err, models.ErrInput) {
+				t.Fatalf("Expected an input error, got: %v", err)
+			}
+			if models.CodeOf(err) != tt.expectedCode {
+				t.Errorf("Expected code %s, got %s", tt.expectedCode, models.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestAnalyzer_AnalyzeFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeRecords(t, dir, "2024-05.json", []AuctionRecord{
+		record("lot1", "", 200, bidder{"alice", 200, 5}, bidder{"shill", 195, 1}),
+	})
+	writeRecords(t, dir, "2024-06.json", []AuctionRecord{
+		record("lot2", "", 80, bidder{"bob", 80, 10}, bidder{"shill", 70, 1}),
+	})
+
+	// Neither month alone reaches the threshold; the history together does
+	analyzer := NewAnalyzer(WithDetector(NewShadowBidDetector()), WithThreshold(0.7))
+	for _, month := range []string{"2024-05.json", "2024-06.json"} {
+		alerts, err := analyzer.AnalyzeFiles(filepath.Join(dir, month))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(alerts) != 0 {
+			t.Errorf("Expected no alerts for %s alone, got %+v", month, alerts)
+		}
+	}
+
+	alerts, err := analyzer.AnalyzeFiles(dir)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].BidderIDs[0] != "shill" || alerts[0].Score != 0.75 {
+		t.Errorf("Expected one shadow bid alert for shill scoring 0.75, got %+v", alerts)
+	}
+
+	if _, err := analyzer.AnalyzeFiles(filepath.Join(dir, "absent.json")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
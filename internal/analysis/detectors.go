@@ -0,0 +1,296 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// Detector names
+const (
+	DetectorSellerLink = "seller_link"
+	DetectorShadowBid  = "shadow_bid"
+	DetectorCluster    = "closed_cluster"
+)
+
+// Cluster detector defaults used by DefaultDetectors
+const (
+	DefaultMinSharedAuctions = 3 // Auctions a group must share before it is reported
+	DefaultMaxClusterSize    = 4 // Largest group considered a cluster rather than an ordinary market
+)
+
+// SellerLinks reports whether a bidder account is linked to a seller, for example by payment details or address
+type SellerLinks interface {
+	Linked(sellerID, bidderID string) bool
+}
+
+// StaticSellerLinks maps each seller ID to the bidder IDs linked to it
+type StaticSellerLinks map[string][]string
+
+// Linked returns true if bidderID is the seller's own ID or listed for the seller
+func (l StaticSellerLinks) Linked(sellerID, bidderID string) bool {
+	if sellerID == bidderID {
+		return true
+	}
+	for _, linked := range l[sellerID] {
+		if linked == bidderID {
+			return true
+		}
+	}
+	return false
+}
+
+// SellerLinkDetector flags bidders linked to the seller of the lot they bid on
+// A linked underbidder, who set the price the winner paid, scores 1; a linked winner 0.8; any other linked bidder 0.6
+type SellerLinkDetector struct {
+	links SellerLinks
+}
+
+// NewSellerLinkDetector creates a new SellerLinkDetector that looks links up in links
+func NewSellerLinkDetector(links SellerLinks) *SellerLinkDetector {
+	return &SellerLinkDetector{links: links}
+}
+
+// Name returns DetectorSellerLink
+func (d *SellerLinkDetector) Name() string {
+	return DetectorSellerLink
+}
+
+// Detect raises an alert for every linked bidder in an auction with a known seller
+func (d *SellerLinkDetector) Detect(records []AuctionRecord) []Alert {
+	if d.links == nil {
+		return nil
+	}
+
+	var alerts []Alert
+	for _, record := range records {
+		if record.SellerID == "" {
+			continue
+		}
+		winnerID := winnerIDOf(record.Result)
+		underbidderID := underbidderIDOf(record.Result)
+		for _, bidder := range record.Result.AllBidders {
+			if !d.links.Linked(record.SellerID, bidder.ID) {
+				continue
+			}
+			score, role := 0.6, "bid on"
+			switch bidder.ID {
+			case underbidderID:
+				score, role = 1, "set the price of"
+			case winnerID:
+				score, role = 0.8, "won"
+			}
+			alerts = append(alerts, Alert{
+				Detector:  d.Name(),
+				Kind:      AlertSellerLinked,
+				Score:     score,
+				LotIDs:    []string{record.LotID},
+				BidderIDs: []string{bidder.ID},
+				SellerID:  record.SellerID,
+				Reason:    fmt.Sprintf("bidder %s, linked to seller %s, %s lot %s", bidder.ID, record.SellerID, role, record.LotID),
+			})
+		}
+	}
+	return alerts
+}
+
+// ShadowBidDetector flags losing bidders whose maximum bid sits exactly one of the winner's increments
+// below the winner's maximum, so the winner paid their full maximum
+// One occurrence scores 0.5 and each repeat by the same bidder adds 0.25, up to 1. Winners without an
+// auto-increment (a site-wide schedule supplied it) are not checked.
+type ShadowBidDetector struct{}
+
+// NewShadowBidDetector creates a new ShadowBidDetector
+func NewShadowBidDetector() *ShadowBidDetector {
+	return &ShadowBidDetector{}
+}
+
+// Name returns DetectorShadowBid
+func (d *ShadowBidDetector) Name() string {
+	return DetectorShadowBid
+}
+
+// Detect raises one alert per bidder, listing every auction they shadowed the winner in
+func (d *ShadowBidDetector) Detect(records []AuctionRecord) []Alert {
+	lots := make(map[string][]string)
+	var order []string
+	for _, record := range records {
+		winner := record.Result.Winner
+		if winner == nil || !winner.AutoIncrement.IsPositive() {
+			continue
+		}
+		for _, bidder := range record.Result.AllBidders {
+			if bidder.ID == winner.ID || !bidder.MaxBid.SameCurrency(winner.MaxBid) || !winner.AutoIncrement.SameCurrency(winner.MaxBid) {
+				continue
+			}
+			if bidder.MaxBid.Amount()+winner.AutoIncrement.Amount() != winner.MaxBid.Amount() {
+				continue
+			}
+			if _, seen := lots[bidder.ID]; !seen {
+				order = append(order, bidder.ID)
+			}
+			lots[bidder.ID] = append(lots[bidder.ID], record.LotID)
+		}
+	}
+
+	alerts := make([]Alert, 0, len(order))
+	for _, bidderID := range order {
+		shadowed := lots[bidderID]
+		alerts = append(alerts, Alert{
+			Detector:  d.Name(),
+			Kind:      AlertShadowBid,
+			Score:     math.Min(1, 0.5+0.25*float64(len(shadowed)-1)),
+			LotIDs:    shadowed,
+			BidderIDs: []string{bidderID},
+			Reason:    fmt.Sprintf("bidder %s bid exactly one increment below the winner's maximum in %d auctions", bidderID, len(shadowed)),
+		})
+	}
+	return alerts
+}
+
+// ClusterDetector flags small groups of bidders that only ever bid against each other
+// Bidders who meet in an auction belong to the same group, so an honest market forms one large group;
+// a group of at most maxSize bidders sharing at least minShared auctions is reported. The score
+// starts at 0.5 and halves its distance to 1 with every further shared auction.
+type ClusterDetector struct {
+	minShared int
+	maxSize   int
+}
+
+// NewClusterDetector creates a new ClusterDetector; values below 1 (or below 2 for maxSize) keep the defaults
+func NewClusterDetector(minShared, maxSize int) *ClusterDetector {
+	if minShared < 1 {
+		minShared = DefaultMinSharedAuctions
+	}
+	if maxSize < 2 {
+		maxSize = DefaultMaxClusterSize
+	}
+	return &ClusterDetector{minShared: minShared, maxSize: maxSize}
+}
+
+// Name returns DetectorCluster
+func (d *ClusterDetector) Name() string {
+	return DetectorCluster
+}
+
+// Detect groups bidders who met in any auction and raises an alert for every small, closed group
+func (d *ClusterDetector) Detect(records []AuctionRecord) []Alert {
+	groups := newUnionFind()
+	for _, record := range records {
+		bidders := record.Result.AllBidders
+		if len(bidders) < 2 {
+			continue
+		}
+		for _, bidder := range bidders[1:] {
+			groups.union(bidders[0].ID, bidder.ID)
+		}
+	}
+
+	members := make(map[string][]string)
+	lots := make(map[string][]string)
+	for _, bidderID := range groups.ids() {
+		root := groups.find(bidderID)
+		members[root] = append(members[root], bidderID)
+	}
+	for _, record := range records {
+		if bidders := record.Result.AllBidders; len(bidders) >= 2 {
+			root := groups.find(bidders[0].ID)
+			lots[root] = append(lots[root], record.LotID)
+		}
+	}
+
+	roots := make([]string, 0, len(members))
+	for root := range members {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+
+	var alerts []Alert
+	for _, root := range roots {
+		group, shared := members[root], lots[root]
+		if len(group) > d.maxSize || len(shared) < d.minShared {
+			continue
+		}
+		alerts = append(alerts, Alert{
+			Detector:  d.Name(),
+			Kind:      AlertClosedCluster,
+			Score:     1 - math.Pow(0.5, float64(len(shared)-d.minShared+1)),
+			LotIDs:    shared,
+			BidderIDs: group,
+			Reason:    fmt.Sprintf("%d bidders met only each other across %d auctions", len(group), len(shared)),
+		})
+	}
+	return alerts
+}
+
+// winnerIDOf returns the winner's ID, or "" when the auction has no winner
+func winnerIDOf(result *models.BidResult) string {
+	if result.Winner == nil {
+		return ""
+	}
+	return result.Winner.ID
+}
+
+// underbidderIDOf returns the losing bidder with the highest maximum bid, whose maximum set the price
+func underbidderIDOf(result *models.BidResult) string {
+	winnerID := winnerIDOf(result)
+	underbidder := ""
+	var highest int64
+	for _, bidder := range result.AllBidders {
+		if bidder.ID == winnerID {
+			continue
+		}
+		if underbidder == "" || bidder.MaxBid.Amount() > highest {
+			underbidder, highest = bidder.ID, bidder.MaxBid.Amount()
+		}
+	}
+	return underbidder
+}
+
+// unionFind groups bidder IDs into disjoint sets
+type unionFind struct {
+	parent map[string]string
+}
+
+func newUnionFind() *unionFind {
+	return &unionFind{parent: make(map[string]string)}
+}
+
+// find returns the representative of id's set, adding id as a set of its own when it is new
+func (u *unionFind) find(id string) string {
+	parent, ok := u.parent[id]
+	if !ok {
+		u.parent[id] = id
+		return id
+	}
+	if parent == id {
+		return id
+	}
+	root := u.find(parent)
+	u.parent[id] = root
+	return root
+}
+
+// union merges the sets of a and b, keeping the smaller ID as the representative so results are reproducible
+func (u *unionFind) union(a, b string) {
+	rootA, rootB := u.find(a), u.find(b)
+	if rootA == rootB {
+		return
+	}
+	if rootB < rootA {
+		rootA, rootB = rootB, rootA
+	}
+	u.parent[rootB] = rootA
+}
+
+// ids returns every ID seen, sorted
+func (u *unionFind) ids() []string {
+	ids := make([]string, 0, len(u.parent))
+	for id := range u.parent {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,186 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// bidder is a test bidder with the given maximum bid and increment in dollars
+type bidder struct {
+	id        string
+	maxBid    float64
+	increment float64
+}
+
+// record builds a settled auction won by the first bidder at price
+func record(lotID, sellerID string, price float64, bidders ...bidder) AuctionRecord {
+	all := make([]models.Bidder, len(bidders))
+	for i, b := range bidders {
+		all[i] = *models.NewBidder(b.id, strings.ToUpper(b.id), models.Dollars(1), models.Dollars(b.maxBid), models.Dollars(b.increment))
+	}
+	winner := all[0]
+	return AuctionRecord{
+		LotID:    lotID,
+		SellerID: sellerID,
+		Result:   models.NewBidResult(&winner, models.Dollars(price), len(all), 1, all),
+	}
+}
+
+func TestStaticSellerLinks(t *testing.T) {
+	links := StaticSellerLinks{"seller1": {"shill"}}
+
+	tests := []struct {
+		sellerID string
+		bidderID string
+		expected bool
+	}{
+		{"seller1", "shill", true},
+		{"seller1", "seller1", true},
+		{"seller1", "alice", false},
+		{"seller2", "shill", false},
+	}
+	for _, tt := range tests {
+		if got := links.Linked(tt.sellerID, tt.bidderID); got != tt.expected {
+			t.Errorf("Expected Linked(%s, %s) to be %v, got %v", tt.sellerID, tt.bidderID, tt.expected, got)
+		}
+	}
+}
+
+func TestSellerLinkDetector(t *testing.T) {
+	detector := NewSellerLinkDetector(StaticSellerLinks{"seller1": {"shill", "friend"}})
+	records := []AuctionRecord{
+		record("lot1", "seller1", 155, bidder{"alice", 200, 5}, bidder{"shill", 150, 5}, bidder{"friend", 100, 5}),
+		record("lot2", "seller1", 105, bidder{"friend", 200, 5}, bidder{"bob", 100, 5}),
+		record("lot3", "", 105, bidder{"shill", 200, 5}, bidder{"bob", 100, 5}),
+		record("lot4", "seller2", 105, bidder{"shill", 200, 5}, bidder{"bob", 100, 5}),
+	}
+
+	alerts := detector.Detect(records)
+	expected := map[string]float64{"lot1/shill": 1, "lot1/friend": 0.6, "lot2/friend": 0.8}
+	if len(alerts) != len(expected) {
+		t.Fatalf("Expected %d alerts, got %d: %+v", len(expected), len(alerts), alerts)
+	}
+	for _, alert := range alerts {
+		key := alert.LotIDs[0] + "/" + alert.BidderIDs[0]
+		score, ok := expected[key]
+		if !ok || alert.Score != score {
+			t.Errorf("Unexpected alert %s with score %v", key, alert.Score)
+		}
+		if alert.Kind != AlertSellerLinked || alert.SellerID != "seller1" {
+			t.Errorf("Expected a seller-linked alert for seller1, got %+v", alert)
+		}
+	}
+
+	if alerts := NewSellerLinkDetector(nil).Detect(records); len(alerts) != 0 {
+		t.Errorf("Expected no alerts without links, got %+v", alerts)
+	}
+}
+
+func TestShadowBidDetector(t *testing.T) {
+	detector := NewShadowBidDetector()
+
+	tests := []struct {
+		name          string
+		records       []AuctionRecord
+		expectedLots  []string
+		expectedScore float64
+	}{
+		{
+			name:    "ordinary underbidder",
+			records: []AuctionRecord{record("lot1", "", 155, bidder{"alice", 200, 5}, bidder{"shill", 150, 5})},
+		},
+		{
+			name:          "one shadow bid",
+			records:       []AuctionRecord{record("lot1", "", 200, bidder{"alice", 200, 5}, bidder{"shill", 195, 1})},
+			expectedLots:  []string{"lot1"},
+			expectedScore: 0.5,
+		},
+		{
+			name: "repeated shadow bids",
+			records: []AuctionRecord{
+				record("lot1", "", 200, bidder{"alice", 200, 5}, bidder{"shill", 195, 1}),
+				record("lot2", "", 80, bidder{"bob", 80, 10}, bidder{"shill", 70, 1}),
+				record("lot3", "", 300, bidder{"carol", 300, 25}, bidder{"shill", 275, 1}),
+				record("lot4", "", 400, bidder{"dave", 400, 25}, bidder{"shill", 375, 1}),
+			},
+			expectedLots:  []string{"lot1", "lot2", "lot3", "lot4"},
+			expectedScore: 1,
+		},
+		{
+			name:    "winner without an increment",
+			records: []AuctionRecord{record("lot1", "", 200, bidder{"alice", 200, 0}, bidder{"shill", 200, 1})},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts := detector.Detect(tt.records)
+			if len(tt.expectedLots) == 0 {
+				if len(alerts) != 0 {
+					t.Errorf("Expected no alerts, got %+v", alerts)
+				}
+				return
+			}
+			if len(alerts) != 1 {
+				t.Fatalf("Expected 1 alert, got %d: %+v", len(alerts), alerts)
+			}
+			alert := alerts[0]
+			if alert.Kind != AlertShadowBid || alert.BidderIDs[0] != "shill" {
+				t.Errorf("Expected a shadow bid alert for shill, got %+v", alert)
+			}
+			if strings.Join(alert.LotIDs, ",") != strings.Join(tt.expectedLots, ",") || alert.Score != tt.expectedScore {
+				t.Errorf("Expected lots %v with score %v, got %v with %v", tt.expectedLots, tt.expectedScore, alert.LotIDs, alert.Score)
+			}
+		})
+	}
+}
+
+func TestClusterDetector(t *testing.T) {
+	// x, y and z only meet each other, while alice to erin form a market too large to be a cluster
+	records := []AuctionRecord{
+		record("lot1", "", 105, bidder{"x", 200, 5}, bidder{"y", 100, 5}),
+		record("lot2", "", 105, bidder{"y", 200, 5}, bidder{"z", 100, 5}),
+		record("lot3", "", 105, bidder{"z", 200, 5}, bidder{"x", 100, 5}),
+		record("lot4", "", 105, bidder{"x", 200, 5}, bidder{"y", 100, 5}, bidder{"z", 90, 5}),
+		record("lot5", "", 105, bidder{"alice", 200, 5}, bidder{"bob", 100, 5}),
+		record("lot6", "", 105, bidder{"bob", 200, 5}, bidder{"carol", 100, 5}),
+		record("lot7", "", 105, bidder{"carol", 200, 5}, bidder{"dave", 100, 5}),
+		record("lot8", "", 105, bidder{"dave", 200, 5}, bidder{"erin", 100, 5}),
+		record("lot9", "", 1, bidder{"solo", 200, 5}),
+	}
+
+	tests := []struct {
+		name          string
+		detector      *ClusterDetector
+		expectedScore float64 // 0 when no alert is expected
+	}{
+		{"four shared auctions", NewClusterDetector(3, 3), 0.75},
+		{"exactly the minimum", NewClusterDetector(4, 3), 0.5},
+		{"too few shared auctions", NewClusterDetector(5, 3), 0},
+		{"defaults", NewClusterDetector(0, 0), 0.75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts := tt.detector.Detect(records)
+			if tt.expectedScore == 0 {
+				if len(alerts) != 0 {
+					t.Errorf("Expected no alerts, got %+v", alerts)
+				}
+				return
+			}
+			if len(alerts) != 1 {
+				t.Fatalf("Expected 1 alert, got %d: %+v", len(alerts), alerts)
+			}
+			alert := alerts[0]
+			if strings.Join(alert.BidderIDs, ",") != "x,y,z" || alert.Score != tt.expectedScore {
+				t.Errorf("Expected the x, y, z cluster with score %v, got %v with %v", tt.expectedScore, alert.BidderIDs, alert.Score)
+			}
+			if len(alert.LotIDs) != 4 || alert.Kind != AlertClosedCluster {
+				t.Errorf("Expected a cluster alert over 4 lots, got %+v", alert)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"sort"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// EngineObserver receives progress notifications while the engine settles bids
+// Calls are made synchronously from ProcessBids with copies of the engine's state
+type EngineObserver interface {
+	// RoundCompleted is called after each round in which at least one bid moved
+	RoundCompleted(round int, bidders []models.Bidder)
+	// LeaderChanged is called when an increment gives the lead to a different bidder
+	LeaderChanged(entry models.AuditEntry)
+	// BidderExhausted is called when a bidder reaches its maximum bid and can no longer increment
+	BidderExhausted(round int, bidder models.Bidder)
+}
+
+// auditLog records increments while bids are settled and tracks the leader after each step
+// The leader is the highest current bid; equal bids are settled by the engine's tie-breaker,
+// as when the winner is found
+type auditLog struct {
+	mode        models.AuditMode
+	sink        models.AuditSink
+	observer    EngineObserver
+	tieBreaker  models.TieBreaker
+	entries     []models.AuditEntry
+	leader      *models.Bidder
+	leaderCents int64
+}
+
+// newAuditLog starts a log over bidders sorted by entry priority
+// Returns nil when there is neither an audit mode nor an observer to serve
+func newAuditLog(mode models.AuditMode, sink models.AuditSink, observer EngineObserver, tieBreaker models.TieBreaker, bidders []models.Bidder) *auditLog {
+	if mode == models.AuditOff && observer == nil {
+		return nil
+	}
+	log := &auditLog{mode: mode, sink: sink, observer: observer, tieBreaker: tieBreaker, leaderCents: -1}
+	for i := range bidders {
+		if log.takesLead(&bidders[i]) {
+			log.leader = &bidders[i]
+			log.leaderCents = bidders[i].GetCurrentBidCents()
+		}
+	}
+	return log
+}
+
+// takesLead reports whether the bidder's current bid beats the leader's, settling equal bids
+// with the tie-breaker over the two bidders in entry priority order
+func (al *auditLog) takesLead(bidder *models.Bidder) bool {
+	cents := bidder.GetCurrentBidCents()
+	switch {
+	case al.leader == nil || cents > al.leaderCents:
+		return true
+	case cents < al.leaderCents || bidder == al.leader:
+		return false
+	}
+	tied := []*models.Bidder{al.leader, bidder}
+	if bidder.EnteredBefore(al.leader) {
+		tied[0], tied[1] = bidder, al.leader
+	}
+	winner, _ := models.BreakTie(tied, al.tieBreaker)
+	return winner == bidder
+}
+
+// record logs one move of a bidder's current bid from previousCents
+func (al *auditLog) record(round int, bidder *models.Bidder, previousCents int64) {
+	if al == nil {
+		return
+	}
+
+	newCents := bidder.GetCurrentBidCents()
+	leaderChanged := false
+	if al.takesLead(bidder) {
+		leaderChanged = al.leader != bidder
+		al.leader = bidder
+		al.leaderCents = newCents
+	}
+
+	entry := models.AuditEntry{
+		Round:         round,
+		BidderID:      bidder.ID,
+		PreviousCents: previousCents,
+		NewCents:      newCents,
+		LeaderID:      al.leader.ID,
+	}
+	if al.observer != nil {
+		if leaderChanged {
+			al.observer.LeaderChanged(entry)
+		}
+		if !bidder.IsActive {
+			al.observer.BidderExhausted(round, *bidder)
+		}
+	}
+
+	if al.mode == models.AuditOff || (al.mode == models.AuditLeaderChanges && !leaderChanged) {
+		return
+	}
+	al.entries = append(al.entries, entry)
+	if al.sink != nil {
+		al.sink(entry)
+	}
+}
+
+// roundCompleted notifies the observer that a round has finished
+func (al *auditLog) roundCompleted(round int, bidders []models.Bidder) {
+	if al == nil || al.observer == nil {
+		return
+	}
+	snapshot := make([]models.Bidder, len(bidders))
+	copy(snapshot, bidders)
+	al.observer.RoundCompleted(round, snapshot)
+}
+
+// recordSettlement logs the moves of a closed-form settlement as round 0 entries, lowest new bid first
+// so the leader evolves as it would while prices climb
+func (al *auditLog) recordSettlement(bidders []models.Bidder, startCents []int64) {
+	if al == nil {
+		return
+	}
+
+	moved := make([]int, 0, len(bidders))
+	for i := range bidders {
+		if bidders[i].GetCurrentBidCents() != startCents[i] {
+			moved = append(moved, i)
+		}
+	}
+	sort.SliceStable(moved, func(a, b int) bool {
+		return bidders[moved[a]].GetCurrentBidCents() < bidders[moved[b]].GetCurrentBidCents()
+	})
+	for _, i := range moved {
+		al.record(0, &bidders[i], startCents[i])
+	}
+	if len(moved) > 0 {
+		al.roundCompleted(0, bidders)
+	}
+}
+
+// trail returns the recorded entries
+func (al *auditLog) trail() []models.AuditEntry {
+	if al == nil {
+		return nil
+	}
+	return al.entries
+}
@@ -0,0 +1,137 @@
+// Package clocktest provides clocks for driving time-dependent auction behaviour in tests.
+package clocktest
+
+import (
+	"sync"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// Real returns the wall clock used in production, for tests that exercise real timing
+func Real() models.Clock {
+	return models.NewSystemClock()
+}
+
+// FakeClock is a models.TimerClock whose time only changes when the test sets or advances it
+// Timers fire synchronously, in deadline order, from the Advance or Set call that reaches them.
+// It is safe for concurrent use
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer // Pending timers
+}
+
+// NewFakeClock creates a new FakeClock stopped at the given time
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the fake clock's current time
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d, fires the timers that have come due and returns the new time
+func (c *FakeClock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	now := c.now
+	c.mu.Unlock()
+	c.fire()
+	return now
+}
+
+// Set moves the clock to t, which may be earlier than the current time, and fires the timers that have come due
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	c.now = t
+	c.mu.Unlock()
+	c.fire()
+}
+
+// AfterFunc schedules f to run once the clock has advanced by d
+// A timer that is already due fires on the next Advance or Set
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) models.Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	timer := &fakeTimer{clock: c, fn: f}
+	c.schedule(timer, d)
+	return timer
+}
+
+// Pending returns the number of timers that have not yet fired or been stopped
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// fire runs due timers one at a time without holding the lock, so they may use the clock
+func (c *FakeClock) fire() {
+	for {
+		c.mu.Lock()
+		next := -1
+		for i, timer := range c.timers {
+			if !timer.at.After(c.now) && (next < 0 || timer.at.Before(c.timers[next].at)) {
+				next = i
+			}
+		}
+		if next < 0 {
+			c.mu.Unlock()
+			return
+		}
+		timer := c.timers[next]
+		c.unschedule(timer)
+		c.mu.Unlock()
+		timer.fn()
+	}
+}
+
+// schedule sets the timer's deadline and adds it to the pending timers; the lock must be held
+func (c *FakeClock) schedule(timer *fakeTimer, d time.Duration) {
+	timer.at = c.now.Add(d)
+	if !timer.pending {
+		timer.pending = true
+		c.timers = append(c.timers, timer)
+	}
+}
+
+// unschedule removes the timer from the pending timers; the lock must be held
+func (c *FakeClock) unschedule(timer *fakeTimer) {
+	for i, pending := range c.timers {
+		if pending == timer {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			break
+		}
+	}
+	timer.pending = false
+}
+
+// fakeTimer is a call scheduled on a FakeClock
+type fakeTimer struct {
+	clock   *FakeClock
+	at      time.Time
+	fn      func()
+	pending bool
+}
+
+// Stop cancels the timer, reporting whether it was still pending
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasPending := t.pending
+	t.clock.unschedule(t)
+	return wasPending
+}
+
+// Reset reschedules the timer to fire d after the clock's current time, reporting whether it was still pending
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasPending := t.pending
+	t.clock.schedule(t, d)
+	return wasPending
+}
@@ -0,0 +1,109 @@
+package clocktest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	if !clock.Now().Equal(start) {
+		t.Errorf("Expected %v, got %v", start, clock.Now())
+	}
+	if got := clock.Advance(90 * time.Second); !got.Equal(start.Add(90 * time.Second)) {
+		t.Errorf("Expected Advance to return the new time, got %v", got)
+	}
+	if !clock.Now().Equal(start.Add(90 * time.Second)) {
+		t.Errorf("Expected clock to advance, got %v", clock.Now())
+	}
+
+	clock.Set(start)
+	if !clock.Now().Equal(start) {
+		t.Errorf("Expected Set to move the clock back, got %v", clock.Now())
+	}
+}
+
+func TestFakeClock_ConcurrentAdvance(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clock.Advance(time.Millisecond)
+			_ = clock.Now()
+		}()
+	}
+	wg.Wait()
+
+	if !clock.Now().Equal(start.Add(100 * time.Millisecond)) {
+		t.Errorf("Expected 100 advances, got %v", clock.Now().Sub(start))
+	}
+}
+
+func TestReal(t *testing.T) {
+	before := time.Now()
+	now := Real().Now()
+	if now.Before(before) || now.After(time.Now()) {
+		t.Errorf("Expected the real clock to report the wall-clock time, got %v", now)
+	}
+}
+
+func TestFakeClock_AfterFunc(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	var fired []string
+	clock.AfterFunc(2*time.Minute, func() { fired = append(fired, "late") })
+	clock.AfterFunc(time.Minute, func() { fired = append(fired, "early") })
+	stopped := clock.AfterFunc(time.Minute, func() { fired = append(fired, "stopped") })
+	if !stopped.Stop() || stopped.Stop() {
+		t.Error("Expected Stop to report only the first cancellation")
+	}
+
+	clock.Advance(59 * time.Second)
+	if len(fired) != 0 {
+		t.Fatalf("Expected no timers before their deadline, got %v", fired)
+	}
+	clock.Advance(2 * time.Minute)
+	if len(fired) != 2 || fired[0] != "early" || fired[1] != "late" {
+		t.Errorf("Expected timers to fire in deadline order, got %v", fired)
+	}
+	if clock.Pending() != 0 {
+		t.Errorf("Expected no pending timers, got %d", clock.Pending())
+	}
+}
+
+func TestFakeClock_ResetFromCallback(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	// A callback that reschedules itself, as an extended close does, fires again only once due
+	var times []time.Time
+	var timer models.Timer
+	timer = clock.AfterFunc(time.Minute, func() {
+		times = append(times, clock.Now())
+		if len(times) == 1 {
+			timer.Reset(time.Minute)
+		}
+	})
+
+	clock.Advance(90 * time.Second)
+	if len(times) != 1 || clock.Pending() != 1 {
+		t.Fatalf("Expected one firing and a pending reset, got %v with %d pending", times, clock.Pending())
+	}
+	clock.Set(start.Add(3 * time.Minute))
+	if len(times) != 2 || !times[1].Equal(start.Add(3*time.Minute)) {
+		t.Errorf("Expected the reset timer to fire at the new time, got %v", times)
+	}
+	if timer.Reset(time.Minute) {
+		t.Error("Expected Reset of a fired timer to report it was not pending")
+	}
+}
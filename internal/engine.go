@@ -1,106 +1,418 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"sort"
+	"time"
 
 	"auction-bidding-algorithm/internal/models"
 )
 
 // BiddingEngine handles the core auction bidding algorithm
 type BiddingEngine struct {
-	maxRounds int // Maximum number of bidding rounds to prevent infinite loops
+	maxRounds  int                  // Maximum number of bidding rounds to prevent infinite loops
+	deadline   time.Duration        // Longest ProcessBids may run on the wall clock (0 means no limit)
+	strategy   ResolutionStrategy   // How proxy bids are settled (iterative by default)
+	config     models.AuctionConfig // Lot-level settings such as the reserve price
+	clock      models.Clock         // Source of the entry time for bidders that have none
+	tieBreaker models.TieBreaker    // Decides between bidders tied at the top (earliest entry when nil)
+	auditMode  models.AuditMode     // How much of the bidding process to record in the result
+	auditSink  models.AuditSink     // Receives audit entries as they are recorded (optional)
+	observer   EngineObserver       // Notified of rounds, leader changes and exhausted bidders (optional)
+	format     Format               // Auction rules other than English proxy bidding (nil means the config's format)
+	logger     *slog.Logger         // Receives debug and warning records (optional)
 }
 
-// NewBiddingEngine creates a new BiddingEngine with default settings
-func NewBiddingEngine() *BiddingEngine {
-	return &BiddingEngine{
+// NewBiddingEngine creates a new BiddingEngine with default settings, configured by the given options
+func NewBiddingEngine(opts ...EngineOption) *BiddingEngine {
+	engine := &BiddingEngine{
 		maxRounds: 1000, // Reasonable limit to prevent infinite loops
+		clock:     models.NewSystemClock(),
 	}
+	for _, opt := range opts {
+		opt(engine)
+	}
+	return engine
+}
+
+// MaxRounds returns the iterative strategy's round limit
+func (be *BiddingEngine) MaxRounds() int {
+	return be.maxRounds
+}
+
+// Deadline returns how long ProcessBids may run (0 means no limit)
+func (be *BiddingEngine) Deadline() time.Duration {
+	return be.deadline
+}
+
+// Clock returns the clock the engine reads the current time from
+func (be *BiddingEngine) Clock() models.Clock {
+	return be.clock
+}
+
+// now returns the current time from the engine's clock, falling back to the wall clock when unset
+func (be *BiddingEngine) now() time.Time {
+	if be.clock == nil {
+		return time.Now()
+	}
+	return be.clock.Now()
+}
+
+// TieBreaker returns the tie-breaker the engine applies, defaulting to earliest entry
+func (be *BiddingEngine) TieBreaker() models.TieBreaker {
+	if be.tieBreaker == nil {
+		return models.NewEarliestEntryTieBreaker()
+	}
+	return be.tieBreaker
+}
+
+// Config returns the auction configuration applied by the engine
+func (be *BiddingEngine) Config() models.AuctionConfig {
+	return be.config
+}
+
+// Strategy returns the resolution strategy used by the engine
+func (be *BiddingEngine) Strategy() ResolutionStrategy {
+	return be.strategy
 }
 
 // ProcessBids executes the core bidding algorithm and returns the result
 func (be *BiddingEngine) ProcessBids(bidders []models.Bidder) (*models.BidResult, error) {
+	return be.ProcessBidsContext(context.Background(), bidders)
+}
+
+// ProcessBidsContext executes the core bidding algorithm, checking for cancellation between rounds
+// A context deadline that passes fails with a TimeoutError, a cancellation with a ProcessingError, and
+// the context's request fields (such as a trace ID) are added to the returned error's context
+func (be *BiddingEngine) ProcessBidsContext(ctx context.Context, bidders []models.Bidder) (*models.BidResult, error) {
+	result, err := be.processBids(ctx, bidders)
+	if err != nil {
+		return nil, models.AddRequestContext(ctx, err)
+	}
+	if result != nil {
+		result.Format = be.Format()
+	}
+	return result, nil
+}
+
+// processBids settles the bids for ProcessBidsContext
+func (be *BiddingEngine) processBids(ctx context.Context, bidders []models.Bidder) (*models.BidResult, error) {
+	startedWall := time.Now()
+	if err := ContextError(ctx, startedWall, len(bidders), 0); err != nil {
+		return nil, err
+	}
+
+	if err := be.checkReserve("ProcessBids"); err != nil {
+		return nil, err
+	}
+
+	format, err := be.resolveFormat()
+	if err != nil {
+		return nil, err
+	}
+
 	if len(bidders) == 0 {
-		return models.NewBidResult(nil, 0, 0, 0, bidders), nil
+		result := models.NewBidResultFromCents(nil, 0, 0, 0, bidders)
+		if be.config.IsMultiCurrency() {
+			result = models.NewBidResultInCurrency(nil, 0, be.config.Settlement(), 0, 0, bidders)
+		}
+		return result.WithReserve(be.config.ReservePriceCents, !be.config.HasReserve()), nil
+	}
+
+	workingBidders, err := be.prepareBidders(bidders)
+	if err != nil {
+		return nil, err
 	}
 
+	if format != nil {
+		return be.settleFormat(ctx, format, bidders, workingBidders)
+	}
+
+	audit := newAuditLog(be.auditMode, be.auditSink, be.observer, be.tieBreaker, workingBidders)
+
+	var rounds int
+	switch be.strategy {
+	case StrategyClosedForm:
+		rounds, err = be.resolveClosedForm(workingBidders, audit)
+	default:
+		rounds, err = be.resolveIterative(ctx, workingBidders, audit, startedWall)
+	}
+	if err == nil {
+		err = ContextError(ctx, startedWall, len(workingBidders), rounds)
+	}
+	if err == nil && be.deadlineExceeded(startedWall) {
+		err = be.deadlineError(len(workingBidders), rounds)
+	}
+	if err != nil {
+		be.warn("bid processing failed", "bidders", len(bidders), "rounds", rounds, "strategy", be.strategy.String(), "error", err)
+		return nil, err
+	}
+
+	// Find the winner (highest current bid, ties settled by the tie-breaker)
+	winner, tieBreak, err := be.findWinnerWithRule(workingBidders)
+	if err != nil {
+		processingErr := models.NewProcessingErrorWithCause("failed to determine winner", err, len(bidders), rounds)
+		processingErr.WithOperation("ProcessBids.FindWinner").WithCode(models.CodeEngineWinnerFailed)
+		processingErr.AddContext("rounds_completed", fmt.Sprintf("%d", rounds))
+		return nil, processingErr
+	}
+
+	if winner == nil {
+		result := models.NewBidResultFromCents(nil, 0, len(bidders), rounds, workingBidders)
+		result.AuditTrail = audit.trail()
+		return result.WithReserve(be.config.ReservePriceCents, !be.config.HasReserve()), nil
+	}
+
+	// The lot goes unsold when even the top bidder's maximum is below the reserve
+	if !be.config.ReserveMetBy(winner.GetMaxBidCents()) {
+		result := models.NewBidResultFromCents(nil, 0, len(bidders), rounds, workingBidders)
+		result.TieBreak = tieBreak
+		result.AuditTrail = audit.trail()
+		return result.WithReserve(be.config.ReservePriceCents, false), nil
+	}
+
+	// Calculate minimum winning bid using precise arithmetic
+	winningBidCents, err := be.CalculateMinimumWinningBidCents(workingBidders, winner)
+	if err != nil {
+		processingErr := models.NewProcessingErrorWithCause("failed to calculate minimum winning bid", err, len(bidders), rounds)
+		processingErr.WithOperation("ProcessBids.CalculateMinimumWinningBidCents").WithCode(models.CodeEnginePriceFailed)
+		processingErr.AddContext("winner_id", winner.ID)
+		processingErr.AddContext("winner_current_bid", winner.CurrentBid.Decimal())
+		return nil, processingErr
+	}
+
+	be.debug("bids processed", "bidders", len(bidders), "rounds", rounds, "strategy", be.strategy.String(), "winner_id", winner.ID, "winning_bid_cents", winningBidCents)
+
+	result := models.NewBidResultFromCents(winner, winningBidCents, len(bidders), rounds, workingBidders)
+	result.TieBreak = tieBreak
+	result.AuditTrail = audit.trail()
+	if be.config.IsMultiCurrency() {
+		local, err := be.localWinningBid(bidders, winner, result.WinningBid)
+		if err != nil {
+			processingErr := models.NewProcessingErrorWithCause("failed to convert winning bid to bidder currency", err, len(bidders), rounds)
+			processingErr.WithOperation("ProcessBids.LocalWinningBid").WithCode(models.CodeEngineConversionFailed)
+			processingErr.AddContext("winner_id", winner.ID)
+			return nil, processingErr
+		}
+		result.WithLocalWinningBid(local)
+	}
+	return result.WithReserve(be.config.ReservePriceCents, true), nil
+}
+
+// checkReserve returns an InputError if the configured reserve price is negative
+func (be *BiddingEngine) checkReserve(operation string) error {
+	if be.config.ReservePriceCents < 0 {
+		inputErr := models.NewInputError("reserve price cannot be negative", "config.ReservePriceCents", be.config.ReservePriceCents)
+		inputErr.WithOperation(operation).WithCode(models.CodeEngineReserveNegative)
+		return inputErr
+	}
+	return nil
+}
+
+// prepareBidders returns a working copy of the bid set ready to settle
+// Bids are normalized into the settlement currency, current bids reset to starting bids, bidders
+// without an entry time enter now, and the copy is sorted by entry time and sequence
+func (be *BiddingEngine) prepareBidders(bidders []models.Bidder) ([]models.Bidder, error) {
 	// Make a copy of bidders to avoid modifying the original slice
 	workingBidders := make([]models.Bidder, len(bidders))
 	copy(workingBidders, bidders)
 
-	// Initialize current bids to starting bids and sort by entry time for tie resolution
+	// Express every bid in the settlement currency so amounts can be compared directly
+	if be.config.IsMultiCurrency() {
+		if err := be.normalizeBidders(workingBidders); err != nil {
+			return nil, err
+		}
+	}
+
+	// Initialize current bids to starting bids; bidders without an entry time enter now
+	now := be.now()
 	for i := range workingBidders {
-		// Reinitialize the bidder to ensure precise calculations
 		bidder := &workingBidders[i]
-		originalEntryTime := bidder.EntryTime // Preserve original entry time
-		*bidder = *models.NewBidder(bidder.ID, bidder.Name, bidder.StartingBid, bidder.MaxBid, bidder.AutoIncrement)
-		bidder.EntryTime = originalEntryTime // Restore original entry time
+		bidder.Reset()
+		if bidder.EntryTime.IsZero() {
+			bidder.EntryTime = now
+		}
 	}
 
-	// Sort bidders by entry time for consistent tie resolution
-	sort.Slice(workingBidders, func(i, j int) bool {
-		return workingBidders[i].EntryTime.Before(workingBidders[j].EntryTime)
+	// Sort bidders by entry time, then sequence, for reproducible tie resolution
+	// The stable sort keeps input order for bidders that are identical on both
+	sort.SliceStable(workingBidders, func(i, j int) bool {
+		return workingBidders[i].EnteredBefore(&workingBidders[j])
 	})
+	return workingBidders, nil
+}
+
+// normalizeBidders converts each bidder's amounts into the settlement currency in place
+// An auto-increment that rounds below one minor unit is kept at one minor unit so bidders can still raise
+func (be *BiddingEngine) normalizeBidders(bidders []models.Bidder) error {
+	settlement := be.config.Settlement()
+	for i := range bidders {
+		bidder := &bidders[i]
+		if bidder.Currency() == settlement {
+			continue
+		}
 
+		rate, err := be.config.RateProvider.Rate(bidder.Currency(), settlement)
+		if err != nil {
+			inputErr := models.NewInputError("no exchange rate to settlement currency", "Currency", string(bidder.Currency()))
+			inputErr.WithOperation("ProcessBids.Normalize").WithCode(models.CodeEngineNoExchangeRate)
+			inputErr.AddContext("bidder_id", bidder.ID)
+			inputErr.AddContext("settlement_currency", string(settlement))
+			inputErr.AddContext("cause", err.Error())
+			return inputErr
+		}
+
+		amounts := []*models.Money{&bidder.StartingBid, &bidder.MaxBid, &bidder.AutoIncrement}
+		fields := []string{"StartingBid", "MaxBid", "AutoIncrement"}
+		for j, amount := range amounts {
+			converted, err := amount.Convert(settlement, rate)
+			if err != nil {
+				inputErr := models.NewInputError("bid cannot be converted to settlement currency", fields[j], amount.String())
+				inputErr.Cause = err
+				inputErr.WithOperation("ProcessBids.Normalize").WithCode(models.CodeEngineConversionFailed)
+				inputErr.AddContext("bidder_id", bidder.ID)
+				inputErr.AddContext("settlement_currency", string(settlement))
+				return inputErr
+			}
+			*amount = converted
+		}
+		if bidder.AutoIncrement.IsZero() && !be.config.HasIncrementSchedule() {
+			bidder.AutoIncrement = models.NewMoney(1, settlement)
+		}
+	}
+	return nil
+}
+
+// localWinningBid converts the settlement winning bid back into the winner's own currency
+// The converted amount never exceeds the maximum bid the winner originally entered
+func (be *BiddingEngine) localWinningBid(original []models.Bidder, winner *models.Bidder, winningBid models.Money) (models.Money, error) {
+	for _, bidder := range original {
+		if bidder.ID != winner.ID {
+			continue
+		}
+		local, err := winningBid.ConvertWith(be.config.RateProvider, bidder.Currency())
+		if err != nil {
+			return models.Money{}, err
+		}
+		if local.Amount() > bidder.MaxBid.Amount() {
+			local = bidder.MaxBid
+		}
+		return local, nil
+	}
+	return winningBid, nil
+}
+
+// resolveIterative runs increment rounds until no losing bidder can increment
+// Returns the number of rounds in which at least one bid was incremented
+// startedWall is processing's start on the wall clock
+func (be *BiddingEngine) resolveIterative(ctx context.Context, bidders []models.Bidder, audit *auditLog, startedWall time.Time) (int, error) {
 	rounds := 0
 
 	// Iterative bidding process with timeout protection
 	for rounds < be.maxRounds {
+		if err := ContextError(ctx, startedWall, len(bidders), rounds); err != nil {
+			return rounds, err
+		}
+		if be.deadlineExceeded(startedWall) {
+			return rounds, be.deadlineError(len(bidders), rounds)
+		}
+
 		// Check if any losing bidders can increment
-		incremented, err := be.IncrementBids(workingBidders)
+		incremented, err := be.incrementBids(bidders, rounds+1, audit)
 		if err != nil {
 			processingErr := models.NewProcessingErrorWithCause("failed to increment bids", err, len(bidders), rounds)
-			processingErr.WithOperation("ProcessBids.IncrementBids")
+			processingErr.WithOperation("ProcessBids.IncrementBids").WithCode(models.CodeEngineIncrementFailed)
 			processingErr.AddContext("round", fmt.Sprintf("%d", rounds))
 			processingErr.AddContext("max_rounds", fmt.Sprintf("%d", be.maxRounds))
-			return nil, processingErr
+			return rounds, processingErr
 		}
 
 		if !incremented {
 			break // No more increments possible
 		}
 		rounds++
+		audit.roundCompleted(rounds, bidders)
 	}
 
 	// Check for timeout condition
 	if rounds >= be.maxRounds {
 		timeoutErr := models.NewTimeoutError("bidding process exceeded maximum rounds", "ProcessBids", fmt.Sprintf("%d rounds", be.maxRounds))
-		timeoutErr.WithOperation("ProcessBids.TimeoutCheck")
+		timeoutErr.WithOperation("ProcessBids.TimeoutCheck").WithCode(models.CodeEngineRoundLimit)
 		timeoutErr.AddContext("bidder_count", fmt.Sprintf("%d", len(bidders)))
 		timeoutErr.AddContext("final_round", fmt.Sprintf("%d", rounds))
-		return nil, timeoutErr
+		return rounds, timeoutErr
 	}
 
-	// Find the winner (highest current bid, earliest entry time for ties)
-	winner, err := be.findWinner(workingBidders)
-	if err != nil {
-		processingErr := models.NewProcessingErrorWithCause("failed to determine winner", err, len(bidders), rounds)
-		processingErr.WithOperation("ProcessBids.FindWinner")
-		processingErr.AddContext("rounds_completed", fmt.Sprintf("%d", rounds))
-		return nil, processingErr
+	return rounds, nil
+}
+
+// deadlineExceeded reports whether processing that began at startedWall has run past the engine's deadline
+// The deadline bounds real processing time, so it is measured on the wall clock rather than the engine's clock
+func (be *BiddingEngine) deadlineExceeded(startedWall time.Time) bool {
+	return be.deadline > 0 && time.Since(startedWall) > be.deadline
+}
+
+// deadlineError builds the error returned when processing runs past the engine's deadline
+func (be *BiddingEngine) deadlineError(bidderCount, rounds int) error {
+	timeoutErr := models.NewTimeoutError("bidding process exceeded deadline", "ProcessBids", be.deadline.String())
+	timeoutErr.WithOperation("ProcessBids.DeadlineCheck").WithCode(models.CodeEngineDeadline)
+	timeoutErr.AddContext("bidder_count", fmt.Sprintf("%d", bidderCount))
+	timeoutErr.AddContext("rounds_completed", fmt.Sprintf("%d", rounds))
+	return timeoutErr
+}
+
+// ContextError returns the error for a context that has ended, or nil while it is still live
+// A passed deadline becomes a TimeoutError reporting how long processing has run since startedWall,
+// and a cancellation becomes a ProcessingError; both keep the context's error as their cause
+func ContextError(ctx context.Context, startedWall time.Time, bidderCount, rounds int) error {
+	ctxErr := ctx.Err()
+	if ctxErr == nil {
+		return nil
 	}
 
-	if winner == nil {
-		return models.NewBidResult(nil, 0, len(bidders), rounds, workingBidders), nil
+	if errors.Is(ctxErr, context.DeadlineExceeded) {
+		timeoutErr := models.NewTimeoutError("bidding process exceeded context deadline", "ProcessBids", time.Since(startedWall).String())
+		timeoutErr.Cause = ctxErr
+		timeoutErr.WithOperation("ProcessBids.ContextCheck").WithCode(models.CodeEngineContextDeadline)
+		timeoutErr.AddContext("bidder_count", fmt.Sprintf("%d", bidderCount))
+		timeoutErr.AddContext("rounds_completed", fmt.Sprintf("%d", rounds))
+		if deadline, ok := ctx.Deadline(); ok {
+			timeoutErr.AddContext("deadline", deadline.Format(time.RFC3339Nano))
+		}
+		return timeoutErr
 	}
 
-	// Calculate minimum winning bid using precise arithmetic
-	winningBidCents, err := be.CalculateMinimumWinningBidCents(workingBidders, winner)
-	if err != nil {
-		processingErr := models.NewProcessingErrorWithCause("failed to calculate minimum winning bid", err, len(bidders), rounds)
-		processingErr.WithOperation("ProcessBids.CalculateMinimumWinningBidCents")
-		processingErr.AddContext("winner_id", winner.ID)
-		processingErr.AddContext("winner_current_bid", fmt.Sprintf("%.2f", winner.CurrentBid))
-		return nil, processingErr
+	processingErr := models.NewProcessingErrorWithCause("bidding process canceled", ctxErr, bidderCount, rounds)
+	processingErr.WithOperation("ProcessBids.ContextCheck").WithCode(models.CodeEngineCanceled)
+	processingErr.AddContext("rounds_completed", fmt.Sprintf("%d", rounds))
+	return processingErr
+}
+
+// debug writes a debug record when the engine has a logger
+func (be *BiddingEngine) debug(msg string, args ...any) {
+	if be.logger != nil {
+		be.logger.Debug(msg, args...)
 	}
+}
 
-	return models.NewBidResultFromCents(winner, winningBidCents, len(bidders), rounds, workingBidders), nil
+// warn writes a warning record when the engine has a logger
+func (be *BiddingEngine) warn(msg string, args ...any) {
+	if be.logger != nil {
+		be.logger.Warn(msg, args...)
+	}
 }
 
 // IncrementBids increments the bids of losing bidders who can afford to increment
 // Returns true if any bids were incremented, false if no more increments are possible
 func (be *BiddingEngine) IncrementBids(bidders []models.Bidder) (bool, error) {
+	return be.incrementBids(bidders, 0, nil)
+}
+
+// incrementBids runs one increment round, recording each increment in the audit log
+func (be *BiddingEngine) incrementBids(bidders []models.Bidder, round int, audit *auditLog) (bool, error) {
 	if len(bidders) <= 1 {
 		return false, nil
 	}
@@ -108,7 +420,9 @@ func (be *BiddingEngine) IncrementBids(bidders []models.Bidder) (bool, error) {
 	// Find current highest bid using precise arithmetic
 	highestBidCents, err := be.findHighestBidCents(bidders)
 	if err != nil {
-		return false, models.NewProcessingErrorWithCause("failed to find highest bid", err, len(bidders), 0)
+		processingErr := models.NewProcessingErrorWithCause("failed to find highest bid", err, len(bidders), 0)
+		processingErr.WithOperation("IncrementBids").WithCode(models.CodeEngineHighestBidFailed)
+		return false, processingErr
 	}
 
 	anyIncremented := false
@@ -117,22 +431,28 @@ func (be *BiddingEngine) IncrementBids(bidders []models.Bidder) (bool, error) {
 	for i := range bidders {
 		bidder := &bidders[i]
 
+		// Use the site-wide schedule when configured, otherwise the bidder's own increment
+		incrementCents := be.config.IncrementFor(bidder)
+
 		// Skip if this bidder is already at the highest bid or can't increment
-		if bidder.GetCurrentBidCents() >= highestBidCents || !bidder.CanIncrement() {
+		if bidder.GetCurrentBidCents() >= highestBidCents || !bidder.CanIncrementBy(incrementCents) {
 			continue
 		}
 
 		// Increment the bidder
-		if bidder.Increment() {
+		previousCents := bidder.GetCurrentBidCents()
+		if bidder.IncrementBy(incrementCents) {
 			anyIncremented = true
+			audit.record(round, bidder, previousCents)
 		} else {
-			// This shouldn't happen if CanIncrement() returned true
-			systemErr := models.NewSystemError("bidder increment failed despite CanIncrement() returning true", "BiddingEngine", "medium")
-			systemErr.WithOperation("IncrementBids")
+			// This shouldn't happen if CanIncrementBy() returned true
+			systemErr := models.NewSystemError("bidder increment failed despite CanIncrementBy() returning true", "BiddingEngine", "medium")
+			systemErr.WithOperation("IncrementBids").WithCode(models.CodeEngineIncrementRejected)
 			systemErr.AddContext("bidder_id", bidder.ID)
-			systemErr.AddContext("current_bid", fmt.Sprintf("%.2f", bidder.CurrentBid))
-			systemErr.AddContext("max_bid", fmt.Sprintf("%.2f", bidder.MaxBid))
-			systemErr.AddContext("auto_increment", fmt.Sprintf("%.2f", bidder.AutoIncrement))
+			systemErr.AddContext("current_bid", bidder.CurrentBid.Decimal())
+			systemErr.AddContext("max_bid", bidder.MaxBid.Decimal())
+			systemErr.AddContext("auto_increment", bidder.AutoIncrement.Decimal())
+			systemErr.AddContext("increment_cents", fmt.Sprintf("%d", incrementCents))
 			return false, systemErr
 		}
 	}
@@ -144,13 +464,13 @@ func (be *BiddingEngine) IncrementBids(bidders []models.Bidder) (bool, error) {
 func (be *BiddingEngine) CalculateMinimumWinningBidCents(bidders []models.Bidder, winner *models.Bidder) (int64, error) {
 	if winner == nil {
 		inputErr := models.NewInputError("winner cannot be nil", "winner", nil)
-		inputErr.WithOperation("CalculateMinimumWinningBidCents")
+		inputErr.WithOperation("CalculateMinimumWinningBidCents").WithCode(models.CodeEngineWinnerNil)
 		return 0, inputErr
 	}
 
 	if len(bidders) == 0 {
 		inputErr := models.NewInputError("bidders slice cannot be empty", "bidders", len(bidders))
-		inputErr.WithOperation("CalculateMinimumWinningBidCents")
+		inputErr.WithOperation("CalculateMinimumWinningBidCents").WithCode(models.CodeEngineNoBidders)
 		return 0, inputErr
 	}
 
@@ -164,7 +484,7 @@ func (be *BiddingEngine) CalculateMinimumWinningBidCents(bidders []models.Bidder
 	}
 	if !winnerFound {
 		inputErr := models.NewInputError("winner not found in bidders slice", "winner.ID", winner.ID)
-		inputErr.WithOperation("CalculateMinimumWinningBidCents")
+		inputErr.WithOperation("CalculateMinimumWinningBidCents").WithCode(models.CodeEngineWinnerNotFound)
 		inputErr.AddContext("winner_id", winner.ID)
 		return 0, inputErr
 	}
@@ -185,13 +505,21 @@ func (be *BiddingEngine) CalculateMinimumWinningBidCents(bidders []models.Bidder
 		}
 	}
 
-	// If no other bidders, winner pays their starting bid
+	// If no other bidders, winner pays their starting bid (or the reserve, if higher)
 	if secondHighestCents == 0 {
-		return winner.GetStartingBidCents(), nil
+		return be.applyReserveCents(winner.GetStartingBidCents(), winner), nil
+	}
+
+	// Winner pays just enough to beat the second highest bidder: one increment above
+	// their max, using the schedule's tier at that price when one is configured
+	incrementCents := winner.GetAutoIncrementCents()
+	if be.config.HasIncrementSchedule() {
+		incrementCents = be.config.IncrementSchedule.IncrementAt(secondHighestCents)
 	}
+	minWinningBidCents := secondHighestCents + incrementCents
 
-	// Winner pays just enough to beat the second highest bidder
-	minWinningBidCents := secondHighestCents + winner.GetAutoIncrementCents()
+	// And at least the reserve price
+	minWinningBidCents = be.applyReserveCents(minWinningBidCents, winner)
 
 	// But never more than their maximum bid
 	if minWinningBidCents > winner.GetMaxBidCents() {
@@ -206,9 +534,9 @@ func (be *BiddingEngine) CalculateMinimumWinningBidCents(bidders []models.Bidder
 	// Validate the calculated bid is reasonable
 	if minWinningBidCents < 0 {
 		systemErr := models.NewSystemError("calculated minimum winning bid is negative", "BiddingEngine", "high")
-		systemErr.WithOperation("CalculateMinimumWinningBidCents")
+		systemErr.WithOperation("CalculateMinimumWinningBidCents").WithCode(models.CodeEngineNegativePrice)
 		systemErr.AddContext("calculated_bid_cents", fmt.Sprintf("%d", minWinningBidCents))
-		systemErr.AddContext("calculated_bid_dollars", fmt.Sprintf("%.2f", models.CentsToDollars(minWinningBidCents)))
+		systemErr.AddContext("calculated_bid_dollars", models.NewMoney(minWinningBidCents, be.config.Settlement()).Decimal())
 		systemErr.AddContext("winner_id", winner.ID)
 		systemErr.AddContext("second_highest_cents", fmt.Sprintf("%d", secondHighestCents))
 		systemErr.AddContext("second_highest_bidder", secondHighestBidderID)
@@ -218,14 +546,32 @@ func (be *BiddingEngine) CalculateMinimumWinningBidCents(bidders []models.Bidder
 	return minWinningBidCents, nil
 }
 
+// applyReserveCents raises a winning bid to the reserve price, never beyond the winner's maximum bid
+func (be *BiddingEngine) applyReserveCents(bidCents int64, winner *models.Bidder) int64 {
+	if bidCents >= be.config.ReservePriceCents {
+		return bidCents
+	}
+	if be.config.ReservePriceCents > winner.GetMaxBidCents() {
+		return winner.GetMaxBidCents()
+	}
+	return be.config.ReservePriceCents
+}
+
 // findWinner identifies the bidder with the highest current bid using precise arithmetic
-// In case of ties, the earliest entry wins
+// Ties are broken by the engine's tie-breaker (earliest entry by default)
 func (be *BiddingEngine) findWinner(bidders []models.Bidder) (*models.Bidder, error) {
+	winner, _, err := be.findWinnerWithRule(bidders)
+	return winner, err
+}
+
+// findWinnerWithRule identifies the winner like findWinner and also returns the rule that
+// broke a tie at the top (TieBreakNone when a single bidder holds the highest bid)
+func (be *BiddingEngine) findWinnerWithRule(bidders []models.Bidder) (*models.Bidder, models.TieBreakRule, error) {
 	if len(bidders) == 0 {
-		return nil, nil
+		return nil, models.TieBreakNone, nil
 	}
 
-	winner := &bidders[0]
+	tied := []*models.Bidder{&bidders[0]}
 
 	for i := 1; i < len(bidders); i++ {
 		current := &bidders[i]
@@ -233,35 +579,35 @@ func (be *BiddingEngine) findWinner(bidders []models.Bidder) (*models.Bidder, er
 		// Validate bidder data integrity using precise values
 		if current.GetCurrentBidCents() < 0 {
 			systemErr := models.NewSystemError("bidder has negative current bid", "BiddingEngine", "high")
-			systemErr.WithOperation("findWinner")
+			systemErr.WithOperation("findWinner").WithCode(models.CodeEngineNegativeBid)
 			systemErr.AddContext("bidder_id", current.ID)
 			systemErr.AddContext("current_bid_cents", fmt.Sprintf("%d", current.GetCurrentBidCents()))
-			systemErr.AddContext("current_bid_dollars", fmt.Sprintf("%.2f", current.CurrentBid))
-			return nil, systemErr
+			systemErr.AddContext("current_bid_dollars", current.CurrentBid.Decimal())
+			return nil, models.TieBreakNone, systemErr
 		}
 
-		// Higher bid wins (using precise comparison)
-		if current.GetCurrentBidCents() > winner.GetCurrentBidCents() {
-			winner = current
-		} else if current.GetCurrentBidCents() == winner.GetCurrentBidCents() {
-			// In case of tie, earlier entry wins (bidders are already sorted by entry time)
-			if current.EntryTime.Before(winner.EntryTime) {
-				winner = current
-			}
+		// Higher bid leads outright (using precise comparison); an equal bid joins the tie
+		if current.GetCurrentBidCents() > tied[0].GetCurrentBidCents() {
+			tied = []*models.Bidder{current}
+		} else if current.GetCurrentBidCents() == tied[0].GetCurrentBidCents() {
+			tied = append(tied, current)
 		}
 	}
 
+	// Bidders are sorted by entry time and sequence, so the tied slice is in priority order
+	winner, rule := models.BreakTie(tied, be.tieBreaker)
+
 	// Final validation of winner
 	if winner.GetCurrentBidCents() < 0 {
 		systemErr := models.NewSystemError("winner has negative current bid", "BiddingEngine", "critical")
-		systemErr.WithOperation("findWinner")
+		systemErr.WithOperation("findWinner").WithCode(models.CodeEngineNegativeBid)
 		systemErr.AddContext("winner_id", winner.ID)
 		systemErr.AddContext("winner_current_bid_cents", fmt.Sprintf("%d", winner.GetCurrentBidCents()))
-		systemErr.AddContext("winner_current_bid_dollars", fmt.Sprintf("%.2f", winner.CurrentBid))
-		return nil, systemErr
+		systemErr.AddContext("winner_current_bid_dollars", winner.CurrentBid.Decimal())
+		return nil, models.TieBreakNone, systemErr
 	}
 
-	return winner, nil
+	return winner, rule, nil
 }
 
 // findHighestBidCents returns the highest current bid among all bidders in cents
@@ -276,10 +622,10 @@ func (be *BiddingEngine) findHighestBidCents(bidders []models.Bidder) (int64, er
 	// Validate first bidder's bid
 	if highestCents < 0 {
 		systemErr := models.NewSystemError("bidder has negative current bid", "BiddingEngine", "high")
-		systemErr.WithOperation("findHighestBidCents")
+		systemErr.WithOperation("findHighestBidCents").WithCode(models.CodeEngineNegativeBid)
 		systemErr.AddContext("bidder_id", bidders[0].ID)
 		systemErr.AddContext("current_bid_cents", fmt.Sprintf("%d", highestCents))
-		systemErr.AddContext("current_bid_dollars", fmt.Sprintf("%.2f", bidders[0].CurrentBid))
+		systemErr.AddContext("current_bid_dollars", bidders[0].CurrentBid.Decimal())
 		return 0, systemErr
 	}
 
@@ -289,10 +635,10 @@ func (be *BiddingEngine) findHighestBidCents(bidders []models.Bidder) (int64, er
 		// Validate each bidder's bid
 		if bidderCents < 0 {
 			systemErr := models.NewSystemError("bidder has negative current bid", "BiddingEngine", "high")
-			systemErr.WithOperation("findHighestBidCents")
+			systemErr.WithOperation("findHighestBidCents").WithCode(models.CodeEngineNegativeBid)
 			systemErr.AddContext("bidder_id", bidder.ID)
 			systemErr.AddContext("current_bid_cents", fmt.Sprintf("%d", bidderCents))
-			systemErr.AddContext("current_bid_dollars", fmt.Sprintf("%.2f", bidder.CurrentBid))
+			systemErr.AddContext("current_bid_dollars", bidder.CurrentBid.Decimal())
 			return 0, systemErr
 		}
 
@@ -305,9 +651,9 @@ func (be *BiddingEngine) findHighestBidCents(bidders []models.Bidder) (int64, er
 	// Final validation
 	if highestCents < 0 {
 		systemErr := models.NewSystemError("calculated highest bid is negative", "BiddingEngine", "critical")
-		systemErr.WithOperation("findHighestBidCents")
+		systemErr.WithOperation("findHighestBidCents").WithCode(models.CodeEngineNegativePrice)
 		systemErr.AddContext("highest_bid_cents", fmt.Sprintf("%d", highestCents))
-		systemErr.AddContext("highest_bid_dollars", fmt.Sprintf("%.2f", models.CentsToDollars(highestCents)))
+		systemErr.AddContext("highest_bid_dollars", models.NewMoney(highestCents, be.config.Settlement()).Decimal())
 		systemErr.AddContext("highest_bidder_id", highestBidderID)
 		return 0, systemErr
 	}
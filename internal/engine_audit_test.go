@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// auditBidders returns an auction where Carol's increments never take the lead
+func auditBidders() []models.Bidder {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bidders := []models.Bidder{
+		*models.NewBidder("alice", "Alice", models.Dollars(100), models.Dollars(150), models.Dollars(10)),
+		*models.NewBidder("bob", "Bob", models.Dollars(105), models.Dollars(200), models.Dollars(10)),
+		*models.NewBidder("carol", "Carol", models.Dollars(100), models.Dollars(120), models.Dollars(10)),
+	}
+	for i := range bidders {
+		bidders[i].EntryTime = base.Add(time.Duration(i) * time.Second)
+	}
+	return bidders
+}
+
+func TestNewBiddingEngine_WithAudit(t *testing.T) {
+	engine := NewBiddingEngine(WithAudit(models.AuditFull, nil))
+	if engine.auditMode != models.AuditFull {
+		t.Errorf("Expected full audit mode, got %s", engine.auditMode)
+	}
+	if engine.maxRounds != 1000 {
+		t.Errorf("Expected maxRounds to be 1000, got %d", engine.maxRounds)
+	}
+}
+
+func TestProcessBids_AuditFull(t *testing.T) {
+	var streamed []models.AuditEntry
+	engine := NewBiddingEngine(WithAudit(models.AuditFull, func(entry models.AuditEntry) {
+		streamed = append(streamed, entry)
+	}))
+
+	result, err := engine.ProcessBids(auditBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Alice and Bob each raise five times, Carol twice
+	if len(result.AuditTrail) != 12 {
+		t.Fatalf("Expected 12 audit entries, got %d: %+v", len(result.AuditTrail), result.AuditTrail)
+	}
+	first := result.AuditTrail[0]
+	expected := models.AuditEntry{Round: 1, BidderID: "alice", PreviousCents: 10000, NewCents: 11000, LeaderID: "alice"}
+	if first != expected {
+		t.Errorf("Expected first entry %+v, got %+v", expected, first)
+	}
+	second := result.AuditTrail[1]
+	if second.BidderID != "carol" || second.LeaderID != "alice" {
+		t.Errorf("Expected Carol to match Alice without taking the lead, got %+v", second)
+	}
+
+	previousRound := 0
+	for _, entry := range result.AuditTrail {
+		if entry.Round < previousRound || entry.Round > result.BiddingRounds {
+			t.Fatalf("Expected rounds in order within 1..%d, got %+v", result.BiddingRounds, entry)
+		}
+		if entry.NewCents <= entry.PreviousCents {
+			t.Errorf("Expected each entry to raise the bid, got %+v", entry)
+		}
+		previousRound = entry.Round
+	}
+	if last := result.AuditTrail[len(result.AuditTrail)-1]; last.LeaderID != result.Winner.ID {
+		t.Errorf("Expected final leader %s, got %s", result.Winner.ID, last.LeaderID)
+	}
+
+	if len(streamed) != len(result.AuditTrail) {
+		t.Fatalf("Expected %d streamed entries, got %d", len(result.AuditTrail), len(streamed))
+	}
+	for i := range streamed {
+		if streamed[i] != result.AuditTrail[i] {
+			t.Errorf("Streamed entry %d differs: %+v vs %+v", i, streamed[i], result.AuditTrail[i])
+		}
+	}
+}
+
+func TestProcessBids_AuditLeaderChanges(t *testing.T) {
+	result, err := NewBiddingEngine(WithAudit(models.AuditLeaderChanges, nil)).ProcessBids(auditBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(result.AuditTrail) != 10 {
+		t.Fatalf("Expected 10 leader changes, got %d: %+v", len(result.AuditTrail), result.AuditTrail)
+	}
+	previousLeader := "bob"
+	for _, entry := range result.AuditTrail {
+		if entry.LeaderID != entry.BidderID || entry.LeaderID == previousLeader {
+			t.Errorf("Expected only leader changes, got %+v after leader %s", entry, previousLeader)
+		}
+		previousLeader = entry.LeaderID
+	}
+}
+
+func TestProcessBids_AuditClosedForm(t *testing.T) {
+	engine := NewBiddingEngine(WithAudit(models.AuditFull, nil))
+	engine.strategy = StrategyClosedForm
+
+	result, err := engine.ProcessBids(auditBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// One settlement entry per bidder that moved, lowest new bid first
+	expected := []models.AuditEntry{
+		{Round: 0, BidderID: "carol", PreviousCents: 10000, NewCents: 12000, LeaderID: "carol"},
+		{Round: 0, BidderID: "alice", PreviousCents: 10000, NewCents: 15000, LeaderID: "alice"},
+		{Round: 0, BidderID: "bob", PreviousCents: 10500, NewCents: 15500, LeaderID: "bob"},
+	}
+	if len(result.AuditTrail) != len(expected) {
+		t.Fatalf("Expected %d entries, got %+v", len(expected), result.AuditTrail)
+	}
+	for i := range expected {
+		if result.AuditTrail[i] != expected[i] {
+			t.Errorf("Entry %d: expected %+v, got %+v", i, expected[i], result.AuditTrail[i])
+		}
+	}
+}
+
+func TestProcessBids_AuditOff(t *testing.T) {
+	result, err := NewBiddingEngine().ProcessBids(auditBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.AuditTrail != nil {
+		t.Errorf("Expected no audit trail by default, got %d entries", len(result.AuditTrail))
+	}
+}
+
+func TestProcessBids_AuditTieFollowsTieBreaker(t *testing.T) {
+	// Alice climbs to Bob's 20.00, where the tie-breaker decides who leads
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	alice := models.NewBidder("alice", "Alice", models.Dollars(10), models.Dollars(100), models.Dollars(10))
+	alice.EntryTime, alice.Sequence = base, 2
+	bob := models.NewBidder("bob", "Bob", models.Dollars(20), models.Dollars(20), models.Dollars(10))
+	bob.EntryTime, bob.Sequence = base.Add(time.Second), 1
+
+	tests := []struct {
+		name       string
+		mode       models.AuditMode
+		strategy   ResolutionStrategy
+		tieBreaker models.TieBreaker
+		expected   string
+	}{
+		{"earliest entry", models.AuditFull, StrategyIterative, nil, "alice"},
+		{"earliest entry leader changes", models.AuditLeaderChanges, StrategyIterative, nil, "alice"},
+		{"earliest entry closed form", models.AuditFull, StrategyClosedForm, nil, "alice"},
+		{"earliest sequence", models.AuditFull, StrategyIterative, models.NewEarliestSequenceTieBreaker(), "bob"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewBiddingEngine(WithAudit(tt.mode, nil), WithStrategy(tt.strategy), WithTieBreaker(tt.tieBreaker))
+			result, err := engine.ProcessBids([]models.Bidder{*alice, *bob})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result.Winner.ID != tt.expected {
+				t.Fatalf("Expected %s to win, got %s", tt.expected, result.Winner.ID)
+			}
+			if len(result.AuditTrail) == 0 {
+				t.Fatal("Expected audit entries, got none")
+			}
+			if last := result.AuditTrail[len(result.AuditTrail)-1]; last.LeaderID != result.Winner.ID {
+				t.Errorf("Expected final leader %s, got %+v", result.Winner.ID, result.AuditTrail)
+			}
+		})
+	}
+}
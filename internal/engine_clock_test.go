@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/clocktest"
+	"auction-bidding-algorithm/internal/models"
+)
+
+func TestNewBiddingEngine_WithClock(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	engine := NewBiddingEngine(WithClock(clock))
+
+	if engine.Clock() != clock {
+		t.Error("Expected engine to use the given clock")
+	}
+	if engine.maxRounds != 1000 {
+		t.Errorf("Expected maxRounds to be 1000, got %d", engine.maxRounds)
+	}
+}
+
+// TestProcessBids_SequenceBreaksIdenticalEntryTimes tests that bidders entered at the same
+// instant are ordered by sequence number regardless of input order
+func TestProcessBids_SequenceBreaksIdenticalEntryTimes(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	alice := models.NewBidderWithClock("1", "Alice", models.Dollars(100), models.Dollars(150), models.Dollars(10), clock)
+	bob := models.NewBidderWithClock("2", "Bob", models.Dollars(100), models.Dollars(150), models.Dollars(10), clock)
+	alice.Sequence = 2
+	bob.Sequence = 1
+
+	for _, bidders := range [][]models.Bidder{{*alice, *bob}, {*bob, *alice}} {
+		result, err := NewBiddingEngine(WithClock(clock)).ProcessBids(bidders)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result.Winner.ID != "2" {
+			t.Errorf("Expected Bob's lower sequence to win the tie, got %s", result.Winner.Name)
+		}
+		if result.AllBidders[0].ID != "2" || result.AllBidders[1].ID != "1" {
+			t.Errorf("Expected bidders ordered by sequence, got %s, %s", result.AllBidders[0].ID, result.AllBidders[1].ID)
+		}
+	}
+}
+
+// TestProcessBids_IdenticalEntryKeepsInputOrder tests that bidders identical on entry time and
+// sequence keep their input order, so repeated runs agree
+func TestProcessBids_IdenticalEntryKeepsInputOrder(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	bidders := make([]models.Bidder, 20)
+	for i := range bidders {
+		bidders[i] = *models.NewBidderWithClock(string(rune('a'+i)), "Bidder", models.Dollars(100), models.Dollars(150), models.Dollars(10), clock)
+	}
+
+	for run := 0; run < 5; run++ {
+		result, err := NewBiddingEngine(WithClock(clock)).ProcessBids(bidders)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result.Winner.ID != "a" {
+			t.Fatalf("Expected first input bidder to win, got %s", result.Winner.ID)
+		}
+		for i := range bidders {
+			if result.AllBidders[i].ID != bidders[i].ID {
+				t.Fatalf("Expected input order to be kept, got %s at %d", result.AllBidders[i].ID, i)
+			}
+		}
+	}
+}
+
+// TestProcessBids_MissingEntryTimeUsesClock tests that bidders without an entry time enter at
+// the engine clock's current time
+func TestProcessBids_MissingEntryTimeUsesClock(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := clocktest.NewFakeClock(now)
+
+	bidders := []models.Bidder{
+		{ID: "1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10)},
+		{ID: "2", Name: "Bob", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: now.Add(-time.Minute)},
+	}
+
+	result, err := NewBiddingEngine(WithClock(clock)).ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != "2" {
+		t.Errorf("Expected Bob, who entered before now, to win the tie, got %s", result.Winner.Name)
+	}
+	for _, bidder := range result.AllBidders {
+		if bidder.ID == "1" && !bidder.EntryTime.Equal(now) {
+			t.Errorf("Expected Alice to be stamped with the clock time, got %v", bidder.EntryTime)
+		}
+	}
+	if !bidders[0].EntryTime.IsZero() {
+		t.Error("Expected the caller's bidder to be unchanged")
+	}
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// roundHook runs a function after each completed round
+type roundHook func(round int)
+
+func (rh roundHook) RoundCompleted(round int, bidders []models.Bidder) { rh(round) }
+func (rh roundHook) LeaderChanged(entry models.AuditEntry)             {}
+func (rh roundHook) BidderExhausted(round int, bidder models.Bidder)   {}
+
+func TestProcessBidsContext_MatchesProcessBids(t *testing.T) {
+	engine := NewBiddingEngine()
+	expected, err := engine.ProcessBids(auditBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	actual, err := engine.ProcessBidsContext(context.Background(), auditBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if diff := compareResults(expected, actual); diff != "" {
+		t.Errorf("Expected identical results: %s", diff)
+	}
+	if actual.BiddingRounds != expected.BiddingRounds {
+		t.Errorf("Expected %d rounds, got %d", expected.BiddingRounds, actual.BiddingRounds)
+	}
+}
+
+func TestProcessBidsContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	engine := NewBiddingEngine(WithMaxRounds(100000), WithObserver(roundHook(func(round int) {
+		if round == 3 {
+			cancel()
+		}
+	})))
+
+	_, err := engine.ProcessBidsContext(ctx, longAuction())
+	processingErr, ok := err.(*models.ProcessingError)
+	if !ok {
+		t.Fatalf("Expected ProcessingError, got %T: %v", err, err)
+	}
+	if !errors.Is(processingErr.Cause, context.Canceled) {
+		t.Errorf("Expected context.Canceled cause, got %v", processingErr.Cause)
+	}
+	if processingErr.CurrentRound != 3 {
+		t.Errorf("Expected cancellation after round 3, got %d", processingErr.CurrentRound)
+	}
+}
+
+func TestProcessBidsContext_DeadlineExceeded(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	engine := NewBiddingEngine(WithMaxRounds(100000), WithObserver(roundHook(func(round int) {
+		if round == 5 {
+			<-ctx.Done()
+		}
+	})))
+
+	_, err := engine.ProcessBidsContext(models.WithTraceID(ctx, "trace-123"), longAuction())
+	timeoutErr, ok := err.(*models.TimeoutError)
+	if !ok {
+		t.Fatalf("Expected TimeoutError, got %T: %v", err, err)
+	}
+	if !errors.Is(timeoutErr.Cause, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded cause, got %v", timeoutErr.Cause)
+	}
+	duration, err := time.ParseDuration(timeoutErr.TimeoutDuration)
+	if err != nil || duration < 20*time.Millisecond {
+		t.Errorf("Expected the elapsed time of at least 20ms, got %q", timeoutErr.TimeoutDuration)
+	}
+	if traceID, _ := timeoutErr.GetContext(models.TraceIDKey); traceID != "trace-123" {
+		t.Errorf("Expected trace ID in the error context, got %q", traceID)
+	}
+	if rounds, _ := timeoutErr.GetContext("rounds_completed"); rounds != "5" {
+		t.Errorf("Expected 5 completed rounds, got %q", rounds)
+	}
+}
+
+func TestProcessBidsContext_AlreadyDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, strategy := range []ResolutionStrategy{StrategyIterative, StrategyClosedForm} {
+		_, err := NewBiddingEngine(WithStrategy(strategy)).ProcessBidsContext(ctx, auditBidders())
+		if _, ok := err.(*models.ProcessingError); !ok {
+			t.Errorf("%s: expected ProcessingError for a canceled context, got %T", strategy, err)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// currencyTestRates returns EUR, GBP and JPY rates into USD
+func currencyTestRates() *models.StaticRateProvider {
+	rates := models.NewStaticRateProvider()
+	_ = rates.SetRate(models.EUR, models.USD, "1.10")
+	_ = rates.SetRate(models.GBP, models.USD, "1.25")
+	_ = rates.SetRate(models.JPY, models.USD, "0.0065")
+	return rates
+}
+
+// currencyTestBidders returns Alice bidding in EUR (max 220.00 USD), Bob in GBP (max 187.50 USD)
+// and Kenji in JPY (max 195.00 USD), entering in that order
+func currencyTestBidders() []models.Bidder {
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		*models.NewBidder("1", "Alice", models.NewMoney(10000, models.EUR), models.NewMoney(20000, models.EUR), models.NewMoney(1000, models.EUR)),
+		*models.NewBidder("2", "Bob", models.NewMoney(9000, models.GBP), models.NewMoney(15000, models.GBP), models.NewMoney(500, models.GBP)),
+		*models.NewBidder("3", "Kenji", models.NewMoney(15000, models.JPY), models.NewMoney(30000, models.JPY), models.NewMoney(500, models.JPY)),
+	}
+	for i := range bidders {
+		bidders[i].EntryTime = baseTime.Add(time.Duration(i) * time.Second)
+	}
+	return bidders
+}
+
+func TestProcessBids_MultiCurrency(t *testing.T) {
+	for _, strategy := range []ResolutionStrategy{StrategyIterative, StrategyClosedForm} {
+		t.Run(strategy.String(), func(t *testing.T) {
+			engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSettlement(models.USD, currencyTestRates())))
+			engine.strategy = strategy
+
+			bidders := currencyTestBidders()
+			result, err := engine.ProcessBids(bidders)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if result.Winner == nil || result.Winner.ID != "1" {
+				t.Fatalf("Expected Alice to win, got %+v", result.Winner)
+			}
+			// Kenji's 195.00 USD max plus Alice's 11.00 USD increment
+			if !result.WinningBid.Equal(models.NewMoney(20600, models.USD)) {
+				t.Errorf("Expected winning bid 206.00 USD, got %s", result.WinningBid)
+			}
+			// 206.00 / 1.10 = 187.2727... EUR
+			if !result.WinningBidLocal.Equal(models.NewMoney(18727, models.EUR)) {
+				t.Errorf("Expected local winning bid 187.27 EUR, got %s", result.WinningBidLocal)
+			}
+			for _, bidder := range result.AllBidders {
+				if bidder.Currency() != models.USD {
+					t.Errorf("Expected %s to be normalized to USD, got %s", bidder.Name, bidder.Currency())
+				}
+			}
+
+			// The caller's bidders keep their own currencies
+			if bidders[2].MaxBid.Currency() != models.JPY || bidders[2].GetMaxBidCents() != 30000 {
+				t.Errorf("Expected original bidder to be unchanged, got %s", bidders[2].MaxBid)
+			}
+		})
+	}
+}
+
+func TestProcessBids_MultiCurrencySettlementWinner(t *testing.T) {
+	rates := currencyTestRates()
+	bidders := currencyTestBidders()
+	bidders = append(bidders, *models.NewBidder("4", "Dana", models.Dollars(150.01), models.Dollars(300.00), models.Dollars(2.00)))
+	bidders[3].EntryTime = bidders[2].EntryTime.Add(time.Second)
+
+	result, err := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSettlement(models.USD, rates))).ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner == nil || result.Winner.ID != "4" {
+		t.Fatalf("Expected Dana to win, got %+v", result.Winner)
+	}
+	if !result.WinningBidLocal.Equal(result.WinningBid) {
+		t.Errorf("Expected local and settlement winning bids to match, got %s and %s", result.WinningBidLocal, result.WinningBid)
+	}
+}
+
+func TestProcessBids_MultiCurrencyLocalBidCappedAtMax(t *testing.T) {
+	rates := models.NewStaticRateProvider()
+	_ = rates.SetRate(models.KWD, models.USD, "3.2525")
+
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		// 100.002 KWD normalizes up to 325.26 USD, which converts back to 100.003 KWD
+		*models.NewBidder("1", "Fahad", models.NewMoney(99920, models.KWD), models.NewMoney(100002, models.KWD), models.NewMoney(1, models.KWD)),
+		*models.NewBidder("2", "Bob", models.Dollars(325.00), models.Dollars(325.27), models.Dollars(0.01)),
+	}
+	bidders[0].EntryTime = baseTime
+	bidders[1].EntryTime = baseTime.Add(time.Second)
+
+	result, err := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSettlement(models.USD, rates))).ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner == nil || result.Winner.ID != "1" {
+		t.Fatalf("Expected Fahad to win, got %+v", result.Winner)
+	}
+	if result.WinningBidLocal.Currency() != models.KWD {
+		t.Fatalf("Expected local winning bid in KWD, got %s", result.WinningBidLocal)
+	}
+	if !result.WinningBid.Equal(models.NewMoney(32526, models.USD)) {
+		t.Errorf("Expected winning bid 325.26 USD, got %s", result.WinningBid)
+	}
+	if !result.WinningBidLocal.Equal(models.NewMoney(100002, models.KWD)) {
+		t.Errorf("Expected local winning bid capped at 100.002 KWD, got %s", result.WinningBidLocal)
+	}
+}
+
+func TestProcessBids_MultiCurrencyTinyIncrement(t *testing.T) {
+	rates := models.NewStaticRateProvider()
+	_ = rates.SetRate(models.KWD, models.USD, "3.25")
+
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		// 0.001 KWD is a third of a cent and would round to zero
+		*models.NewBidder("1", "Fahad", models.NewMoney(30000, models.KWD), models.NewMoney(30010, models.KWD), models.NewMoney(1, models.KWD)),
+		*models.NewBidder("2", "Bob", models.Dollars(97.40), models.Dollars(97.52), models.Dollars(0.01)),
+	}
+	bidders[0].EntryTime = baseTime
+	bidders[1].EntryTime = baseTime.Add(time.Second)
+
+	result, err := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSettlement(models.USD, rates))).ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	for _, bidder := range result.AllBidders {
+		if bidder.ID == "1" && bidder.GetAutoIncrementCents() != 1 {
+			t.Errorf("Expected increment to be kept at one cent, got %d", bidder.GetAutoIncrementCents())
+		}
+	}
+	if result.Winner == nil || result.Winner.ID != "1" {
+		t.Fatalf("Expected Fahad to win, got %+v", result.Winner)
+	}
+}
+
+func TestProcessBids_MultiCurrencyMissingRate(t *testing.T) {
+	rates := models.NewStaticRateProvider()
+	_ = rates.SetRate(models.EUR, models.USD, "1.10")
+
+	_, err := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSettlement(models.USD, rates))).ProcessBids(currencyTestBidders())
+	inputErr, ok := err.(*models.InputError)
+	if !ok {
+		t.Fatalf("Expected InputError, got %T: %v", err, err)
+	}
+	if inputErr.Context["bidder_id"] != "2" {
+		t.Errorf("Expected error for Bob, got context %v", inputErr.Context)
+	}
+}
+
+func TestProcessBids_MultiCurrencyConversionOverflow(t *testing.T) {
+	rates := currencyTestRates()
+	_ = rates.SetRate(models.JPY, models.USD, "1000000000000000")
+
+	_, err := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSettlement(models.USD, rates))).ProcessBids(currencyTestBidders())
+	inputErr, ok := err.(*models.InputError)
+	if !ok {
+		t.Fatalf("Expected InputError, got %T: %v", err, err)
+	}
+	if inputErr.Code != models.CodeEngineConversionFailed || inputErr.Operation != "ProcessBids.Normalize" {
+		t.Errorf("Expected %s from ProcessBids.Normalize, got %s from %s", models.CodeEngineConversionFailed, inputErr.Code, inputErr.Operation)
+	}
+	if inputErr.Context["bidder_id"] != "3" || inputErr.Cause == nil {
+		t.Errorf("Expected the overflow for Kenji as the cause, got context %v and cause %v", inputErr.Context, inputErr.Cause)
+	}
+}
+
+func TestProcessBids_MultiCurrencyReserve(t *testing.T) {
+	config := models.NewAuctionConfigWithSettlement(models.USD, currencyTestRates())
+	config.ReservePriceCents = 25000
+
+	result, err := NewBiddingEngine(WithConfig(config)).ProcessBids(currencyTestBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.ReserveMet || result.Winner != nil {
+		t.Error("Expected the 250.00 USD reserve not to be met")
+	}
+	if !result.ReservePrice.Equal(models.NewMoney(25000, models.USD)) {
+		t.Errorf("Expected reserve in settlement currency, got %s", result.ReservePrice)
+	}
+
+	empty, err := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSettlement(models.EUR, currencyTestRates()))).ProcessBids(nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if empty.Currency() != models.EUR {
+		t.Errorf("Expected empty result in settlement currency EUR, got %s", empty.Currency())
+	}
+}
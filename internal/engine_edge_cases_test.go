@@ -12,7 +12,7 @@ func TestFindHighestBidCents_NegativeBidValidation(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	// Create a bidder and manually corrupt its internal state to simulate negative bid
-	bidder := models.NewBidder("1", "Alice", 10.00, 20.00, 5.00)
+	bidder := models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00))
 
 	// We can't directly set negative cents, but we can test the validation logic
 	// by creating a scenario that would trigger the validation checks
@@ -35,10 +35,10 @@ func TestFindHighestBidCents_MultipleBiddersValidation(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 5.00, 15.00, 2.00),
-		*models.NewBidder("2", "Bob", 8.00, 18.00, 3.00),
-		*models.NewBidder("3", "Charlie", 12.00, 22.00, 4.00),
-		*models.NewBidder("4", "Diana", 6.00, 16.00, 2.50),
+		*models.NewBidder("1", "Alice", models.Dollars(5.00), models.Dollars(15.00), models.Dollars(2.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(8.00), models.Dollars(18.00), models.Dollars(3.00)),
+		*models.NewBidder("3", "Charlie", models.Dollars(12.00), models.Dollars(22.00), models.Dollars(4.00)),
+		*models.NewBidder("4", "Diana", models.Dollars(6.00), models.Dollars(16.00), models.Dollars(2.50)),
 	}
 
 	highest, err := engine.findHighestBidCents(bidders)
@@ -59,9 +59,9 @@ func TestFindWinner_ValidationPaths(t *testing.T) {
 
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.00, 20.00, 5.00),
-		*models.NewBidder("2", "Bob", 15.00, 25.00, 5.00),
-		*models.NewBidder("3", "Charlie", 8.00, 18.00, 3.00),
+		*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(15.00), models.Dollars(25.00), models.Dollars(5.00)),
+		*models.NewBidder("3", "Charlie", models.Dollars(8.00), models.Dollars(18.00), models.Dollars(3.00)),
 	}
 
 	// Set entry times for deterministic ordering
@@ -90,9 +90,9 @@ func TestFindWinner_TieWithSameBids(t *testing.T) {
 
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.00, 20.00, 5.00),
-		*models.NewBidder("2", "Bob", 10.00, 20.00, 5.00),
-		*models.NewBidder("3", "Charlie", 10.00, 20.00, 5.00),
+		*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
+		*models.NewBidder("3", "Charlie", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
 	}
 
 	// Set entry times - Alice enters first
@@ -122,9 +122,9 @@ func TestIncrementBids_EdgeCases(t *testing.T) {
 	// Test with bidders at different states
 	// Bob has the highest starting bid, so Alice will try to increment to catch up
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.00, 15.00, 2.00), // Can increment to catch up
-		*models.NewBidder("2", "Bob", 12.00, 13.00, 1.00),   // Has highest bid initially
-		*models.NewBidder("3", "Charlie", 8.00, 8.00, 1.00), // Cannot increment (at max)
+		*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(15.00), models.Dollars(2.00)), // Can increment to catch up
+		*models.NewBidder("2", "Bob", models.Dollars(12.00), models.Dollars(13.00), models.Dollars(1.00)),   // Has highest bid initially
+		*models.NewBidder("3", "Charlie", models.Dollars(8.00), models.Dollars(8.00), models.Dollars(1.00)), // Cannot increment (at max)
 	}
 
 	// Charlie is already at max, so set inactive
@@ -141,16 +141,16 @@ func TestIncrementBids_EdgeCases(t *testing.T) {
 	}
 
 	// Check results after first increment
-	if bidders[0].CurrentBid != 12.00 { // Alice: 10.00 -> 12.00 (catches up to Bob)
-		t.Errorf("Expected Alice's bid to be 12.00, got %.2f", bidders[0].CurrentBid)
+	if bidders[0].CurrentBid.Float64() != 12.00 { // Alice: 10.00 -> 12.00 (catches up to Bob)
+		t.Errorf("Expected Alice's bid to be 12.00, got %.2f", bidders[0].CurrentBid.Float64())
 	}
 
-	if bidders[1].CurrentBid != 12.00 { // Bob: unchanged (was highest)
-		t.Errorf("Expected Bob's bid to remain 12.00, got %.2f", bidders[1].CurrentBid)
+	if bidders[1].CurrentBid.Float64() != 12.00 { // Bob: unchanged (was highest)
+		t.Errorf("Expected Bob's bid to remain 12.00, got %.2f", bidders[1].CurrentBid.Float64())
 	}
 
-	if bidders[2].CurrentBid != 8.00 { // Charlie: unchanged (at max)
-		t.Errorf("Expected Charlie's bid to remain 8.00, got %.2f", bidders[2].CurrentBid)
+	if bidders[2].CurrentBid.Float64() != 8.00 { // Charlie: unchanged (at max)
+		t.Errorf("Expected Charlie's bid to remain 8.00, got %.2f", bidders[2].CurrentBid.Float64())
 	}
 
 	// Now Alice and Bob are tied at 12.00
@@ -159,8 +159,8 @@ func TestIncrementBids_EdgeCases(t *testing.T) {
 	highestBid, _ := engine.findHighestBidCents(bidders)
 	t.Logf("After first round - Highest bid: %d cents", highestBid)
 	t.Logf("Alice: %.2f (can increment: %v), Bob: %.2f (can increment: %v)",
-		bidders[0].CurrentBid, bidders[0].CanIncrement(),
-		bidders[1].CurrentBid, bidders[1].CanIncrement())
+		bidders[0].CurrentBid.Float64(), bidders[0].CanIncrement(),
+		bidders[1].CurrentBid.Float64(), bidders[1].CanIncrement())
 
 	incremented, err = engine.IncrementBids(bidders)
 	if err != nil {
@@ -174,7 +174,7 @@ func TestIncrementBids_EdgeCases(t *testing.T) {
 		t.Logf("No increments in second round - this might be expected if both are at highest bid")
 	}
 
-	t.Logf("After second round - Alice: %.2f, Bob: %.2f", bidders[0].CurrentBid, bidders[1].CurrentBid)
+	t.Logf("After second round - Alice: %.2f, Bob: %.2f", bidders[0].CurrentBid.Float64(), bidders[1].CurrentBid.Float64())
 
 	// The actual behavior might be different - let's just verify the logic works
 	// without making specific assumptions about the exact bid values
@@ -185,8 +185,8 @@ func TestIncrementBids_AllBiddersAtMax(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.00, 10.00, 1.00), // At max
-		*models.NewBidder("2", "Bob", 12.00, 12.00, 1.00),   // At max
+		*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(10.00), models.Dollars(1.00)), // At max
+		*models.NewBidder("2", "Bob", models.Dollars(12.00), models.Dollars(12.00), models.Dollars(1.00)),   // At max
 	}
 
 	// Set both as inactive (at max)
@@ -211,8 +211,8 @@ func TestProcessBids_IncrementError(t *testing.T) {
 
 	// Create a scenario that exercises the increment logic thoroughly
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 1.00, 10.00, 1.00),
-		*models.NewBidder("2", "Bob", 2.00, 9.00, 1.00),
+		*models.NewBidder("1", "Alice", models.Dollars(1.00), models.Dollars(10.00), models.Dollars(1.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(2.00), models.Dollars(9.00), models.Dollars(1.00)),
 	}
 
 	baseTime := time.Now()
@@ -239,8 +239,8 @@ func TestCalculateMinimumWinningBidCents_ComplexScenarios(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	// Test scenario where calculated bid would be negative (edge case)
-	winner := models.NewBidder("1", "Alice", 100.00, 200.00, 50.00)
-	loser := models.NewBidder("2", "Bob", 10.00, 20.00, 5.00)
+	winner := models.NewBidder("1", "Alice", models.Dollars(100.00), models.Dollars(200.00), models.Dollars(50.00))
+	loser := models.NewBidder("2", "Bob", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00))
 
 	bidders := []models.Bidder{*winner, *loser}
 
@@ -256,8 +256,8 @@ func TestCalculateMinimumWinningBidCents_ComplexScenarios(t *testing.T) {
 	}
 
 	// Test multiple losers scenario
-	loser2 := models.NewBidder("3", "Charlie", 15.00, 30.00, 3.00)
-	loser3 := models.NewBidder("4", "Diana", 8.00, 25.00, 2.00)
+	loser2 := models.NewBidder("3", "Charlie", models.Dollars(15.00), models.Dollars(30.00), models.Dollars(3.00))
+	loser3 := models.NewBidder("4", "Diana", models.Dollars(8.00), models.Dollars(25.00), models.Dollars(2.00))
 
 	bidders = []models.Bidder{*winner, *loser, *loser2, *loser3}
 
@@ -280,9 +280,9 @@ func TestProcessBids_WinnerCalculationError(t *testing.T) {
 
 	// Create a scenario that exercises the winner calculation and minimum bid calculation
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 50.00, 100.00, 10.00),
-		*models.NewBidder("2", "Bob", 45.00, 90.00, 8.00),
-		*models.NewBidder("3", "Charlie", 40.00, 80.00, 6.00),
+		*models.NewBidder("1", "Alice", models.Dollars(50.00), models.Dollars(100.00), models.Dollars(10.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(45.00), models.Dollars(90.00), models.Dollars(8.00)),
+		*models.NewBidder("3", "Charlie", models.Dollars(40.00), models.Dollars(80.00), models.Dollars(6.00)),
 	}
 
 	baseTime := time.Now()
@@ -309,7 +309,7 @@ func TestProcessBids_WinnerCalculationError(t *testing.T) {
 	}
 
 	// Verify winning bid calculation
-	if result.WinningBid <= 0 {
+	if result.WinningBid.Float64() <= 0 {
 		t.Error("Expected positive winning bid")
 	}
 }
@@ -332,7 +332,7 @@ func TestFindHighestBidCents_SingleBidderEdgeCase(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			bidder := models.NewBidder("1", "Test", tc.startingBid, tc.startingBid+10, 1.00)
+			bidder := models.NewBidder("1", "Test", models.Dollars(tc.startingBid), models.Dollars(tc.startingBid+10), models.Dollars(1.00))
 			bidders := []models.Bidder{*bidder}
 
 			highest, err := engine.findHighestBidCents(bidders)
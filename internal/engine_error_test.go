@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -29,9 +30,9 @@ func TestEnhancedErrorHandling_ProcessBids(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "Alice",
-					StartingBid:   100.0,
-					MaxBid:        200.0,
-					AutoIncrement: 10.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(200.0),
+					AutoIncrement: models.Dollars(10.0),
 					EntryTime:     time.Now(),
 				},
 			},
@@ -85,25 +86,25 @@ func TestTimeoutError_ProcessBids(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "Alice",
-			StartingBid:   100.0,
-			MaxBid:        1000.0, // Very high max to ensure many rounds
-			AutoIncrement: 1.0,    // Small increment
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(1000.0), // Very high max to ensure many rounds
+			AutoIncrement: models.Dollars(1.0),    // Small increment
 			EntryTime:     time.Now(),
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Bob",
-			StartingBid:   101.0,  // Slightly higher starting bid
-			MaxBid:        1000.0, // Very high max to ensure many rounds
-			AutoIncrement: 1.0,    // Small increment
+			StartingBid:   models.Dollars(101.0),  // Slightly higher starting bid
+			MaxBid:        models.Dollars(1000.0), // Very high max to ensure many rounds
+			AutoIncrement: models.Dollars(1.0),    // Small increment
 			EntryTime:     time.Now().Add(time.Second),
 		},
 		{
 			ID:            "bidder3",
 			Name:          "Charlie",
-			StartingBid:   102.0,  // Highest starting bid
-			MaxBid:        1000.0, // Very high max to ensure many rounds
-			AutoIncrement: 1.0,    // Small increment
+			StartingBid:   models.Dollars(102.0),  // Highest starting bid
+			MaxBid:        models.Dollars(1000.0), // Very high max to ensure many rounds
+			AutoIncrement: models.Dollars(1.0),    // Small increment
 			EntryTime:     time.Now().Add(2 * time.Second),
 		},
 	}
@@ -138,7 +139,7 @@ func TestSystemError_FindWinner(t *testing.T) {
 
 	// With precision handling, negative bids should not occur in normal operation
 	// Create a valid bidder and verify no system error occurs
-	bidder := *models.NewBidder("bidder1", "Alice", 100.0, 200.0, 10.0)
+	bidder := *models.NewBidder("bidder1", "Alice", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(10.0))
 	bidders := []models.Bidder{bidder}
 
 	winner, err := engine.findWinner(bidders)
@@ -162,8 +163,8 @@ func TestProcessingError_IncrementBids(t *testing.T) {
 
 	// With precision handling, create valid bidders that should increment normally
 	bidders := []models.Bidder{
-		*models.NewBidder("bidder1", "Alice", 100.0, 200.0, 10.0),
-		*models.NewBidder("bidder2", "Bob", 50.0, 150.0, 5.0),
+		*models.NewBidder("bidder1", "Alice", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(10.0)),
+		*models.NewBidder("bidder2", "Bob", models.Dollars(50.0), models.Dollars(150.0), models.Dollars(5.0)),
 	}
 
 	incremented, err := engine.IncrementBids(bidders)
@@ -183,8 +184,8 @@ func TestErrorWrappingInEngine(t *testing.T) {
 
 	// With precision handling, create valid bidders that should work normally
 	bidders := []models.Bidder{
-		*models.NewBidder("bidder1", "Alice", 100.0, 200.0, 10.0),
-		*models.NewBidder("bidder2", "Bob", 50.0, 150.0, 5.0),
+		*models.NewBidder("bidder1", "Alice", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(10.0)),
+		*models.NewBidder("bidder2", "Bob", models.Dollars(50.0), models.Dollars(150.0), models.Dollars(5.0)),
 	}
 
 	_, err := engine.IncrementBids(bidders)
@@ -215,7 +216,7 @@ func contains(s, substr string) bool {
 func BenchmarkErrorHandling_ProcessBids(b *testing.B) {
 	engine := NewBiddingEngine()
 	bidders := []models.Bidder{
-		*models.NewBidder("bidder1", "Alice", 100.0, 200.0, 10.0),
+		*models.NewBidder("bidder1", "Alice", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(10.0)),
 	}
 
 	b.ResetTimer()
@@ -227,3 +228,82 @@ func BenchmarkErrorHandling_ProcessBids(b *testing.B) {
 		_ = result
 	}
 }
+
+// TestEngineErrorCodes tests that engine failures carry stable codes
+func TestEngineErrorCodes(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, stop := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer stop()
+	stall := roundHook(func(int) { time.Sleep(2 * time.Millisecond) })
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		engine  *BiddingEngine
+		bidders []models.Bidder
+		code    models.ErrorCode
+	}{
+		{"round limit", context.Background(), NewBiddingEngine(WithMaxRounds(5)), longAuction(), models.CodeEngineRoundLimit},
+		{"deadline", context.Background(), NewBiddingEngine(WithMaxRounds(100000), WithObserver(stall), WithDeadline(time.Millisecond)), longAuction(), models.CodeEngineDeadline},
+		{"context deadline", expired, NewBiddingEngine(), longAuction(), models.CodeEngineContextDeadline},
+		{"canceled", canceled, NewBiddingEngine(), longAuction(), models.CodeEngineCanceled},
+		{"negative reserve", context.Background(), NewBiddingEngine(WithConfig(models.AuctionConfig{ReservePriceCents: -1})), longAuction(), models.CodeEngineReserveNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.engine.ProcessBidsContext(tt.ctx, tt.bidders)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if code := models.CodeOf(err); code != tt.code {
+				t.Errorf("Expected code %s, got %s (%v)", tt.code, code, err)
+			}
+		})
+	}
+}
+
+// TestEngineErrorCodes_MinimumWinningBid tests the codes of invalid price calculation inputs
+func TestEngineErrorCodes_MinimumWinningBid(t *testing.T) {
+	engine := NewBiddingEngine()
+	alice := models.NewBidder("bidder1", "Alice", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(10.0))
+	bob := models.NewBidder("bidder2", "Bob", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(10.0))
+
+	tests := []struct {
+		name    string
+		bidders []models.Bidder
+		winner  *models.Bidder
+		code    models.ErrorCode
+	}{
+		{"nil winner", []models.Bidder{*alice}, nil, models.CodeEngineWinnerNil},
+		{"no bidders", nil, alice, models.CodeEngineNoBidders},
+		{"winner not bidding", []models.Bidder{*alice}, bob, models.CodeEngineWinnerNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := engine.CalculateMinimumWinningBidCents(tt.bidders, tt.winner)
+			if code := models.CodeOf(err); code != tt.code {
+				t.Errorf("Expected code %s, got %s (%v)", tt.code, code, err)
+			}
+		})
+	}
+}
+
+// TestEngineErrors_NegativePriceInSettlementCurrency tests that amounts in error context are
+// written with the settlement currency's minor digits
+func TestEngineErrors_NegativePriceInSettlementCurrency(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSettlement(models.JPY, models.NewStaticRateProvider())))
+	winner := models.NewBidder("bidder1", "Alice", models.NewMoney(-500, models.JPY), models.NewMoney(-500, models.JPY), models.NewMoney(10, models.JPY))
+	rival := models.NewBidder("bidder2", "Bob", models.NewMoney(100, models.JPY), models.NewMoney(200, models.JPY), models.NewMoney(10, models.JPY))
+
+	_, err := engine.CalculateMinimumWinningBidCents([]models.Bidder{*winner, *rival}, winner)
+	systemErr, ok := err.(*models.SystemError)
+	if !ok || systemErr.Code != models.CodeEngineNegativePrice {
+		t.Fatalf("Expected a SystemError with %s, got %T: %v", models.CodeEngineNegativePrice, err, err)
+	}
+	if amount, _ := systemErr.GetContext("calculated_bid_dollars"); amount != "-500" {
+		t.Errorf("Expected the yen amount without decimals, got %q", amount)
+	}
+}
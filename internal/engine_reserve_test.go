@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// reserveTestBidders returns Alice (max 200.00) and Bob (max 150.00) with Alice entering first
+func reserveTestBidders() []models.Bidder {
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		*models.NewBidder("1", "Alice", models.Dollars(100.00), models.Dollars(200.00), models.Dollars(10.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(90.00), models.Dollars(150.00), models.Dollars(10.00)),
+	}
+	bidders[0].EntryTime = baseTime
+	bidders[1].EntryTime = baseTime.Add(time.Second)
+	return bidders
+}
+
+func TestNewBiddingEngine_WithConfig(t *testing.T) {
+	config := models.NewAuctionConfigWithReserve(12500)
+	engine := NewBiddingEngine(WithConfig(config))
+
+	if engine.Config() != config {
+		t.Errorf("Expected config %+v, got %+v", config, engine.Config())
+	}
+	if engine.maxRounds != 1000 {
+		t.Errorf("Expected maxRounds to be 1000, got %d", engine.maxRounds)
+	}
+}
+
+func TestProcessBids_ReserveNotMet(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithReserve(25000)))
+
+	result, err := engine.ProcessBids(reserveTestBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.ReserveMet {
+		t.Error("Expected reserve not to be met")
+	}
+	if result.Winner != nil {
+		t.Errorf("Expected no winner when reserve is not met, got %s", result.Winner.Name)
+	}
+	if result.WinningBid.Float64() != 0 {
+		t.Errorf("Expected winning bid 0, got %.2f", result.WinningBid.Float64())
+	}
+	if result.ReservePrice.Float64() != 250.00 {
+		t.Errorf("Expected reserve price 250.00, got %.2f", result.ReservePrice.Float64())
+	}
+	if len(result.AllBidders) != 2 {
+		t.Errorf("Expected 2 bidders in result, got %d", len(result.AllBidders))
+	}
+}
+
+func TestProcessBids_ReserveRaisesWinningBid(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithReserve(18000)))
+
+	result, err := engine.ProcessBids(reserveTestBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !result.ReserveMet {
+		t.Error("Expected reserve to be met")
+	}
+	if result.Winner == nil || result.Winner.ID != "1" {
+		t.Fatalf("Expected Alice to win, got %+v", result.Winner)
+	}
+	// Bob's max plus Alice's increment is 160.00, below the 180.00 reserve
+	if result.GetWinningBidCents() != 18000 {
+		t.Errorf("Expected winning bid 18000 cents, got %d", result.GetWinningBidCents())
+	}
+}
+
+func TestProcessBids_ReserveBelowCompetitivePrice(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithReserve(12000)))
+
+	result, err := engine.ProcessBids(reserveTestBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !result.ReserveMet {
+		t.Error("Expected reserve to be met")
+	}
+	// Competition already pushes the price past the reserve
+	if result.GetWinningBidCents() != 16000 {
+		t.Errorf("Expected winning bid 16000 cents, got %d", result.GetWinningBidCents())
+	}
+}
+
+func TestProcessBids_ReserveEqualsWinnerMax(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithReserve(20000)))
+
+	result, err := engine.ProcessBids(reserveTestBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !result.ReserveMet {
+		t.Error("Expected reserve equal to the winner's max bid to be met")
+	}
+	if result.GetWinningBidCents() != 20000 {
+		t.Errorf("Expected winning bid 20000 cents, got %d", result.GetWinningBidCents())
+	}
+}
+
+func TestProcessBids_ReserveSingleBidder(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithReserve(15000)))
+
+	bidders := []models.Bidder{*models.NewBidder("1", "Alice", models.Dollars(100.00), models.Dollars(200.00), models.Dollars(10.00))}
+	result, err := engine.ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// A lone bidder would normally pay their starting bid
+	if result.GetWinningBidCents() != 15000 {
+		t.Errorf("Expected winning bid 15000 cents, got %d", result.GetWinningBidCents())
+	}
+}
+
+func TestProcessBids_ReserveEmptyBidders(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithReserve(15000)))
+
+	result, err := engine.ProcessBids([]models.Bidder{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.ReserveMet {
+		t.Error("Expected reserve not to be met without bidders")
+	}
+
+	result, err = NewBiddingEngine().ProcessBids([]models.Bidder{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !result.ReserveMet {
+		t.Error("Expected auctions without a reserve to report it as met")
+	}
+}
+
+func TestProcessBids_NegativeReserve(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithReserve(-1)))
+
+	_, err := engine.ProcessBids(reserveTestBidders())
+	if err == nil {
+		t.Fatal("Expected error for negative reserve")
+	}
+
+	inputErr, ok := err.(*models.InputError)
+	if !ok {
+		t.Fatalf("Expected InputError, got %T", err)
+	}
+	if inputErr.InputField != "config.ReservePriceCents" {
+		t.Errorf("Expected input field 'config.ReservePriceCents', got '%s'", inputErr.InputField)
+	}
+}
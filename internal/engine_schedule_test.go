@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// scheduleTestBidders returns bidders without their own increments, Alice entering first
+func scheduleTestBidders() []models.Bidder {
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		*models.NewBidder("1", "Alice", models.Dollars(0.50), models.Dollars(30.00), models.Dollars(0)),
+		*models.NewBidder("2", "Bob", models.Dollars(0.60), models.Dollars(12.00), models.Dollars(0)),
+	}
+	bidders[0].EntryTime = baseTime
+	bidders[1].EntryTime = baseTime.Add(time.Second)
+	return bidders
+}
+
+func TestProcessBids_IncrementSchedule(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSchedule(models.DefaultIncrementSchedule())))
+
+	result, err := engine.ProcessBids(scheduleTestBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if result.Winner == nil || result.Winner.ID != "1" {
+		t.Fatalf("Expected Alice to win, got %+v", result.Winner)
+	}
+	if result.BiddingRounds == 0 {
+		t.Error("Expected bidding rounds with scheduled increments")
+	}
+	// Bob's $12.00 max plus the $0.50 increment of the $5-$25 tier
+	if result.GetWinningBidCents() != 1250 {
+		t.Errorf("Expected winning bid 1250 cents, got %d", result.GetWinningBidCents())
+	}
+}
+
+func TestCalculateMinimumWinningBidCents_ScheduleTier(t *testing.T) {
+	schedule, err := models.NewTieredIncrementSchedule([]models.IncrementTier{
+		{FromCents: 0, IncrementCents: 10},
+		{FromCents: 1000, IncrementCents: 100},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSchedule(schedule)))
+
+	winner := models.NewBidder("1", "Alice", models.Dollars(5.00), models.Dollars(50.00), models.Dollars(0))
+	tests := []struct {
+		secondMax float64
+		expected  int64
+	}{
+		{9.99, 1009},  // Still in the lower tier
+		{10.00, 1100}, // Exactly on the tier boundary
+		{20.00, 2100},
+	}
+
+	for _, tt := range tests {
+		bidders := []models.Bidder{*winner, *models.NewBidder("2", "Bob", models.Dollars(1.00), models.Dollars(tt.secondMax), models.Dollars(0))}
+		got, err := engine.CalculateMinimumWinningBidCents(bidders, winner)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if got != tt.expected {
+			t.Errorf("Second max %.2f: expected %d cents, got %d", tt.secondMax, tt.expected, got)
+		}
+	}
+}
+
+func TestClosedForm_RequiresTieredSchedule(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSchedule(flatSchedule(25))))
+	engine.strategy = StrategyClosedForm
+
+	_, err := engine.ProcessBids(scheduleTestBidders())
+	if _, ok := err.(*models.InputError); !ok {
+		t.Fatalf("Expected InputError for a schedule without tiers, got %v", err)
+	}
+}
+
+func TestClosedForm_SkippedTier(t *testing.T) {
+	// Bob's ladder jumps from 0.01 straight to 0.05, so no price in the 0.03 tier can settle
+	schedule, err := models.NewTieredIncrementSchedule([]models.IncrementTier{
+		{FromCents: 0, IncrementCents: 4},
+		{FromCents: 3, IncrementCents: 2},
+		{FromCents: 5, IncrementCents: 3},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	baseTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	alice := models.NewBidder("1", "Alice", models.NewMoney(4, models.USD), models.NewMoney(13, models.USD), models.NewMoney(0, models.USD))
+	alice.EntryTime = baseTime
+	bob := models.NewBidder("2", "Bob", models.NewMoney(1, models.USD), models.NewMoney(16, models.USD), models.NewMoney(0, models.USD))
+	bob.EntryTime = baseTime.Add(time.Second)
+
+	for _, strategy := range []ResolutionStrategy{StrategyIterative, StrategyClosedForm} {
+		engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithSchedule(schedule)))
+		engine.strategy = strategy
+		result, err := engine.ProcessBids([]models.Bidder{*alice, *bob})
+		if err != nil {
+			t.Fatalf("%s: expected no error, got: %v", strategy, err)
+		}
+		if result.Winner.ID != "2" || result.GetWinningBidCents() != 16 {
+			t.Errorf("%s: expected Bob to win at 16 cents, got %s at %d", strategy, result.Winner.ID, result.GetWinningBidCents())
+		}
+	}
+}
+
+// TestClosedForm_MatchesIterativeWithSchedule runs both strategies over randomized
+// auctions priced by randomized tiered schedules, including narrow tiers that a single
+// increment can jump over and increments that do not divide the tier boundaries
+func TestClosedForm_MatchesIterativeWithSchedule(t *testing.T) {
+	rng := rand.New(rand.NewSource(20240602))
+	baseTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for trial := 0; trial < 3000; trial++ {
+		tiers := []models.IncrementTier{{FromCents: 0, IncrementCents: int64(1 + rng.Intn(20))}}
+		for count := 2 + rng.Intn(6); len(tiers) < count; {
+			from := tiers[len(tiers)-1].FromCents + int64(1+rng.Intn(600))
+			tiers = append(tiers, models.IncrementTier{FromCents: from, IncrementCents: int64(1 + rng.Intn(150))})
+		}
+		schedule, err := models.NewTieredIncrementSchedule(tiers)
+		if err != nil {
+			t.Fatalf("trial %d: invalid schedule: %v", trial, err)
+		}
+		config := models.NewAuctionConfigWithSchedule(schedule)
+		iterative := &BiddingEngine{maxRounds: 1000000, strategy: StrategyIterative, config: config}
+		closedForm := &BiddingEngine{maxRounds: 1000000, strategy: StrategyClosedForm, config: config}
+
+		bidders := randomBidders(rng, baseTime)
+
+		expected, err := iterative.ProcessBids(bidders)
+		if err != nil {
+			t.Fatalf("trial %d: iterative strategy failed: %v", trial, err)
+		}
+		actual, err := closedForm.ProcessBids(bidders)
+		if err != nil {
+			t.Fatalf("trial %d: closed-form strategy failed: %v", trial, err)
+		}
+
+		if diff := compareResults(expected, actual); diff != "" {
+			t.Fatalf("trial %d: strategies disagree: %s\nschedule: %+v\nbidders: %+v", trial, diff, schedule.Tiers(), bidders)
+		}
+	}
+}
+
+// flatSchedule is an increment schedule that does not expose tiers
+type flatSchedule int64
+
+func (fs flatSchedule) IncrementAt(priceCents int64) int64 {
+	return int64(fs)
+}
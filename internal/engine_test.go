@@ -28,8 +28,8 @@ func TestProcessBids_EmptyBidders(t *testing.T) {
 	if result.Winner != nil {
 		t.Error("Expected no winner for empty bidders")
 	}
-	if result.WinningBid != 0 {
-		t.Errorf("Expected winning bid to be 0, got %f", result.WinningBid)
+	if result.WinningBid.Float64() != 0 {
+		t.Errorf("Expected winning bid to be 0, got %f", result.WinningBid.Float64())
 	}
 	if result.TotalBidders != 0 {
 		t.Errorf("Expected total bidders to be 0, got %d", result.TotalBidders)
@@ -39,7 +39,7 @@ func TestProcessBids_EmptyBidders(t *testing.T) {
 func TestProcessBids_SingleBidder(t *testing.T) {
 	engine := NewBiddingEngine()
 
-	bidder := models.NewBidder("1", "Alice", 100.0, 200.0, 10.0)
+	bidder := models.NewBidder("1", "Alice", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(10.0))
 	bidders := []models.Bidder{*bidder}
 
 	result, err := engine.ProcessBids(bidders)
@@ -54,8 +54,8 @@ func TestProcessBids_SingleBidder(t *testing.T) {
 	if result.Winner.ID != "1" {
 		t.Errorf("Expected winner ID to be '1', got '%s'", result.Winner.ID)
 	}
-	if result.WinningBid != 100.0 {
-		t.Errorf("Expected winning bid to be 100.0 (starting bid), got %f", result.WinningBid)
+	if result.WinningBid.Float64() != 100.0 {
+		t.Errorf("Expected winning bid to be 100.0 (starting bid), got %f", result.WinningBid.Float64())
 	}
 	if result.BiddingRounds != 0 {
 		t.Errorf("Expected 0 bidding rounds for single bidder, got %d", result.BiddingRounds)
@@ -66,8 +66,8 @@ func TestProcessBids_MultipleBidders_NoIncrements(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	// Create bidders where the highest starting bid wins immediately
-	bidder1 := models.NewBidder("1", "Alice", 100.0, 150.0, 10.0)
-	bidder2 := models.NewBidder("2", "Bob", 80.0, 120.0, 5.0)
+	bidder1 := models.NewBidder("1", "Alice", models.Dollars(100.0), models.Dollars(150.0), models.Dollars(10.0))
+	bidder2 := models.NewBidder("2", "Bob", models.Dollars(80.0), models.Dollars(120.0), models.Dollars(5.0))
 
 	bidders := []models.Bidder{*bidder1, *bidder2}
 
@@ -85,8 +85,8 @@ func TestProcessBids_MultipleBidders_NoIncrements(t *testing.T) {
 	}
 	// Alice should pay just enough to beat Bob's max bid
 	expectedWinningBid := 120.0 + 10.0 // Bob's max + Alice's increment
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid to be %f, got %f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid to be %f, got %f", expectedWinningBid, result.WinningBid.Float64())
 	}
 }
 
@@ -94,8 +94,8 @@ func TestProcessBids_MultipleBidders_WithIncrements(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	// Create bidders where increments are needed
-	bidder1 := models.NewBidder("1", "Alice", 100.0, 200.0, 20.0)
-	bidder2 := models.NewBidder("2", "Bob", 110.0, 180.0, 15.0)
+	bidder1 := models.NewBidder("1", "Alice", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(20.0))
+	bidder2 := models.NewBidder("2", "Bob", models.Dollars(110.0), models.Dollars(180.0), models.Dollars(15.0))
 
 	bidders := []models.Bidder{*bidder1, *bidder2}
 
@@ -124,8 +124,8 @@ func TestProcessBids_MultipleBidders_WithIncrements(t *testing.T) {
 	// Alice should pay just enough to beat Bob's max (180) + her increment (20) = 200
 	// But capped at her max bid of 200
 	expectedWinningBid := 200.0
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid to be %f, got %f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid to be %f, got %f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	if result.BiddingRounds == 0 {
@@ -140,12 +140,12 @@ func TestProcessBids_TieResolution(t *testing.T) {
 	now := time.Now()
 
 	bidder1 := &models.Bidder{
-		ID: "1", Name: "Alice", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now,
+		ID: "1", Name: "Alice", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now,
 	}
 	bidder2 := &models.Bidder{
-		ID: "2", Name: "Bob", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now.Add(time.Second),
+		ID: "2", Name: "Bob", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now.Add(time.Second),
 	}
 
 	bidders := []models.Bidder{*bidder1, *bidder2}
@@ -171,10 +171,10 @@ func TestIncrementBids_NoIncrementsPossible(t *testing.T) {
 
 	// Create bidders who can't increment
 	bidder1 := models.Bidder{
-		ID: "1", CurrentBid: 100.0, MaxBid: 100.0, AutoIncrement: 10.0, IsActive: false,
+		ID: "1", CurrentBid: models.Dollars(100.0), MaxBid: models.Dollars(100.0), AutoIncrement: models.Dollars(10.0), IsActive: false,
 	}
 	bidder2 := models.Bidder{
-		ID: "2", CurrentBid: 90.0, MaxBid: 90.0, AutoIncrement: 5.0, IsActive: false,
+		ID: "2", CurrentBid: models.Dollars(90.0), MaxBid: models.Dollars(90.0), AutoIncrement: models.Dollars(5.0), IsActive: false,
 	}
 
 	bidders := []models.Bidder{bidder1, bidder2}
@@ -194,8 +194,8 @@ func TestIncrementBids_SomeIncrementsPerformed(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	// Create bidders where some can increment using NewBidder for proper initialization
-	bidder1 := *models.NewBidder("1", "Alice", 100.0, 150.0, 10.0)
-	bidder2 := *models.NewBidder("2", "Bob", 90.0, 120.0, 5.0)
+	bidder1 := *models.NewBidder("1", "Alice", models.Dollars(100.0), models.Dollars(150.0), models.Dollars(10.0))
+	bidder2 := *models.NewBidder("2", "Bob", models.Dollars(90.0), models.Dollars(120.0), models.Dollars(5.0))
 
 	bidders := []models.Bidder{bidder1, bidder2}
 
@@ -210,17 +210,17 @@ func TestIncrementBids_SomeIncrementsPerformed(t *testing.T) {
 	}
 
 	// Bidder2 should have incremented to compete with bidder1
-	if bidders[1].CurrentBid != 95.0 {
-		t.Errorf("Expected bidder2 to increment to 95.0, got %f", bidders[1].CurrentBid)
+	if bidders[1].CurrentBid.Float64() != 95.0 {
+		t.Errorf("Expected bidder2 to increment to 95.0, got %f", bidders[1].CurrentBid.Float64())
 	}
 }
 
 func TestFindWinner_HighestBidWins(t *testing.T) {
 	engine := NewBiddingEngine()
 
-	bidder1 := *models.NewBidder("1", "Bidder1", 100.0, 150.0, 10.0)
-	bidder2 := *models.NewBidder("2", "Bidder2", 120.0, 150.0, 10.0)
-	bidder3 := *models.NewBidder("3", "Bidder3", 90.0, 150.0, 10.0)
+	bidder1 := *models.NewBidder("1", "Bidder1", models.Dollars(100.0), models.Dollars(150.0), models.Dollars(10.0))
+	bidder2 := *models.NewBidder("2", "Bidder2", models.Dollars(120.0), models.Dollars(150.0), models.Dollars(10.0))
+	bidder3 := *models.NewBidder("3", "Bidder3", models.Dollars(90.0), models.Dollars(150.0), models.Dollars(10.0))
 
 	bidders := []models.Bidder{bidder1, bidder2, bidder3}
 
@@ -243,10 +243,10 @@ func TestFindWinner_TieGoesToEarlierEntry(t *testing.T) {
 
 	now := time.Now()
 
-	bidder1 := *models.NewBidder("1", "Bidder1", 100.0, 150.0, 10.0)
+	bidder1 := *models.NewBidder("1", "Bidder1", models.Dollars(100.0), models.Dollars(150.0), models.Dollars(10.0))
 	bidder1.EntryTime = now.Add(time.Second)
 
-	bidder2 := *models.NewBidder("2", "Bidder2", 100.0, 150.0, 10.0)
+	bidder2 := *models.NewBidder("2", "Bidder2", models.Dollars(100.0), models.Dollars(150.0), models.Dollars(10.0))
 	bidder2.EntryTime = now
 
 	bidders := []models.Bidder{bidder1, bidder2}
@@ -275,16 +275,16 @@ func TestTieResolution_SameEffectiveBidAmount_EarlierEntryWins(t *testing.T) {
 	// Create bidders with identical parameters but different entry times
 	// Requirement 5.1: WHEN multiple bidders have the same effective bid amount THEN the system SHALL prioritize the earlier entry
 	bidder1 := &models.Bidder{
-		ID: "1", Name: "Alice", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now, IsActive: true,
+		ID: "1", Name: "Alice", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now, IsActive: true,
 	}
 	bidder2 := &models.Bidder{
-		ID: "2", Name: "Bob", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now.Add(time.Second), IsActive: true,
+		ID: "2", Name: "Bob", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now.Add(time.Second), IsActive: true,
 	}
 	bidder3 := &models.Bidder{
-		ID: "3", Name: "Charlie", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now.Add(2 * time.Second), IsActive: true,
+		ID: "3", Name: "Charlie", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now.Add(2 * time.Second), IsActive: true,
 	}
 
 	bidders := []models.Bidder{*bidder1, *bidder2, *bidder3}
@@ -312,16 +312,16 @@ func TestTieResolution_BidOrderByTimestamp(t *testing.T) {
 
 	// Requirement 5.2: WHEN determining bid order THEN the system SHALL use the timestamp or entry sequence of when bids were submitted
 	bidder1 := &models.Bidder{
-		ID: "1", Name: "Alice", StartingBid: 90.0, MaxBid: 120.0,
-		AutoIncrement: 5.0, EntryTime: now.Add(2 * time.Second), IsActive: true,
+		ID: "1", Name: "Alice", StartingBid: models.Dollars(90.0), MaxBid: models.Dollars(120.0),
+		AutoIncrement: models.Dollars(5.0), EntryTime: now.Add(2 * time.Second), IsActive: true,
 	}
 	bidder2 := &models.Bidder{
-		ID: "2", Name: "Bob", StartingBid: 95.0, MaxBid: 120.0,
-		AutoIncrement: 5.0, EntryTime: now, IsActive: true, // Earliest entry
+		ID: "2", Name: "Bob", StartingBid: models.Dollars(95.0), MaxBid: models.Dollars(120.0),
+		AutoIncrement: models.Dollars(5.0), EntryTime: now, IsActive: true, // Earliest entry
 	}
 	bidder3 := &models.Bidder{
-		ID: "3", Name: "Charlie", StartingBid: 85.0, MaxBid: 120.0,
-		AutoIncrement: 5.0, EntryTime: now.Add(time.Second), IsActive: true,
+		ID: "3", Name: "Charlie", StartingBid: models.Dollars(85.0), MaxBid: models.Dollars(120.0),
+		AutoIncrement: models.Dollars(5.0), EntryTime: now.Add(time.Second), IsActive: true,
 	}
 
 	bidders := []models.Bidder{*bidder1, *bidder2, *bidder3}
@@ -349,12 +349,12 @@ func TestTieResolution_WinningBidLevelTie_FirstEntryWins(t *testing.T) {
 
 	// Requirement 5.3: WHEN ties occur at the winning bid level THEN the system SHALL award the item to the bidder who entered first
 	bidder1 := &models.Bidder{
-		ID: "1", Name: "Alice", StartingBid: 100.0, MaxBid: 130.0,
-		AutoIncrement: 10.0, EntryTime: now.Add(time.Second), IsActive: true,
+		ID: "1", Name: "Alice", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(130.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now.Add(time.Second), IsActive: true,
 	}
 	bidder2 := &models.Bidder{
-		ID: "2", Name: "Bob", StartingBid: 100.0, MaxBid: 130.0,
-		AutoIncrement: 10.0, EntryTime: now, IsActive: true, // Earlier entry
+		ID: "2", Name: "Bob", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(130.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now, IsActive: true, // Earlier entry
 	}
 
 	bidders := []models.Bidder{*bidder1, *bidder2}
@@ -376,8 +376,8 @@ func TestTieResolution_WinningBidLevelTie_FirstEntryWins(t *testing.T) {
 
 	// Both bidders start at 100.0 and since they're identical, no increments are needed
 	// The winner is determined by tie resolution (earlier entry wins)
-	if result.Winner.CurrentBid != 100.0 {
-		t.Errorf("Expected winner's current bid to be 100.0 (starting bid), got %f", result.Winner.CurrentBid)
+	if result.Winner.CurrentBid.Float64() != 100.0 {
+		t.Errorf("Expected winner's current bid to be 100.0 (starting bid), got %f", result.Winner.CurrentBid.Float64())
 	}
 }
 
@@ -388,20 +388,20 @@ func TestTieResolution_ComplexScenario_MultipleRoundsWithTies(t *testing.T) {
 
 	// Complex scenario with multiple bidders and increments
 	bidder1 := &models.Bidder{
-		ID: "1", Name: "Alice", StartingBid: 100.0, MaxBid: 200.0,
-		AutoIncrement: 15.0, EntryTime: now.Add(3 * time.Second), IsActive: true,
+		ID: "1", Name: "Alice", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(200.0),
+		AutoIncrement: models.Dollars(15.0), EntryTime: now.Add(3 * time.Second), IsActive: true,
 	}
 	bidder2 := &models.Bidder{
-		ID: "2", Name: "Bob", StartingBid: 105.0, MaxBid: 200.0,
-		AutoIncrement: 15.0, EntryTime: now, IsActive: true, // Earliest
+		ID: "2", Name: "Bob", StartingBid: models.Dollars(105.0), MaxBid: models.Dollars(200.0),
+		AutoIncrement: models.Dollars(15.0), EntryTime: now, IsActive: true, // Earliest
 	}
 	bidder3 := &models.Bidder{
-		ID: "3", Name: "Charlie", StartingBid: 95.0, MaxBid: 200.0,
-		AutoIncrement: 15.0, EntryTime: now.Add(time.Second), IsActive: true,
+		ID: "3", Name: "Charlie", StartingBid: models.Dollars(95.0), MaxBid: models.Dollars(200.0),
+		AutoIncrement: models.Dollars(15.0), EntryTime: now.Add(time.Second), IsActive: true,
 	}
 	bidder4 := &models.Bidder{
-		ID: "4", Name: "David", StartingBid: 90.0, MaxBid: 200.0,
-		AutoIncrement: 15.0, EntryTime: now.Add(2 * time.Second), IsActive: true,
+		ID: "4", Name: "David", StartingBid: models.Dollars(90.0), MaxBid: models.Dollars(200.0),
+		AutoIncrement: models.Dollars(15.0), EntryTime: now.Add(2 * time.Second), IsActive: true,
 	}
 
 	bidders := []models.Bidder{*bidder1, *bidder2, *bidder3, *bidder4}
@@ -424,8 +424,8 @@ func TestTieResolution_ComplexScenario_MultipleRoundsWithTies(t *testing.T) {
 	}
 
 	// Charlie should reach exactly 200
-	if result.Winner.CurrentBid != 200.0 {
-		t.Errorf("Expected winner's current bid to be 200.0, got %f", result.Winner.CurrentBid)
+	if result.Winner.CurrentBid.Float64() != 200.0 {
+		t.Errorf("Expected winner's current bid to be 200.0, got %f", result.Winner.CurrentBid.Float64())
 	}
 
 	// Verify that bidding rounds occurred
@@ -442,16 +442,16 @@ func TestTieResolution_DifferentMaxBids_HigherMaxWins(t *testing.T) {
 	// Test that higher max bid wins when both bidders need to increment
 	// Create a third bidder with higher starting bid to force increments
 	bidder1 := &models.Bidder{
-		ID: "1", Name: "Alice", StartingBid: 90.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now, IsActive: true, // Earlier entry
+		ID: "1", Name: "Alice", StartingBid: models.Dollars(90.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now, IsActive: true, // Earlier entry
 	}
 	bidder2 := &models.Bidder{
-		ID: "2", Name: "Bob", StartingBid: 95.0, MaxBid: 180.0,
-		AutoIncrement: 10.0, EntryTime: now.Add(time.Second), IsActive: true, // Later entry but higher max
+		ID: "2", Name: "Bob", StartingBid: models.Dollars(95.0), MaxBid: models.Dollars(180.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now.Add(time.Second), IsActive: true, // Later entry but higher max
 	}
 	bidder3 := &models.Bidder{
-		ID: "3", Name: "Charlie", StartingBid: 100.0, MaxBid: 120.0,
-		AutoIncrement: 5.0, EntryTime: now.Add(2 * time.Second), IsActive: true, // Forces others to increment
+		ID: "3", Name: "Charlie", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(120.0),
+		AutoIncrement: models.Dollars(5.0), EntryTime: now.Add(2 * time.Second), IsActive: true, // Forces others to increment
 	}
 
 	bidders := []models.Bidder{*bidder1, *bidder2, *bidder3}
@@ -474,8 +474,8 @@ func TestTieResolution_DifferentMaxBids_HigherMaxWins(t *testing.T) {
 	}
 
 	// Bob should reach a bid higher than Alice's max (150)
-	if result.Winner.CurrentBid <= 150.0 {
-		t.Errorf("Expected Bob to bid higher than Alice's max (150), got %f", result.Winner.CurrentBid)
+	if result.Winner.CurrentBid.Float64() <= 150.0 {
+		t.Errorf("Expected Bob to bid higher than Alice's max (150), got %f", result.Winner.CurrentBid.Float64())
 	}
 }
 
@@ -486,12 +486,12 @@ func TestTieResolution_IdenticalBiddersExceptEntryTime(t *testing.T) {
 
 	// Test with completely identical bidders except for entry time
 	bidder1 := &models.Bidder{
-		ID: "1", Name: "Alice", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now.Add(100 * time.Millisecond), IsActive: true,
+		ID: "1", Name: "Alice", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now.Add(100 * time.Millisecond), IsActive: true,
 	}
 	bidder2 := &models.Bidder{
-		ID: "2", Name: "Bob", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now, IsActive: true, // 100ms earlier
+		ID: "2", Name: "Bob", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now, IsActive: true, // 100ms earlier
 	}
 
 	bidders := []models.Bidder{*bidder1, *bidder2}
@@ -513,8 +513,8 @@ func TestTieResolution_IdenticalBiddersExceptEntryTime(t *testing.T) {
 
 	// Since both bidders are identical and start at the same bid (100.0),
 	// no increments are needed and tie resolution determines the winner
-	if result.Winner.CurrentBid != 100.0 {
-		t.Errorf("Expected winner's current bid to be 100.0 (starting bid), got %f", result.Winner.CurrentBid)
+	if result.Winner.CurrentBid.Float64() != 100.0 {
+		t.Errorf("Expected winner's current bid to be 100.0 (starting bid), got %f", result.Winner.CurrentBid.Float64())
 	}
 }
 
@@ -525,16 +525,16 @@ func TestTieResolution_SortingPreservesEntryTimeOrder(t *testing.T) {
 
 	// Create bidders in random order but with specific entry times
 	bidder1 := &models.Bidder{
-		ID: "1", Name: "Alice", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now.Add(3 * time.Second), IsActive: true, // Latest
+		ID: "1", Name: "Alice", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now.Add(3 * time.Second), IsActive: true, // Latest
 	}
 	bidder2 := &models.Bidder{
-		ID: "2", Name: "Bob", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now, IsActive: true, // Earliest
+		ID: "2", Name: "Bob", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now, IsActive: true, // Earliest
 	}
 	bidder3 := &models.Bidder{
-		ID: "3", Name: "Charlie", StartingBid: 100.0, MaxBid: 150.0,
-		AutoIncrement: 10.0, EntryTime: now.Add(time.Second), IsActive: true, // Middle
+		ID: "3", Name: "Charlie", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(150.0),
+		AutoIncrement: models.Dollars(10.0), EntryTime: now.Add(time.Second), IsActive: true, // Middle
 	}
 
 	// Pass bidders in non-chronological order to test sorting
@@ -574,8 +574,8 @@ func TestProcessBids_MaxRoundsTimeout(t *testing.T) {
 
 	// Create bidders that would cause many rounds of bidding
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 1.00, 100.00, 1.00),
-		*models.NewBidder("2", "Bob", 1.01, 99.00, 1.00),
+		*models.NewBidder("1", "Alice", models.Dollars(1.00), models.Dollars(100.00), models.Dollars(1.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(1.01), models.Dollars(99.00), models.Dollars(1.00)),
 	}
 
 	// Set entry times
@@ -609,7 +609,7 @@ func TestIncrementBids_SystemError(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	// Create a bidder with corrupted internal state (this is artificial for testing)
-	bidder := models.NewBidder("1", "Alice", 10.00, 20.00, 5.00)
+	bidder := models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00))
 
 	// Manually corrupt the bidder's internal state to trigger system error
 	// We'll use reflection or direct field access if possible, or create a scenario
@@ -648,7 +648,7 @@ func TestIncrementBids_EmptyAndSingleBidder(t *testing.T) {
 	}
 
 	// Test single bidder
-	bidder := models.NewBidder("1", "Alice", 10.00, 20.00, 5.00)
+	bidder := models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00))
 	incremented, err = engine.IncrementBids([]models.Bidder{*bidder})
 	if err != nil {
 		t.Fatalf("Expected no error with single bidder, got: %v", err)
@@ -664,7 +664,7 @@ func TestCalculateMinimumWinningBidCents_ErrorPaths(t *testing.T) {
 
 	// Test nil winner
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.00, 20.00, 5.00),
+		*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
 	}
 
 	_, err := engine.CalculateMinimumWinningBidCents(bidders, nil)
@@ -681,7 +681,7 @@ func TestCalculateMinimumWinningBidCents_ErrorPaths(t *testing.T) {
 	}
 
 	// Test empty bidders
-	winner := models.NewBidder("1", "Alice", 10.00, 20.00, 5.00)
+	winner := models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00))
 	_, err = engine.CalculateMinimumWinningBidCents([]models.Bidder{}, winner)
 	if err == nil {
 		t.Fatal("Expected error with empty bidders")
@@ -696,7 +696,7 @@ func TestCalculateMinimumWinningBidCents_ErrorPaths(t *testing.T) {
 	}
 
 	// Test winner not in bidders slice
-	winner = models.NewBidder("2", "Bob", 10.00, 20.00, 5.00)
+	winner = models.NewBidder("2", "Bob", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00))
 	_, err = engine.CalculateMinimumWinningBidCents(bidders, winner)
 	if err == nil {
 		t.Fatal("Expected error when winner not in bidders")
@@ -716,7 +716,7 @@ func TestCalculateMinimumWinningBidCents_EdgeCases(t *testing.T) {
 	engine := NewBiddingEngine()
 
 	// Test single bidder (no other bidders to compete against)
-	winner := models.NewBidder("1", "Alice", 10.00, 20.00, 5.00)
+	winner := models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00))
 	bidders := []models.Bidder{*winner}
 
 	winningBid, err := engine.CalculateMinimumWinningBidCents(bidders, winner)
@@ -731,8 +731,8 @@ func TestCalculateMinimumWinningBidCents_EdgeCases(t *testing.T) {
 	}
 
 	// Test winner pays minimum when calculated bid is less than starting bid
-	winner = models.NewBidder("1", "Alice", 15.00, 20.00, 1.00)
-	loser := models.NewBidder("2", "Bob", 10.00, 12.00, 1.00)
+	winner = models.NewBidder("1", "Alice", models.Dollars(15.00), models.Dollars(20.00), models.Dollars(1.00))
+	loser := models.NewBidder("2", "Bob", models.Dollars(10.00), models.Dollars(12.00), models.Dollars(1.00))
 	bidders = []models.Bidder{*winner, *loser}
 
 	winningBid, err = engine.CalculateMinimumWinningBidCents(bidders, winner)
@@ -746,8 +746,8 @@ func TestCalculateMinimumWinningBidCents_EdgeCases(t *testing.T) {
 	}
 
 	// Test winner pays max bid when calculated exceeds max
-	winner = models.NewBidder("1", "Alice", 10.00, 15.00, 1.00)
-	loser = models.NewBidder("2", "Bob", 10.00, 20.00, 1.00)
+	winner = models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(15.00), models.Dollars(1.00))
+	loser = models.NewBidder("2", "Bob", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(1.00))
 	bidders = []models.Bidder{*winner, *loser}
 
 	winningBid, err = engine.CalculateMinimumWinningBidCents(bidders, winner)
@@ -776,8 +776,8 @@ func TestFindWinner_ErrorPaths(t *testing.T) {
 
 	// Test normal case
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.00, 20.00, 5.00),
-		*models.NewBidder("2", "Bob", 15.00, 25.00, 5.00),
+		*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(15.00), models.Dollars(25.00), models.Dollars(5.00)),
 	}
 
 	// Set entry times for deterministic ordering
@@ -805,8 +805,8 @@ func TestFindWinner_TieResolution(t *testing.T) {
 
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.00, 20.00, 5.00),
-		*models.NewBidder("2", "Bob", 10.00, 20.00, 5.00),
+		*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
 	}
 
 	// Alice enters first
@@ -842,9 +842,9 @@ func TestFindHighestBidCents_ErrorPaths(t *testing.T) {
 
 	// Test normal case
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.00, 20.00, 5.00),
-		*models.NewBidder("2", "Bob", 15.00, 25.00, 5.00),
-		*models.NewBidder("3", "Charlie", 12.00, 22.00, 5.00),
+		*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(20.00), models.Dollars(5.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(15.00), models.Dollars(25.00), models.Dollars(5.00)),
+		*models.NewBidder("3", "Charlie", models.Dollars(12.00), models.Dollars(22.00), models.Dollars(5.00)),
 	}
 
 	highest, err = engine.findHighestBidCents(bidders)
@@ -863,7 +863,7 @@ func TestFindHighestBidCents_ErrorPaths(t *testing.T) {
 func TestFindHighestBidCents_SingleBidder(t *testing.T) {
 	engine := NewBiddingEngine()
 
-	bidder := models.NewBidder("1", "Alice", 10.50, 20.00, 5.00)
+	bidder := models.NewBidder("1", "Alice", models.Dollars(10.50), models.Dollars(20.00), models.Dollars(5.00))
 	bidders := []models.Bidder{*bidder}
 
 	highest, err := engine.findHighestBidCents(bidders)
@@ -883,10 +883,10 @@ func TestProcessBids_ComplexScenario(t *testing.T) {
 
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 100.00, 500.00, 50.00),
-		*models.NewBidder("2", "Bob", 110.00, 450.00, 40.00),
-		*models.NewBidder("3", "Charlie", 90.00, 300.00, 30.00),
-		*models.NewBidder("4", "Diana", 95.00, 200.00, 25.00),
+		*models.NewBidder("1", "Alice", models.Dollars(100.00), models.Dollars(500.00), models.Dollars(50.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(110.00), models.Dollars(450.00), models.Dollars(40.00)),
+		*models.NewBidder("3", "Charlie", models.Dollars(90.00), models.Dollars(300.00), models.Dollars(30.00)),
+		*models.NewBidder("4", "Diana", models.Dollars(95.00), models.Dollars(200.00), models.Dollars(25.00)),
 	}
 
 	// Set entry times
@@ -931,8 +931,8 @@ func TestProcessBids_NoWinner(t *testing.T) {
 	// where all bidders have the same parameters
 	baseTime := time.Now()
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 100.00, 100.00, 10.00),
-		*models.NewBidder("2", "Bob", 100.00, 100.00, 10.00),
+		*models.NewBidder("1", "Alice", models.Dollars(100.00), models.Dollars(100.00), models.Dollars(10.00)),
+		*models.NewBidder("2", "Bob", models.Dollars(100.00), models.Dollars(100.00), models.Dollars(10.00)),
 	}
 
 	// Set entry times
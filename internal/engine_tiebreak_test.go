@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// stalledTie returns two bidders who start level and therefore never outbid each other
+func stalledTie() []models.Bidder {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bidders := []models.Bidder{
+		*models.NewBidder("1", "Alice", models.Dollars(100), models.Dollars(150), models.Dollars(10)),
+		*models.NewBidder("2", "Bob", models.Dollars(100), models.Dollars(200), models.Dollars(10)),
+	}
+	bidders[0].EntryTime = base
+	bidders[1].EntryTime = base.Add(time.Second)
+	return bidders
+}
+
+func TestNewBiddingEngine_WithTieBreaker(t *testing.T) {
+	breaker := models.NewHighestMaxBidTieBreaker()
+	engine := NewBiddingEngine(WithTieBreaker(breaker))
+	if engine.TieBreaker() != breaker {
+		t.Error("Expected engine to use the given tie-breaker")
+	}
+	if NewBiddingEngine().TieBreaker().Rule() != models.TieBreakEarliestEntry {
+		t.Error("Expected default engine to break ties by earliest entry")
+	}
+}
+
+func TestProcessBids_TieBreakers(t *testing.T) {
+	tests := []struct {
+		name          string
+		breaker       models.TieBreaker
+		expectedID    string
+		expectedCents int64
+		expectedRule  models.TieBreakRule
+	}{
+		// Alice wins and pays Bob's max plus her increment, capped at her own max
+		{"default", nil, "1", 15000, models.TieBreakEarliestEntry},
+		// Bob wins and pays Alice's max plus his increment
+		{"highest max bid", models.NewHighestMaxBidTieBreaker(), "2", 16000, models.TieBreakHighestMaxBid},
+	}
+
+	for _, strategy := range []ResolutionStrategy{StrategyIterative, StrategyClosedForm} {
+		for _, tt := range tests {
+			t.Run(strategy.String()+"/"+tt.name, func(t *testing.T) {
+				engine := NewBiddingEngine(WithTieBreaker(tt.breaker))
+				engine.strategy = strategy
+
+				result, err := engine.ProcessBids(stalledTie())
+				if err != nil {
+					t.Fatalf("Expected no error, got: %v", err)
+				}
+				if result.Winner.ID != tt.expectedID {
+					t.Errorf("Expected bidder %s to win, got %s", tt.expectedID, result.Winner.ID)
+				}
+				if result.GetWinningBidCents() != tt.expectedCents {
+					t.Errorf("Expected winning bid %d cents, got %d", tt.expectedCents, result.GetWinningBidCents())
+				}
+				if result.TieBreak != tt.expectedRule {
+					t.Errorf("Expected tie broken by %q, got %q", tt.expectedRule, result.TieBreak)
+				}
+			})
+		}
+	}
+}
+
+func TestProcessBids_NoTieRecordsNoRule(t *testing.T) {
+	bidders := stalledTie()
+	bidders[1].StartingBid = models.Dollars(105)
+
+	result, err := NewBiddingEngine(WithTieBreaker(models.NewHighestMaxBidTieBreaker())).ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.TieBreak != models.TieBreakNone {
+		t.Errorf("Expected no tie-break rule, got %q", result.TieBreak)
+	}
+}
@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"context"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// Format settles bids under a set of auction rules other than English proxy bidding
+// Settle receives a copy of the bid set normalized into the settlement currency, with current bids
+// reset to starting bids and sorted by entry time and sequence. It applies the reserve price itself;
+// the engine then records the format's name and, in multi-currency auctions, the local winning bid.
+type Format interface {
+	// Name is recorded in BidResult.Format
+	Name() models.AuctionFormat
+	// Settle determines the winner and price of the bid set
+	Settle(ctx context.Context, engine *BiddingEngine, bidders []models.Bidder) (*models.BidResult, error)
+}
+
+// builtinFormat returns the built-in format with the given name, or nil for English proxy bidding
+// The boolean is false when no built-in format has the name
+func builtinFormat(name models.AuctionFormat) (Format, bool) {
+	switch name {
+	case models.FormatEnglish:
+		return nil, true
+	case models.FormatFirstPriceSealed:
+		return NewFirstPriceSealedFormat(), true
+	case models.FormatVickrey:
+		return newVickreyFormat(models.SecondPriceExact), true
+	default:
+		return nil, false
+	}
+}
+
+// Format returns the name of the format the engine settles bids under
+func (be *BiddingEngine) Format() models.AuctionFormat {
+	if be.format != nil {
+		return be.format.Name()
+	}
+	return be.config.AuctionFormat()
+}
+
+// resolveFormat returns the format set with WithFormat or named by the configuration (nil for English)
+func (be *BiddingEngine) resolveFormat() (Format, error) {
+	if be.format != nil {
+		return be.format, nil
+	}
+	format, ok := builtinFormat(be.config.AuctionFormat())
+	if !ok {
+		inputErr := models.NewInputError("unsupported auction format", "config.Format", string(be.config.Format))
+		inputErr.WithOperation("ProcessBids").WithCode(models.CodeEngineFormatUnsupported)
+		return nil, inputErr
+	}
+	return format, nil
+}
+
+// settleFormat runs a format over the prepared bid set and completes its result
+// original is the bid set as given, before normalization, used to express the price in the winner's currency
+func (be *BiddingEngine) settleFormat(ctx context.Context, format Format, original, bidders []models.Bidder) (*models.BidResult, error) {
+	result, err := format.Settle(ctx, be, bidders)
+	if err != nil {
+		be.warn("bid processing failed", "bidders", len(bidders), "format", string(format.Name()), "error", err)
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	if be.config.IsMultiCurrency() && result.Winner != nil {
+		local, err := be.localWinningBid(original, result.Winner, result.WinningBid)
+		if err != nil {
+			processingErr := models.NewProcessingErrorWithCause("failed to convert winning bid to bidder currency", err, len(bidders), result.BiddingRounds)
+			processingErr.WithOperation("ProcessBids.LocalWinningBid").WithCode(models.CodeEngineConversionFailed)
+			processingErr.AddContext("winner_id", result.Winner.ID)
+			return nil, processingErr
+		}
+		result.WithLocalWinningBid(local)
+	}
+
+	winnerID := ""
+	if result.Winner != nil {
+		winnerID = result.Winner.ID
+	}
+	be.debug("bids processed", "bidders", len(bidders), "format", string(format.Name()), "winner_id", winnerID, "winning_bid_cents", result.GetWinningBidCents())
+	return result, nil
+}
+
+// winnerError wraps a failure to select a format's winner
+func winnerError(err error, bidderCount int, format models.AuctionFormat) error {
+	processingErr := models.NewProcessingErrorWithCause("failed to determine winner", err, bidderCount, 0)
+	processingErr.WithOperation("ProcessBids.FindWinner").WithCode(models.CodeEngineWinnerFailed)
+	processingErr.AddContext("format", string(format))
+	return processingErr
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// lastEntryFormat awards the lot to the last bidder to enter at their starting bid
+type lastEntryFormat struct {
+	received []models.Bidder
+}
+
+func (f *lastEntryFormat) Name() models.AuctionFormat { return "last_entry" }
+
+func (f *lastEntryFormat) Settle(ctx context.Context, be *BiddingEngine, bidders []models.Bidder) (*models.BidResult, error) {
+	f.received = append([]models.Bidder(nil), bidders...)
+	winner := &bidders[len(bidders)-1]
+	return models.NewBidResult(winner, winner.StartingBid, len(bidders), 0, bidders), nil
+}
+
+func TestBiddingEngine_Format(t *testing.T) {
+	tests := []struct {
+		name     string
+		engine   *BiddingEngine
+		expected models.AuctionFormat
+	}{
+		{"default", NewBiddingEngine(), models.FormatEnglish},
+		{"named in config", NewBiddingEngine(WithConfig(models.NewAuctionConfigWithFormat(models.FormatFirstPriceSealed))), models.FormatFirstPriceSealed},
+		{"vickrey named in config", NewBiddingEngine(WithConfig(models.NewAuctionConfigWithFormat(models.FormatVickrey))), models.FormatVickrey},
+		{"format option", NewBiddingEngine(WithFormat(&lastEntryFormat{})), "last_entry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.engine.Format() != tt.expected {
+				t.Errorf("Expected format %q, got %q", tt.expected, tt.engine.Format())
+			}
+			result, err := tt.engine.ProcessBids(stalledTie())
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result.Format != tt.expected {
+				t.Errorf("Expected the result to record format %q, got %q", tt.expected, result.Format)
+			}
+		})
+	}
+}
+
+func TestBiddingEngine_CustomFormat(t *testing.T) {
+	format := &lastEntryFormat{}
+	engine := NewBiddingEngine(WithFormat(format))
+
+	// Bob entered last, so he wins even though he is listed first
+	bidders := stalledTie()
+	bidders[0], bidders[1] = bidders[1], bidders[0]
+	result, err := engine.ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != "2" || !result.WinningBid.Equal(models.Dollars(100)) {
+		t.Errorf("Expected Bob to win at 100.00, got %s at %s", result.Winner.ID, result.WinningBid)
+	}
+	if len(format.received) != 2 || format.received[0].ID != "1" {
+		t.Errorf("Expected the format to receive bidders in entry order, got %+v", format.received)
+	}
+	if bidders[0].ID != "2" {
+		t.Error("Expected the caller's slice to be unchanged")
+	}
+}
+
+func TestBiddingEngine_UnsupportedFormat(t *testing.T) {
+	engine := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithFormat("candle")))
+
+	_, err := engine.ProcessBids(stalledTie())
+	if !errors.Is(err, models.ErrInput) {
+		t.Fatalf("Expected an input error, got: %v", err)
+	}
+	if !models.HasCode(err, models.CodeEngineFormatUnsupported) {
+		t.Errorf("Expected code %s, got %s", models.CodeEngineFormatUnsupported, models.CodeOf(err))
+	}
+}
@@ -0,0 +1,39 @@
+package models
+
+// AuditMode selects how much of the bidding process the engine records
+type AuditMode int
+
+const (
+	// AuditOff records nothing (the default)
+	AuditOff AuditMode = iota
+	// AuditFull records every increment
+	AuditFull
+	// AuditLeaderChanges records only the increments that changed the leader, keeping the trail short for large auctions
+	AuditLeaderChanges
+)
+
+// String returns the audit mode name
+func (am AuditMode) String() string {
+	switch am {
+	case AuditOff:
+		return "off"
+	case AuditFull:
+		return "full"
+	case AuditLeaderChanges:
+		return "leader_changes"
+	default:
+		return "unknown"
+	}
+}
+
+// AuditEntry records one increment of one bidder's current bid
+type AuditEntry struct {
+	Round         int    `json:"round"`          // Bidding round the increment happened in (0 for closed-form settlement)
+	BidderID      string `json:"bidder_id"`      // Bidder whose bid moved
+	PreviousCents int64  `json:"previous_cents"` // Current bid before the step, in minor units
+	NewCents      int64  `json:"new_cents"`      // Current bid after the step, in minor units
+	LeaderID      string `json:"leader_id"`      // Leading bidder after the step
+}
+
+// AuditSink receives audit entries as they are recorded
+type AuditSink func(entry AuditEntry)
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAuditMode_String(t *testing.T) {
+	tests := map[AuditMode]string{
+		AuditOff:           "off",
+		AuditFull:          "full",
+		AuditLeaderChanges: "leader_changes",
+		AuditMode(42):      "unknown",
+	}
+	for mode, expected := range tests {
+		if mode.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, mode.String())
+		}
+	}
+}
+
+func TestBidResult_AuditTrailJSON(t *testing.T) {
+	winner := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00))
+	result := NewBidResult(winner, Dollars(15.00), 1, 1, []Bidder{*winner})
+	result.AuditTrail = []AuditEntry{{Round: 1, BidderID: "1", PreviousCents: 1000, NewCents: 1500, LeaderID: "1"}}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var decoded BidResult
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(decoded.AuditTrail) != 1 || decoded.AuditTrail[0] != result.AuditTrail[0] {
+		t.Errorf("Expected audit trail to round trip, got %+v", decoded.AuditTrail)
+	}
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -8,86 +10,209 @@ import (
 type Bidder struct {
 	ID            string    `json:"id" validate:"required"`                  // Unique identifier
 	Name          string    `json:"name" validate:"required"`                // Bidder name
-	StartingBid   float64   `json:"starting_bid" validate:"required,gt=0"`   // Initial bid amount
-	MaxBid        float64   `json:"max_bid" validate:"required,gt=0"`        // Maximum willing to pay
-	AutoIncrement float64   `json:"auto_increment" validate:"required,gt=0"` // Increment amount
-	CurrentBid    float64   `json:"current_bid"`                             // Current active bid
+	StartingBid   Money     `json:"starting_bid" validate:"required,gt=0"`   // Initial bid amount
+	MaxBid        Money     `json:"max_bid" validate:"required,gt=0"`        // Maximum willing to pay
+	AutoIncrement Money     `json:"auto_increment" validate:"required,gt=0"` // Increment amount
+	CurrentBid    Money     `json:"current_bid"`                             // Current active bid
 	EntryTime     time.Time `json:"entry_time"`                              // When bid was submitted
+	Sequence      uint64    `json:"sequence,omitempty"`                      // Arrival order among bids with the same entry time
+	Quantity      int       `json:"quantity,omitempty"`                      // Units wanted at up to MaxBid each in a multi-unit auction (0 means 1)
 	IsActive      bool      `json:"is_active"`                               // Whether bidder can still increment
+}
 
-	// Internal fields for precise calculations (stored as cents)
-	startingBidCents   int64 // Starting bid in cents
-	maxBidCents        int64 // Maximum bid in cents
-	autoIncrementCents int64 // Auto increment in cents
-	currentBidCents    int64 // Current bid in cents
+// NewBidder creates a new Bidder with the provided parameters, entered at the current wall-clock time
+func NewBidder(id, name string, startingBid, maxBid, autoIncrement Money) *Bidder {
+	return NewBidderWithClock(id, name, startingBid, maxBid, autoIncrement, NewSystemClock())
 }
 
-// NewBidder creates a new Bidder with the provided parameters
-func NewBidder(id, name string, startingBid, maxBid, autoIncrement float64) *Bidder {
-	bidder := &Bidder{
+// NewBidderWithClock creates a new Bidder whose entry time is read from the given clock
+func NewBidderWithClock(id, name string, startingBid, maxBid, autoIncrement Money, clock Clock) *Bidder {
+	return &Bidder{
 		ID:            id,
 		Name:          name,
 		StartingBid:   startingBid,
 		MaxBid:        maxBid,
 		AutoIncrement: autoIncrement,
 		CurrentBid:    startingBid,
-		EntryTime:     time.Now(),
+		EntryTime:     clock.Now(),
 		IsActive:      true,
 	}
+}
+
+// Reset returns the bidder to its state before any bidding: the current bid is the starting bid
+// and the bidder is active. Identity, limits, entry time and sequence are kept.
+func (b *Bidder) Reset() {
+	b.CurrentBid = b.StartingBid
+	b.IsActive = true
+}
+
+// EnteredBefore returns true if the bidder takes priority over other in a tie: an earlier entry
+// time wins, and identical entry times fall back to the lower sequence number
+func (b *Bidder) EnteredBefore(other *Bidder) bool {
+	if !b.EntryTime.Equal(other.EntryTime) {
+		return b.EntryTime.Before(other.EntryTime)
+	}
+	return b.Sequence < other.Sequence
+}
 
-	// Convert to cents for precise calculations
-	bidder.startingBidCents = DollarsToCents(startingBid)
-	bidder.maxBidCents = DollarsToCents(maxBid)
-	bidder.autoIncrementCents = DollarsToCents(autoIncrement)
-	bidder.currentBidCents = bidder.startingBidCents
+// Units returns the number of units the bidder wants in a multi-unit auction
+func (b *Bidder) Units() int {
+	if b.Quantity <= 0 {
+		return 1
+	}
+	return b.Quantity
+}
 
-	return bidder
+// Currency returns the currency the bidder's amounts are expressed in
+func (b *Bidder) Currency() Currency {
+	return b.StartingBid.Currency()
 }
 
 // CanIncrement checks if the bidder can increment their current bid
 func (b *Bidder) CanIncrement() bool {
-	return b.IsActive && (b.currentBidCents+b.autoIncrementCents) <= b.maxBidCents
+	return b.CanIncrementBy(b.AutoIncrement.Amount())
+}
+
+// CanIncrementBy checks if the bidder can raise their current bid by the given increment in minor units
+func (b *Bidder) CanIncrementBy(incrementCents int64) bool {
+	if !b.IsActive {
+		return false
+	}
+	next, err := b.CurrentBid.Add(NewMoney(incrementCents, b.CurrentBid.Currency()))
+	if err != nil {
+		return false
+	}
+	cmp, err := next.Cmp(b.MaxBid)
+	return err == nil && cmp <= 0
 }
 
 // Increment increases the bidder's current bid by their auto-increment amount
 func (b *Bidder) Increment() bool {
-	if !b.CanIncrement() {
+	return b.IncrementBy(b.AutoIncrement.Amount())
+}
+
+// IncrementBy increases the bidder's current bid by the given increment in minor units
+func (b *Bidder) IncrementBy(incrementCents int64) bool {
+	if !b.CanIncrementBy(incrementCents) {
 		return false
 	}
-	b.currentBidCents += b.autoIncrementCents
-	if b.currentBidCents >= b.maxBidCents {
-		b.currentBidCents = b.maxBidCents
+	b.CurrentBid, _ = b.CurrentBid.Add(NewMoney(incrementCents, b.CurrentBid.Currency()))
+	if b.CurrentBid.Amount() >= b.MaxBid.Amount() {
+		b.CurrentBid = b.MaxBid
 		b.IsActive = false
 	}
-	// Update the float64 field for external API compatibility
-	b.CurrentBid = CentsToDollars(b.currentBidCents)
 	return true
 }
 
-// GetCurrentBidCents returns the current bid in cents for precise calculations
+// RemainingIncrements returns how many more auto-increments the bidder can afford
+func (b *Bidder) RemainingIncrements() int64 {
+	step := b.AutoIncrement.Amount()
+	if !b.IsActive || step <= 0 || !b.CurrentBid.SameCurrency(b.MaxBid) {
+		return 0
+	}
+	headroom, err := b.MaxBid.Sub(b.CurrentBid)
+	if err != nil || !headroom.IsPositive() {
+		return 0
+	}
+	return headroom.Amount() / step
+}
+
+// IncrementTimes applies n auto-increments at once, following the same capping rules as Increment
+func (b *Bidder) IncrementTimes(n int64) bool {
+	if n <= 0 || n > b.RemainingIncrements() {
+		return false
+	}
+	total, err := b.AutoIncrement.Mul(n)
+	if err != nil {
+		return false
+	}
+	return b.IncrementBy(total.Amount())
+}
+
+// AdvanceTo moves the current bid up to targetCents as a series of increments ending there would
+func (b *Bidder) AdvanceTo(targetCents int64) bool {
+	if !b.IsActive || targetCents <= b.CurrentBid.Amount() || targetCents > b.MaxBid.Amount() {
+		return false
+	}
+	return b.IncrementBy(targetCents - b.CurrentBid.Amount())
+}
+
+// GetCurrentBidCents returns the current bid in minor units for precise calculations
 func (b *Bidder) GetCurrentBidCents() int64 {
-	return b.currentBidCents
+	return b.CurrentBid.Amount()
 }
 
-// GetMaxBidCents returns the maximum bid in cents for precise calculations
+// GetMaxBidCents returns the maximum bid in minor units for precise calculations
 func (b *Bidder) GetMaxBidCents() int64 {
-	return b.maxBidCents
+	return b.MaxBid.Amount()
 }
 
-// GetAutoIncrementCents returns the auto increment in cents for precise calculations
+// GetAutoIncrementCents returns the auto increment in minor units for precise calculations
 func (b *Bidder) GetAutoIncrementCents() int64 {
-	return b.autoIncrementCents
+	return b.AutoIncrement.Amount()
 }
 
-// GetStartingBidCents returns the starting bid in cents for precise calculations
+// GetStartingBidCents returns the starting bid in minor units for precise calculations
 func (b *Bidder) GetStartingBidCents() int64 {
-	return b.startingBidCents
+	return b.StartingBid.Amount()
+}
+
+// bidderJSON is the wire format of a Bidder: amounts are plain numbers in major units
+// (as they were before Money was introduced) plus a currency code
+type bidderJSON struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Currency      Currency  `json:"currency"`
+	StartingBid   float64   `json:"starting_bid"`
+	MaxBid        float64   `json:"max_bid"`
+	AutoIncrement float64   `json:"auto_increment"`
+	CurrentBid    float64   `json:"current_bid"`
+	EntryTime     time.Time `json:"entry_time"`
+	Sequence      uint64    `json:"sequence,omitempty"`
+	Quantity      int       `json:"quantity,omitempty"`
+	IsActive      bool      `json:"is_active"`
+}
+
+// MarshalJSON encodes the bidder using the backward compatible numeric amount fields
+func (b Bidder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bidderJSON{
+		ID:            b.ID,
+		Name:          b.Name,
+		Currency:      b.Currency(),
+		StartingBid:   b.StartingBid.Float64(),
+		MaxBid:        b.MaxBid.Float64(),
+		AutoIncrement: b.AutoIncrement.Float64(),
+		CurrentBid:    b.CurrentBid.Float64(),
+		EntryTime:     b.EntryTime,
+		Sequence:      b.Sequence,
+		Quantity:      b.Quantity,
+		IsActive:      b.IsActive,
+	})
 }
 
-// SyncFloatFields updates the float64 fields from the precise cent values
-func (b *Bidder) SyncFloatFields() {
-	b.CurrentBid = CentsToDollars(b.currentBidCents)
-	b.StartingBid = CentsToDollars(b.startingBidCents)
-	b.MaxBid = CentsToDollars(b.maxBidCents)
-	b.AutoIncrement = CentsToDollars(b.autoIncrementCents)
+// UnmarshalJSON decodes numeric amount fields; a missing currency defaults to DefaultCurrency
+func (b *Bidder) UnmarshalJSON(data []byte) error {
+	var decoded bidderJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	currency := Currency(strings.ToUpper(string(decoded.Currency)))
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+
+	*b = Bidder{
+		ID:            decoded.ID,
+		Name:          decoded.Name,
+		StartingBid:   MoneyFromMajor(decoded.StartingBid, currency),
+		MaxBid:        MoneyFromMajor(decoded.MaxBid, currency),
+		AutoIncrement: MoneyFromMajor(decoded.AutoIncrement, currency),
+		CurrentBid:    MoneyFromMajor(decoded.CurrentBid, currency),
+		EntryTime:     decoded.EntryTime,
+		Sequence:      decoded.Sequence,
+		Quantity:      decoded.Quantity,
+		IsActive:      decoded.IsActive,
+	}
+	return nil
 }
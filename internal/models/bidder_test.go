@@ -1,7 +1,9 @@
 package models
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 )
 
 // TestNewBidder tests the NewBidder constructor
@@ -12,7 +14,7 @@ func TestNewBidder(t *testing.T) {
 	maxBid := 25.75
 	autoIncrement := 2.25
 
-	bidder := NewBidder(id, name, startingBid, maxBid, autoIncrement)
+	bidder := NewBidder(id, name, Dollars(startingBid), Dollars(maxBid), Dollars(autoIncrement))
 
 	if bidder == nil {
 		t.Fatal("Expected bidder, got nil")
@@ -26,20 +28,20 @@ func TestNewBidder(t *testing.T) {
 		t.Errorf("Expected name '%s', got '%s'", name, bidder.Name)
 	}
 
-	if bidder.StartingBid != startingBid {
-		t.Errorf("Expected starting bid %.2f, got %.2f", startingBid, bidder.StartingBid)
+	if bidder.StartingBid.Float64() != startingBid {
+		t.Errorf("Expected starting bid %.2f, got %.2f", startingBid, bidder.StartingBid.Float64())
 	}
 
-	if bidder.MaxBid != maxBid {
-		t.Errorf("Expected max bid %.2f, got %.2f", maxBid, bidder.MaxBid)
+	if bidder.MaxBid.Float64() != maxBid {
+		t.Errorf("Expected max bid %.2f, got %.2f", maxBid, bidder.MaxBid.Float64())
 	}
 
-	if bidder.AutoIncrement != autoIncrement {
-		t.Errorf("Expected auto increment %.2f, got %.2f", autoIncrement, bidder.AutoIncrement)
+	if bidder.AutoIncrement.Float64() != autoIncrement {
+		t.Errorf("Expected auto increment %.2f, got %.2f", autoIncrement, bidder.AutoIncrement.Float64())
 	}
 
-	if bidder.CurrentBid != startingBid {
-		t.Errorf("Expected current bid to equal starting bid %.2f, got %.2f", startingBid, bidder.CurrentBid)
+	if bidder.CurrentBid.Float64() != startingBid {
+		t.Errorf("Expected current bid to equal starting bid %.2f, got %.2f", startingBid, bidder.CurrentBid.Float64())
 	}
 
 	if !bidder.IsActive {
@@ -119,7 +121,7 @@ func TestBidder_CanIncrement(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			bidder := NewBidder("1", "Test", tt.startingBid, tt.maxBid, tt.autoIncrement)
+			bidder := NewBidder("1", "Test", Dollars(tt.startingBid), Dollars(tt.maxBid), Dollars(tt.autoIncrement))
 			bidder.IsActive = tt.isActive
 
 			result := bidder.CanIncrement()
@@ -172,7 +174,7 @@ func TestBidder_Increment(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			bidder := NewBidder("1", "Test", tt.startingBid, tt.maxBid, tt.autoIncrement)
+			bidder := NewBidder("1", "Test", Dollars(tt.startingBid), Dollars(tt.maxBid), Dollars(tt.autoIncrement))
 
 			// If starting at max, set inactive
 			if tt.startingBid >= tt.maxBid {
@@ -184,8 +186,8 @@ func TestBidder_Increment(t *testing.T) {
 				t.Errorf("Expected Increment() = %v, got %v", tt.expectedSuccess, success)
 			}
 
-			if bidder.CurrentBid != tt.expectedNewBid {
-				t.Errorf("Expected current bid %.2f, got %.2f", tt.expectedNewBid, bidder.CurrentBid)
+			if bidder.CurrentBid.Float64() != tt.expectedNewBid {
+				t.Errorf("Expected current bid %.2f, got %.2f", tt.expectedNewBid, bidder.CurrentBid.Float64())
 			}
 
 			if bidder.IsActive != tt.expectedActive {
@@ -207,7 +209,7 @@ func TestBidder_GetMethods(t *testing.T) {
 	maxBid := 56.78
 	autoIncrement := 9.01
 
-	bidder := NewBidder("1", "Test", startingBid, maxBid, autoIncrement)
+	bidder := NewBidder("1", "Test", Dollars(startingBid), Dollars(maxBid), Dollars(autoIncrement))
 
 	// Test GetCurrentBidCents
 	expectedCurrentCents := DollarsToCents(startingBid)
@@ -234,54 +236,44 @@ func TestBidder_GetMethods(t *testing.T) {
 	}
 }
 
-// TestBidder_SyncFloatFields tests the SyncFloatFields method
-func TestBidder_SyncFloatFields(t *testing.T) {
-	bidder := NewBidder("1", "Test", 10.00, 20.00, 2.50)
+// TestBidder_CentGettersTrackMoneyFields tests that the cent getters read the Money fields directly
+func TestBidder_CentGettersTrackMoneyFields(t *testing.T) {
+	bidder := NewBidder("1", "Test", Dollars(10.00), Dollars(20.00), Dollars(2.50))
 
-	// Increment the bidder to change internal state
+	// Increment the bidder to change its state
 	bidder.Increment()
 
-	// Manually modify the float fields to test sync
-	bidder.CurrentBid = 999.99
-	bidder.StartingBid = 888.88
-	bidder.MaxBid = 777.77
-	bidder.AutoIncrement = 666.66
+	// Assigning the exported fields is the only state there is
+	bidder.CurrentBid = Dollars(999.99)
+	bidder.StartingBid = Dollars(888.88)
+	bidder.MaxBid = Dollars(777.77)
+	bidder.AutoIncrement = Dollars(666.66)
 
-	// Sync should restore correct values from cents
-	bidder.SyncFloatFields()
-
-	expectedCurrent := CentsToDollars(bidder.GetCurrentBidCents())
-	if bidder.CurrentBid != expectedCurrent {
-		t.Errorf("Expected current bid %.2f after sync, got %.2f", expectedCurrent, bidder.CurrentBid)
+	if bidder.GetCurrentBidCents() != 99999 {
+		t.Errorf("Expected current bid cents 99999, got %d", bidder.GetCurrentBidCents())
 	}
-
-	expectedStarting := CentsToDollars(bidder.GetStartingBidCents())
-	if bidder.StartingBid != expectedStarting {
-		t.Errorf("Expected starting bid %.2f after sync, got %.2f", expectedStarting, bidder.StartingBid)
+	if bidder.GetStartingBidCents() != 88888 {
+		t.Errorf("Expected starting bid cents 88888, got %d", bidder.GetStartingBidCents())
 	}
-
-	expectedMax := CentsToDollars(bidder.GetMaxBidCents())
-	if bidder.MaxBid != expectedMax {
-		t.Errorf("Expected max bid %.2f after sync, got %.2f", expectedMax, bidder.MaxBid)
+	if bidder.GetMaxBidCents() != 77777 {
+		t.Errorf("Expected max bid cents 77777, got %d", bidder.GetMaxBidCents())
 	}
-
-	expectedIncrement := CentsToDollars(bidder.GetAutoIncrementCents())
-	if bidder.AutoIncrement != expectedIncrement {
-		t.Errorf("Expected auto increment %.2f after sync, got %.2f", expectedIncrement, bidder.AutoIncrement)
+	if bidder.GetAutoIncrementCents() != 66666 {
+		t.Errorf("Expected auto increment cents 66666, got %d", bidder.GetAutoIncrementCents())
 	}
 }
 
 // TestBidder_MultipleIncrements tests multiple increments
 func TestBidder_MultipleIncrements(t *testing.T) {
-	bidder := NewBidder("1", "Test", 10.00, 25.00, 5.00)
+	bidder := NewBidder("1", "Test", Dollars(10.00), Dollars(25.00), Dollars(5.00))
 
 	// First increment: 10.00 -> 15.00
 	success := bidder.Increment()
 	if !success {
 		t.Fatal("Expected first increment to succeed")
 	}
-	if bidder.CurrentBid != 15.00 {
-		t.Errorf("Expected current bid 15.00, got %.2f", bidder.CurrentBid)
+	if bidder.CurrentBid.Float64() != 15.00 {
+		t.Errorf("Expected current bid 15.00, got %.2f", bidder.CurrentBid.Float64())
 	}
 	if !bidder.IsActive {
 		t.Error("Expected bidder to remain active")
@@ -292,8 +284,8 @@ func TestBidder_MultipleIncrements(t *testing.T) {
 	if !success {
 		t.Fatal("Expected second increment to succeed")
 	}
-	if bidder.CurrentBid != 20.00 {
-		t.Errorf("Expected current bid 20.00, got %.2f", bidder.CurrentBid)
+	if bidder.CurrentBid.Float64() != 20.00 {
+		t.Errorf("Expected current bid 20.00, got %.2f", bidder.CurrentBid.Float64())
 	}
 	if !bidder.IsActive {
 		t.Error("Expected bidder to remain active")
@@ -304,8 +296,8 @@ func TestBidder_MultipleIncrements(t *testing.T) {
 	if !success {
 		t.Fatal("Expected third increment to succeed")
 	}
-	if bidder.CurrentBid != 25.00 {
-		t.Errorf("Expected current bid 25.00, got %.2f", bidder.CurrentBid)
+	if bidder.CurrentBid.Float64() != 25.00 {
+		t.Errorf("Expected current bid 25.00, got %.2f", bidder.CurrentBid.Float64())
 	}
 	if bidder.IsActive {
 		t.Error("Expected bidder to become inactive at max bid")
@@ -316,15 +308,15 @@ func TestBidder_MultipleIncrements(t *testing.T) {
 	if success {
 		t.Error("Expected fourth increment to fail")
 	}
-	if bidder.CurrentBid != 25.00 {
-		t.Errorf("Expected current bid to remain 25.00, got %.2f", bidder.CurrentBid)
+	if bidder.CurrentBid.Float64() != 25.00 {
+		t.Errorf("Expected current bid to remain 25.00, got %.2f", bidder.CurrentBid.Float64())
 	}
 }
 
 // TestBidder_PrecisionHandling tests precision handling with fractional cents
 func TestBidder_PrecisionHandling(t *testing.T) {
 	// Use values that might cause floating-point precision issues
-	bidder := NewBidder("1", "Test", 10.01, 20.99, 0.33)
+	bidder := NewBidder("1", "Test", Dollars(10.01), Dollars(20.99), Dollars(0.33))
 
 	// Test that cents conversion is accurate
 	expectedStartingCents := int64(1001) // 10.01 * 100
@@ -354,7 +346,245 @@ func TestBidder_PrecisionHandling(t *testing.T) {
 	}
 
 	expectedNewDollars := 10.34 // Should be precise
-	if bidder.CurrentBid != expectedNewDollars {
-		t.Errorf("Expected current bid %.2f, got %.2f", expectedNewDollars, bidder.CurrentBid)
+	if bidder.CurrentBid.Float64() != expectedNewDollars {
+		t.Errorf("Expected current bid %.2f, got %.2f", expectedNewDollars, bidder.CurrentBid.Float64())
+	}
+}
+
+// TestBidder_RemainingIncrements tests how many increments a bidder can still afford
+func TestBidder_RemainingIncrements(t *testing.T) {
+	bidder := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(3.00))
+	if got := bidder.RemainingIncrements(); got != 3 {
+		t.Errorf("Expected 3 remaining increments, got %d", got)
+	}
+
+	bidder.IsActive = false
+	if got := bidder.RemainingIncrements(); got != 0 {
+		t.Errorf("Expected 0 remaining increments for inactive bidder, got %d", got)
+	}
+
+	zeroIncrement := NewBidder("2", "Bob", Dollars(10.00), Dollars(20.00), Dollars(0))
+	if got := zeroIncrement.RemainingIncrements(); got != 0 {
+		t.Errorf("Expected 0 remaining increments with zero auto-increment, got %d", got)
+	}
+}
+
+// TestBidder_IncrementTimes tests that bulk increments match repeated Increment calls
+func TestBidder_IncrementTimes(t *testing.T) {
+	bulk := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(2.50))
+	single := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(2.50))
+
+	if !bulk.IncrementTimes(4) {
+		t.Fatal("Expected IncrementTimes(4) to succeed")
+	}
+	for i := 0; i < 4; i++ {
+		single.Increment()
+	}
+
+	if bulk.GetCurrentBidCents() != single.GetCurrentBidCents() {
+		t.Errorf("Expected current bid cents %d, got %d", single.GetCurrentBidCents(), bulk.GetCurrentBidCents())
+	}
+	if bulk.IsActive != single.IsActive {
+		t.Errorf("Expected IsActive %v, got %v", single.IsActive, bulk.IsActive)
+	}
+	if bulk.CurrentBid.Float64() != 20.00 {
+		t.Errorf("Expected current bid 20.00, got %.2f", bulk.CurrentBid.Float64())
+	}
+
+	// Exceeding the affordable increments must not change state
+	other := NewBidder("2", "Bob", Dollars(10.00), Dollars(20.00), Dollars(2.50))
+	if other.IncrementTimes(5) {
+		t.Error("Expected IncrementTimes(5) to fail")
+	}
+	if other.IncrementTimes(0) {
+		t.Error("Expected IncrementTimes(0) to report no change")
+	}
+	if other.GetCurrentBidCents() != 1000 {
+		t.Errorf("Expected current bid cents to stay 1000, got %d", other.GetCurrentBidCents())
+	}
+}
+
+// TestBidder_IncrementBy tests increments by an externally supplied amount
+func TestBidder_IncrementBy(t *testing.T) {
+	bidder := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(0))
+
+	if !bidder.CanIncrementBy(500) {
+		t.Error("Expected bidder to afford a 500 cent increment")
+	}
+	if !bidder.IncrementBy(500) {
+		t.Fatal("Expected IncrementBy(500) to succeed")
+	}
+	if bidder.CurrentBid.Float64() != 15.00 {
+		t.Errorf("Expected current bid 15.00, got %.2f", bidder.CurrentBid.Float64())
+	}
+
+	if bidder.IncrementBy(600) {
+		t.Error("Expected IncrementBy past the max bid to fail")
+	}
+	if !bidder.IncrementBy(500) {
+		t.Fatal("Expected IncrementBy to reach the max bid")
+	}
+	if bidder.IsActive {
+		t.Error("Expected bidder to be inactive at the max bid")
+	}
+}
+
+// TestBidder_AdvanceTo tests moving directly to a target bid
+func TestBidder_AdvanceTo(t *testing.T) {
+	bidder := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(0))
+
+	if bidder.AdvanceTo(1000) {
+		t.Error("Expected AdvanceTo the current bid to report no change")
+	}
+	if bidder.AdvanceTo(2001) {
+		t.Error("Expected AdvanceTo beyond the max bid to fail")
+	}
+	if !bidder.AdvanceTo(1750) {
+		t.Fatal("Expected AdvanceTo(1750) to succeed")
+	}
+	if bidder.GetCurrentBidCents() != 1750 || !bidder.IsActive {
+		t.Errorf("Expected active bidder at 1750 cents, got %d (active: %v)", bidder.GetCurrentBidCents(), bidder.IsActive)
+	}
+	if !bidder.AdvanceTo(2000) || bidder.IsActive {
+		t.Error("Expected bidder to become inactive after advancing to the max bid")
+	}
+}
+
+// TestBidder_JSONBackwardCompatible tests that bidders still encode amounts as plain numbers
+func TestBidder_JSONBackwardCompatible(t *testing.T) {
+	legacy := `{"id":"1","name":"Alice","starting_bid":10.5,"max_bid":20,"auto_increment":0.25,"current_bid":10.5,"entry_time":"2024-06-01T12:00:00Z","is_active":true}`
+
+	var bidder Bidder
+	if err := json.Unmarshal([]byte(legacy), &bidder); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !bidder.StartingBid.Equal(Dollars(10.50)) || !bidder.MaxBid.Equal(Dollars(20)) || !bidder.AutoIncrement.Equal(Dollars(0.25)) {
+		t.Errorf("Unexpected amounts: %s, %s, %s", bidder.StartingBid, bidder.MaxBid, bidder.AutoIncrement)
+	}
+	if bidder.Currency() != DefaultCurrency {
+		t.Errorf("Expected default currency, got %s", bidder.Currency())
+	}
+
+	data, err := json.Marshal(bidder)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if fields["starting_bid"] != 10.5 || fields["max_bid"] != 20.0 || fields["currency"] != "USD" {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+
+	yen := NewBidder("2", "Kenji", NewMoney(1500, JPY), NewMoney(3000, JPY), NewMoney(100, JPY))
+	data, err = json.Marshal(yen)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var roundTrip Bidder
+	if err := json.Unmarshal(data, &roundTrip); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !roundTrip.MaxBid.Equal(NewMoney(3000, JPY)) {
+		t.Errorf("Expected max bid 3000 JPY after round trip, got %s", roundTrip.MaxBid)
+	}
+	if _, ok := fields["quantity"]; ok {
+		t.Errorf("Expected no quantity for a single-unit bid, got %s", data)
+	}
+}
+
+func TestBidder_Units(t *testing.T) {
+	tests := []struct {
+		quantity int
+		expected int
+	}{
+		{0, 1},
+		{-2, 1},
+		{1, 1},
+		{50, 50},
+	}
+
+	for _, tt := range tests {
+		bidder := Bidder{ID: "1", Quantity: tt.quantity}
+		if got := bidder.Units(); got != tt.expected {
+			t.Errorf("Expected quantity %d to ask for %d units, got %d", tt.quantity, tt.expected, got)
+		}
+	}
+
+	data, err := json.Marshal(Bidder{ID: "1", Quantity: 12, StartingBid: Dollars(5), MaxBid: Dollars(5)})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var roundTrip Bidder
+	if err := json.Unmarshal(data, &roundTrip); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if roundTrip.Quantity != 12 {
+		t.Errorf("Expected quantity 12 after round trip, got %d", roundTrip.Quantity)
+	}
+}
+
+// fixedClock is a Clock that always reports the same time
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// TestNewBidderWithClock tests that the entry time comes from the given clock
+func TestNewBidderWithClock(t *testing.T) {
+	entry := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bidder := NewBidderWithClock("1", "Alice", Dollars(100), Dollars(200), Dollars(10), fixedClock(entry))
+
+	if !bidder.EntryTime.Equal(entry) {
+		t.Errorf("Expected entry time %v, got %v", entry, bidder.EntryTime)
+	}
+	if !bidder.CurrentBid.Equal(Dollars(100)) || !bidder.IsActive {
+		t.Errorf("Expected a fresh bidder, got %+v", bidder)
+	}
+}
+
+// TestBidder_Reset tests that Reset restores the starting state but keeps entry order
+func TestBidder_Reset(t *testing.T) {
+	entry := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bidder := NewBidderWithClock("1", "Alice", Dollars(100), Dollars(110), Dollars(10), fixedClock(entry))
+	bidder.Sequence = 7
+	bidder.Increment()
+
+	if bidder.IsActive {
+		t.Fatal("Expected bidder to be exhausted after reaching the maximum")
+	}
+
+	bidder.Reset()
+	if !bidder.CurrentBid.Equal(Dollars(100)) || !bidder.IsActive {
+		t.Errorf("Expected starting state, got current %s active %v", bidder.CurrentBid, bidder.IsActive)
+	}
+	if !bidder.EntryTime.Equal(entry) || bidder.Sequence != 7 {
+		t.Errorf("Expected entry time and sequence to be kept, got %v and %d", bidder.EntryTime, bidder.Sequence)
+	}
+}
+
+// TestBidder_EnteredBefore tests tie priority by entry time and then sequence
+func TestBidder_EnteredBefore(t *testing.T) {
+	entry := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	early := Bidder{ID: "early", EntryTime: entry, Sequence: 9}
+	late := Bidder{ID: "late", EntryTime: entry.Add(time.Nanosecond), Sequence: 1}
+	first := Bidder{ID: "first", EntryTime: entry, Sequence: 1}
+
+	tests := []struct {
+		a, b     *Bidder
+		expected bool
+	}{
+		{&early, &late, true},
+		{&late, &early, false},
+		{&first, &early, true},
+		{&early, &first, false},
+		{&first, &first, false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.EnteredBefore(tt.b); got != tt.expected {
+			t.Errorf("%s.EnteredBefore(%s) = %v, expected %v", tt.a.ID, tt.b.ID, got, tt.expected)
+		}
 	}
 }
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Clock supplies the current time so that time-dependent behaviour can be driven deterministically
+type Clock interface {
+	Now() time.Time
+}
+
+// Timer is a call scheduled on a TimerClock; *time.Timer satisfies it
+type Timer interface {
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// TimerClock is a Clock that can also schedule calls on its own timeline
+// Components that wait for a point in time, such as automatic closes, use it when the clock provides it
+type TimerClock interface {
+	Clock
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// SystemClock is a Clock backed by the operating system's wall clock
+type SystemClock struct{}
+
+// NewSystemClock creates a new SystemClock
+func NewSystemClock() Clock {
+	return SystemClock{}
+}
+
+// Now returns the current wall-clock time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// AfterFunc calls f in its own goroutine once d has elapsed on the wall clock
+func (SystemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
@@ -0,0 +1,174 @@
+package models
+
+import "errors"
+
+// ErrorCode is a stable machine-readable identifier for a specific failure
+// Codes never change once published, so API clients can branch on them instead of on messages
+type ErrorCode string
+
+// Bidder validation codes, set on ValidationError details
+const (
+	CodeBidderIDRequired             ErrorCode = "BIDDER_ID_REQUIRED"
+	CodeBidderNameRequired           ErrorCode = "BIDDER_NAME_REQUIRED"
+	CodeBidderDuplicateID            ErrorCode = "BIDDER_DUPLICATE_ID"
+	CodeBidderCurrencyMismatch       ErrorCode = "BIDDER_CURRENCY_MISMATCH"
+	CodeBidderNoExchangeRate         ErrorCode = "BIDDER_NO_EXCHANGE_RATE"
+	CodeBidMaxCurrencyMismatch       ErrorCode = "BID_MAX_CURRENCY_MISMATCH"
+	CodeBidIncrementCurrencyMismatch ErrorCode = "BID_INCREMENT_CURRENCY_MISMATCH"
+	CodeBidStartNegative             ErrorCode = "BID_START_NEGATIVE"
+	CodeBidMaxNegative               ErrorCode = "BID_MAX_NEGATIVE"
+	CodeBidIncrementNegative         ErrorCode = "BID_INCREMENT_NEGATIVE"
+	CodeBidIncrementNotPositive      ErrorCode = "BID_INCREMENT_NOT_POSITIVE"
+	CodeBidStartGreaterThanMax       ErrorCode = "BID_START_GT_MAX"
+	CodeBidQuantityNegative          ErrorCode = "BID_QUANTITY_NEGATIVE"
+	CodeBidderValidationUnexpected   ErrorCode = "BIDDER_VALIDATION_UNEXPECTED"
+)
+
+// Validation rule codes, set on the ValidationError details reported by configurable rules
+const (
+	CodeBidStartBelowMinimum ErrorCode = "BID_START_BELOW_MINIMUM"
+	CodeBidMaxAboveCap       ErrorCode = "BID_MAX_ABOVE_CAP"
+	CodeBidIncrementOffGrid  ErrorCode = "BID_INCREMENT_OFF_GRID"
+	CodeBidderNameTooShort   ErrorCode = "BIDDER_NAME_TOO_SHORT"
+	CodeBidderNameTooLong    ErrorCode = "BIDDER_NAME_TOO_LONG"
+	CodeBidderIDFormat       ErrorCode = "BIDDER_ID_FORMAT"
+)
+
+// Cross-bidder validation codes, set on the ValidationError details reported by auction-level rules
+const (
+	CodeBidderDuplicateName      ErrorCode = "BIDDER_DUPLICATE_NAME"
+	CodeBidMaxOutlier            ErrorCode = "BID_MAX_OUTLIER"
+	CodeBidEntryInFuture         ErrorCode = "BID_ENTRY_IN_FUTURE"
+	CodeBidEntryAfterClose       ErrorCode = "BID_ENTRY_AFTER_CLOSE"
+	CodeAuctionBelowOpeningPrice ErrorCode = "AUCTION_BELOW_OPENING_PRICE"
+)
+
+// Validation policy codes, set on the errors returned while loading a rule policy
+const (
+	CodePolicyUnknownRule ErrorCode = "POLICY_UNKNOWN_RULE"
+	CodePolicyInvalidRule ErrorCode = "POLICY_INVALID_RULE"
+	CodePolicyMalformed   ErrorCode = "POLICY_MALFORMED"
+)
+
+// Analysis codes, set on the errors returned while loading historical auctions for analysis
+const (
+	CodeAnalysisUnreadableFile  ErrorCode = "ANALYSIS_UNREADABLE_FILE"
+	CodeAnalysisMalformedRecord ErrorCode = "ANALYSIS_MALFORMED_RECORD"
+)
+
+// Dutch auction codes, set on the errors returned for invalid price schedules and refused acceptances
+const (
+	CodeDutchScheduleInvalid ErrorCode = "DUTCH_SCHEDULE_INVALID"
+	CodeDutchNotStarted      ErrorCode = "DUTCH_NOT_STARTED"
+	CodeDutchClosed          ErrorCode = "DUTCH_CLOSED"
+)
+
+// Live auction codes, set on the errors returned for refused mutations of a live auction
+const (
+	CodeAuctionClosed   ErrorCode = "AUCTION_CLOSED"
+	CodeBidderNotFound  ErrorCode = "BIDDER_NOT_FOUND"
+	CodeBidMaxNotRaised ErrorCode = "BID_MAX_NOT_RAISED"
+)
+
+// Auction manager codes, set on the errors returned for missing or conflicting lots and after shutdown
+const (
+	CodeManagerLotNotOpen ErrorCode = "MANAGER_LOT_NOT_OPEN"
+	CodeManagerLotExists  ErrorCode = "MANAGER_LOT_EXISTS"
+	CodeManagerShutDown   ErrorCode = "MANAGER_SHUT_DOWN"
+)
+
+// Auction validation codes, set on the AuctionError returned by a validator
+const (
+	CodeBidderInvalid    ErrorCode = "BIDDER_INVALID"
+	CodeAuctionNoBidders ErrorCode = "AUCTION_NO_BIDDERS"
+	CodeAuctionInvalid   ErrorCode = "AUCTION_INVALID_BIDDERS"
+)
+
+// Engine codes, set on the errors returned by the bidding engine
+const (
+	CodeEngineReserveNegative     ErrorCode = "ENGINE_RESERVE_NEGATIVE"
+	CodeEngineNoExchangeRate      ErrorCode = "ENGINE_NO_EXCHANGE_RATE"
+	CodeEngineRoundLimit          ErrorCode = "ENGINE_ROUND_LIMIT"
+	CodeEngineDeadline            ErrorCode = "ENGINE_DEADLINE_EXCEEDED"
+	CodeEngineContextDeadline     ErrorCode = "ENGINE_CONTEXT_DEADLINE"
+	CodeEngineCanceled            ErrorCode = "ENGINE_CANCELED"
+	CodeEngineIncrementFailed     ErrorCode = "ENGINE_INCREMENT_FAILED"
+	CodeEngineIncrementRejected   ErrorCode = "ENGINE_INCREMENT_REJECTED"
+	CodeEngineWinnerFailed        ErrorCode = "ENGINE_WINNER_FAILED"
+	CodeEngineWinnerNil           ErrorCode = "ENGINE_WINNER_NIL"
+	CodeEngineWinnerNotFound      ErrorCode = "ENGINE_WINNER_NOT_FOUND"
+	CodeEngineNoBidders           ErrorCode = "ENGINE_NO_BIDDERS"
+	CodeEnginePriceFailed         ErrorCode = "ENGINE_PRICE_FAILED"
+	CodeEngineConversionFailed    ErrorCode = "ENGINE_CONVERSION_FAILED"
+	CodeEngineHighestBidFailed    ErrorCode = "ENGINE_HIGHEST_BID_FAILED"
+	CodeEngineNegativeBid         ErrorCode = "ENGINE_NEGATIVE_BID"
+	CodeEngineNegativePrice       ErrorCode = "ENGINE_NEGATIVE_PRICE"
+	CodeEngineStrategyUnsupported ErrorCode = "ENGINE_STRATEGY_UNSUPPORTED"
+	CodeEngineNoSettledPrice      ErrorCode = "ENGINE_NO_SETTLED_PRICE"
+	CodeEngineFormatUnsupported   ErrorCode = "ENGINE_FORMAT_UNSUPPORTED"
+	CodeEnginePricingUnsupported  ErrorCode = "ENGINE_PRICING_UNSUPPORTED"
+	CodeEngineMultiUnitInvalid    ErrorCode = "ENGINE_MULTI_UNIT_INVALID"
+)
+
+// Service codes, set on the errors the auction service creates itself
+const (
+	CodeServiceUnexpectedError ErrorCode = "SERVICE_UNEXPECTED_ERROR"
+	CodeServiceNilResult       ErrorCode = "SERVICE_NIL_RESULT"
+	CodeServiceObserverPanic   ErrorCode = "SERVICE_OBSERVER_PANIC"
+)
+
+// CodeOf returns the code of the outermost coded error in err's chain, or "" if there is none
+func CodeOf(err error) ErrorCode {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if auctionErr := auctionErrorOf(err); auctionErr != nil && auctionErr.Code != "" {
+			return auctionErr.Code
+		}
+		if detail, ok := err.(*ValidationError); ok && detail.Code != "" {
+			return detail.Code
+		}
+	}
+	return ""
+}
+
+// HasCode reports whether code is set on any error in err's chain or on any of their validation details
+func HasCode(err error, code ErrorCode) bool {
+	if code == "" {
+		return false
+	}
+	for ; err != nil; err = errors.Unwrap(err) {
+		if detail, ok := err.(*ValidationError); ok && detail.Code == code {
+			return true
+		}
+		auctionErr := auctionErrorOf(err)
+		if auctionErr == nil {
+			continue
+		}
+		if auctionErr.Code == code {
+			return true
+		}
+		for _, detail := range auctionErr.Details {
+			if detail.Code == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// auctionErrorOf returns err's AuctionError, whether err is one or embeds one, without searching its chain
+func auctionErrorOf(err error) *AuctionError {
+	switch e := err.(type) {
+	case *AuctionError:
+		return e
+	case *ProcessingError:
+		return e.AuctionError
+	case *SystemError:
+		return e.AuctionError
+	case *InputError:
+		return e.AuctionError
+	case *TimeoutError:
+		return e.AuctionError
+	default:
+		return nil
+	}
+}
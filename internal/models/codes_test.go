@@ -0,0 +1,64 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCodeOf(t *testing.T) {
+	roundLimit := NewTimeoutError("too many rounds", "ProcessBids", "10 rounds")
+	roundLimit.WithCode(CodeEngineRoundLimit)
+
+	wrapped := NewAuctionErrorWithCause(ErrorTypeProcessing, "unexpected processing error", roundLimit)
+	uncodedWrapper := NewAuctionErrorWithCause(ErrorTypeProcessing, "no code", roundLimit)
+	wrapped.WithCode(CodeServiceUnexpectedError)
+
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCode
+	}{
+		{"nil", nil, ""},
+		{"plain error", errors.New("boom"), ""},
+		{"coded timeout", roundLimit, CodeEngineRoundLimit},
+		{"outermost code wins", wrapped, CodeServiceUnexpectedError},
+		{"uncoded wrapper", uncodedWrapper, CodeEngineRoundLimit},
+		{"fmt wrapper", fmt.Errorf("lot-1: %w", roundLimit), CodeEngineRoundLimit},
+		{"validation detail", NewValidationError("b1", "ID", "duplicate").WithCode(CodeBidderDuplicateID), CodeBidderDuplicateID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CodeOf(tt.err); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestHasCode(t *testing.T) {
+	validationErr := NewAuctionError(ErrorTypeValidation, "validation failed", []*ValidationError{
+		NewValidationError("b1", "StartingBid", "starting bid cannot be greater than maximum bid").WithCode(CodeBidStartGreaterThanMax),
+		NewValidationError("b2", "ID", "duplicate bidder ID").WithCode(CodeBidderDuplicateID),
+	})
+	validationErr.WithCode(CodeAuctionInvalid)
+	err := fmt.Errorf("settling lot: %w", validationErr)
+
+	for _, code := range []ErrorCode{CodeAuctionInvalid, CodeBidStartGreaterThanMax, CodeBidderDuplicateID} {
+		if !HasCode(err, code) {
+			t.Errorf("Expected %s to be found", code)
+		}
+	}
+	if HasCode(err, CodeEngineRoundLimit) {
+		t.Error("Expected ENGINE_ROUND_LIMIT not to be found")
+	}
+	if HasCode(NewAuctionError(ErrorTypeValidation, "no code", nil), "") {
+		t.Error("Expected the empty code never to match")
+	}
+
+	systemErr := NewSystemErrorWithCause("wrapped", "BiddingEngine", "high", NewInputError("bad", "f", 1).WithCode(CodeEngineWinnerNil))
+	if !HasCode(systemErr, CodeEngineWinnerNil) {
+		t.Error("Expected codes of causes to be found")
+	}
+}
@@ -0,0 +1,99 @@
+package models
+
+// AuctionConfig carries lot-level settings that apply to every bidder in an auction
+// When a RateProvider is set, bids may use different currencies and are normalized into the
+// settlement currency before they are compared; the reserve price and increment schedule are
+// then expressed in the settlement currency
+type AuctionConfig struct {
+	ReservePriceCents  int64             `json:"reserve_price_cents"`           // Hidden minimum sale price in cents (0 means no reserve)
+	IncrementSchedule  IncrementSchedule `json:"-"`                             // Site-wide increment table (nil means per-bidder AutoIncrement)
+	SettlementCurrency Currency          `json:"settlement_currency,omitempty"` // Currency bids are compared and settled in (DefaultCurrency if empty)
+	RateProvider       RateProvider      `json:"-"`                             // Exchange rates for multi-currency auctions (nil means single currency)
+	SoftClose          SoftClosePolicy   `json:"soft_close"`                    // Anti-sniping extension for live auctions (zero value disables it)
+	Format             AuctionFormat     `json:"format,omitempty"`              // Rules the auction is settled under (FormatEnglish if empty)
+	MultiUnit          MultiUnitConfig   `json:"multi_unit"`                    // Units for sale when the format is a multi-unit format
+}
+
+// NewAuctionConfig creates a new AuctionConfig with no reserve price
+func NewAuctionConfig() AuctionConfig {
+	return AuctionConfig{}
+}
+
+// NewAuctionConfigWithReserve creates a new AuctionConfig with the given reserve price in cents
+func NewAuctionConfigWithReserve(reservePriceCents int64) AuctionConfig {
+	return AuctionConfig{ReservePriceCents: reservePriceCents}
+}
+
+// NewAuctionConfigWithSchedule creates a new AuctionConfig that uses a site-wide increment schedule
+func NewAuctionConfigWithSchedule(schedule IncrementSchedule) AuctionConfig {
+	return AuctionConfig{IncrementSchedule: schedule}
+}
+
+// NewAuctionConfigWithSettlement creates a new AuctionConfig for a multi-currency auction settled in the given currency
+func NewAuctionConfigWithSettlement(settlement Currency, rates RateProvider) AuctionConfig {
+	return AuctionConfig{SettlementCurrency: settlement, RateProvider: rates}
+}
+
+// NewAuctionConfigWithSoftClose creates a new AuctionConfig whose live auctions extend under the given policy
+func NewAuctionConfigWithSoftClose(policy SoftClosePolicy) AuctionConfig {
+	return AuctionConfig{SoftClose: policy}
+}
+
+// NewAuctionConfigWithFormat creates a new AuctionConfig for an auction settled under the given format
+func NewAuctionConfigWithFormat(format AuctionFormat) AuctionConfig {
+	return AuctionConfig{Format: format}
+}
+
+// NewAuctionConfigWithUnits creates a new AuctionConfig for a uniform-price auction of identical units
+func NewAuctionConfigWithUnits(multiUnit MultiUnitConfig) AuctionConfig {
+	return AuctionConfig{Format: FormatUniformPrice, MultiUnit: multiUnit}
+}
+
+// NewAuctionConfigWithPayAsBid creates a new AuctionConfig for a pay-as-bid auction of identical units
+func NewAuctionConfigWithPayAsBid(multiUnit MultiUnitConfig) AuctionConfig {
+	return AuctionConfig{Format: FormatPayAsBid, MultiUnit: multiUnit}
+}
+
+// AuctionFormat returns the format the auction is settled under
+func (ac AuctionConfig) AuctionFormat() AuctionFormat {
+	if ac.Format == "" {
+		return FormatEnglish
+	}
+	return ac.Format
+}
+
+// IsMultiCurrency returns true if bids are normalized into a settlement currency before comparison
+func (ac AuctionConfig) IsMultiCurrency() bool {
+	return ac.RateProvider != nil
+}
+
+// Settlement returns the currency bids are settled in
+func (ac AuctionConfig) Settlement() Currency {
+	if ac.SettlementCurrency == "" {
+		return DefaultCurrency
+	}
+	return ac.SettlementCurrency
+}
+
+// HasIncrementSchedule returns true if bids are raised according to a site-wide schedule
+func (ac AuctionConfig) HasIncrementSchedule() bool {
+	return ac.IncrementSchedule != nil
+}
+
+// IncrementFor returns the increment in cents the bidder uses from their current bid
+func (ac AuctionConfig) IncrementFor(bidder *Bidder) int64 {
+	if ac.IncrementSchedule != nil {
+		return ac.IncrementSchedule.IncrementAt(bidder.GetCurrentBidCents())
+	}
+	return bidder.GetAutoIncrementCents()
+}
+
+// HasReserve returns true if the auction has a reserve price
+func (ac AuctionConfig) HasReserve() bool {
+	return ac.ReservePriceCents > 0
+}
+
+// ReserveMetBy returns true if a maximum bid in cents satisfies the reserve price
+func (ac AuctionConfig) ReserveMetBy(maxBidCents int64) bool {
+	return maxBidCents >= ac.ReservePriceCents
+}
@@ -0,0 +1,79 @@
+package models
+
+import "testing"
+
+// TestNewAuctionConfig tests the default configuration has no reserve
+func TestNewAuctionConfig(t *testing.T) {
+	config := NewAuctionConfig()
+
+	if config.HasReserve() {
+		t.Error("Expected default config to have no reserve")
+	}
+
+	if !config.ReserveMetBy(0) {
+		t.Error("Expected any bid to meet a missing reserve")
+	}
+}
+
+// TestAuctionConfig_ReserveMetBy tests reserve comparisons at the boundary
+func TestAuctionConfig_ReserveMetBy(t *testing.T) {
+	config := NewAuctionConfigWithReserve(15000)
+
+	if !config.HasReserve() {
+		t.Fatal("Expected config to have a reserve")
+	}
+
+	tests := []struct {
+		maxBidCents int64
+		expected    bool
+	}{
+		{14999, false},
+		{15000, true},
+		{15001, true},
+	}
+
+	for _, tt := range tests {
+		if got := config.ReserveMetBy(tt.maxBidCents); got != tt.expected {
+			t.Errorf("ReserveMetBy(%d) = %v, expected %v", tt.maxBidCents, got, tt.expected)
+		}
+	}
+}
+
+// TestAuctionConfig_IncrementFor tests choosing between the schedule and per-bidder increments
+func TestAuctionConfig_IncrementFor(t *testing.T) {
+	bidder := NewBidder("1", "Alice", Dollars(4.00), Dollars(20.00), Dollars(0.10))
+
+	perBidder := NewAuctionConfig()
+	if perBidder.HasIncrementSchedule() {
+		t.Error("Expected default config to have no increment schedule")
+	}
+	if got := perBidder.IncrementFor(bidder); got != 10 {
+		t.Errorf("Expected per-bidder increment 10 cents, got %d", got)
+	}
+
+	scheduled := NewAuctionConfigWithSchedule(DefaultIncrementSchedule())
+	if !scheduled.HasIncrementSchedule() {
+		t.Error("Expected config to have an increment schedule")
+	}
+	if got := scheduled.IncrementFor(bidder); got != 25 {
+		t.Errorf("Expected scheduled increment 25 cents at $4.00, got %d", got)
+	}
+}
+
+// TestAuctionConfig_Settlement tests the multi-currency settings
+func TestAuctionConfig_Settlement(t *testing.T) {
+	if NewAuctionConfig().IsMultiCurrency() {
+		t.Error("Expected default config to be single currency")
+	}
+	if NewAuctionConfig().Settlement() != DefaultCurrency {
+		t.Errorf("Expected default settlement currency %s, got %s", DefaultCurrency, NewAuctionConfig().Settlement())
+	}
+
+	config := NewAuctionConfigWithSettlement(EUR, NewStaticRateProvider())
+	if !config.IsMultiCurrency() {
+		t.Error("Expected config with rate provider to be multi-currency")
+	}
+	if config.Settlement() != EUR {
+		t.Errorf("Expected settlement currency EUR, got %s", config.Settlement())
+	}
+}
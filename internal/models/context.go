@@ -0,0 +1,53 @@
+package models
+
+import "context"
+
+// TraceIDKey is the error context key under which a request's trace ID is recorded
+const TraceIDKey = "trace_id"
+
+// requestFieldsKey is the context key holding request-scoped error fields
+type requestFieldsKey struct{}
+
+// WithRequestField returns a context carrying a request-scoped value that is copied into the
+// Context map of errors returned by context-aware auction calls
+func WithRequestField(ctx context.Context, key, value string) context.Context {
+	existing := RequestFields(ctx)
+	fields := make(map[string]string, len(existing)+1)
+	for k, v := range existing {
+		fields[k] = v
+	}
+	fields[key] = value
+	return context.WithValue(ctx, requestFieldsKey{}, fields)
+}
+
+// WithTraceID returns a context carrying a trace ID for errors returned by context-aware auction calls
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return WithRequestField(ctx, TraceIDKey, traceID)
+}
+
+// RequestFields returns the request-scoped error fields carried by a context
+// The returned map must not be modified
+func RequestFields(ctx context.Context) map[string]string {
+	fields, _ := ctx.Value(requestFieldsKey{}).(map[string]string)
+	return fields
+}
+
+// TraceID returns the trace ID carried by a context, or "" if there is none
+func TraceID(ctx context.Context) string {
+	return RequestFields(ctx)[TraceIDKey]
+}
+
+// AddRequestContext copies the context's request-scoped fields into an auction error and returns it
+// Errors that are not auction errors are returned unchanged
+func AddRequestContext(ctx context.Context, err error) error {
+	fields := RequestFields(ctx)
+	if err == nil || len(fields) == 0 {
+		return err
+	}
+	if annotated, ok := err.(interface {
+		WithContext(context map[string]string) *AuctionError
+	}); ok {
+		annotated.WithContext(fields)
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRequestFields(t *testing.T) {
+	ctx := context.Background()
+	if RequestFields(ctx) != nil || TraceID(ctx) != "" {
+		t.Error("Expected no fields on a plain context")
+	}
+
+	parent := WithTraceID(ctx, "trace-1")
+	child := WithRequestField(parent, "tenant", "acme")
+	if TraceID(child) != "trace-1" || RequestFields(child)["tenant"] != "acme" {
+		t.Errorf("Expected trace ID and tenant, got %v", RequestFields(child))
+	}
+	if _, ok := RequestFields(parent)["tenant"]; ok {
+		t.Error("Expected the parent context to be unchanged")
+	}
+}
+
+func TestAddRequestContext(t *testing.T) {
+	ctx := WithTraceID(context.Background(), "trace-1")
+
+	timeoutErr := NewTimeoutError("too slow", "ProcessBids", "1s")
+	if AddRequestContext(ctx, timeoutErr) != error(timeoutErr) {
+		t.Fatal("Expected the same error back")
+	}
+	if traceID, _ := timeoutErr.GetContext(TraceIDKey); traceID != "trace-1" {
+		t.Errorf("Expected trace ID on a TimeoutError, got %q", traceID)
+	}
+
+	auctionErr := NewAuctionError(ErrorTypeValidation, "invalid", nil)
+	AddRequestContext(ctx, auctionErr)
+	if traceID, _ := auctionErr.GetContext(TraceIDKey); traceID != "trace-1" {
+		t.Errorf("Expected trace ID on an AuctionError, got %q", traceID)
+	}
+
+	plain := errors.New("plain")
+	if AddRequestContext(ctx, plain) != plain || AddRequestContext(ctx, nil) != nil {
+		t.Error("Expected other errors to pass through unchanged")
+	}
+}
@@ -0,0 +1,80 @@
+package models
+
+import "time"
+
+// DutchSchedule sets how the asking price of a Dutch auction falls while nobody accepts it
+// The price starts at StartPrice and drops by Step every Interval until it reaches FloorPrice,
+// where it is offered for one more Interval before the lot is withdrawn
+type DutchSchedule struct {
+	StartPrice Money         `json:"start_price"` // Asking price when the auction opens
+	FloorPrice Money         `json:"floor_price"` // Lowest asking price
+	Step       Money         `json:"step"`        // Amount the price drops by at each interval
+	Interval   time.Duration `json:"interval"`    // Time between price drops
+}
+
+// NewDutchSchedule creates a new DutchSchedule and validates it
+func NewDutchSchedule(startPrice, floorPrice, step Money, interval time.Duration) (DutchSchedule, error) {
+	schedule := DutchSchedule{StartPrice: startPrice, FloorPrice: floorPrice, Step: step, Interval: interval}
+	if err := schedule.Validate(); err != nil {
+		return DutchSchedule{}, err
+	}
+	return schedule, nil
+}
+
+// Validate returns an InputError if the prices are not in one currency, the start price is not
+// positive, the floor is negative or above the start price, or the step or interval is not positive
+func (s DutchSchedule) Validate() error {
+	switch {
+	case !s.StartPrice.IsPositive():
+		return scheduleError("start price must be positive", "StartPrice", s.StartPrice.String())
+	case !s.FloorPrice.SameCurrency(s.StartPrice):
+		return scheduleError("floor price must be in the start price's currency", "FloorPrice", s.FloorPrice.String())
+	case !s.Step.SameCurrency(s.StartPrice):
+		return scheduleError("step must be in the start price's currency", "Step", s.Step.String())
+	case s.FloorPrice.IsNegative():
+		return scheduleError("floor price cannot be negative", "FloorPrice", s.FloorPrice.String())
+	case s.FloorPrice.Amount() > s.StartPrice.Amount():
+		return scheduleError("floor price cannot exceed the start price", "FloorPrice", s.FloorPrice.String())
+	case !s.Step.IsPositive():
+		return scheduleError("step must be positive", "Step", s.Step.String())
+	case s.Interval <= 0:
+		return scheduleError("interval must be positive", "Interval", s.Interval.String())
+	}
+	return nil
+}
+
+// scheduleError creates the InputError for an invalid Dutch schedule field
+func scheduleError(message, field, value string) error {
+	inputErr := NewInputError(message, "schedule."+field, value)
+	inputErr.WithOperation("DutchSchedule.Validate").WithCode(CodeDutchScheduleInvalid)
+	return inputErr
+}
+
+// PriceAt returns the asking price the given time after the auction opened, never below the floor
+func (s DutchSchedule) PriceAt(elapsed time.Duration) Money {
+	if elapsed < 0 {
+		return s.StartPrice
+	}
+	drops := int64(elapsed / s.Interval)
+	if drops >= s.drops() {
+		return s.FloorPrice
+	}
+	return NewMoney(s.StartPrice.Amount()-drops*s.Step.Amount(), s.StartPrice.Currency())
+}
+
+// FloorAfter returns how long after opening the price reaches the floor
+func (s DutchSchedule) FloorAfter() time.Duration {
+	return time.Duration(s.drops()) * s.Interval
+}
+
+// Duration returns how long after opening the lot is withdrawn if nobody accepts
+func (s DutchSchedule) Duration() time.Duration {
+	return s.FloorAfter() + s.Interval
+}
+
+// drops returns the number of price drops from the start price to the floor
+// The last drop may be smaller than Step, landing exactly on the floor
+func (s DutchSchedule) drops() int64 {
+	span := s.StartPrice.Amount() - s.FloorPrice.Amount()
+	return (span + s.Step.Amount() - 1) / s.Step.Amount()
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDutchSchedule_PriceAt(t *testing.T) {
+	// 100.00 falling by 15.00 a minute to 50.00: 85, 70, 55, then 50
+	schedule, err := NewDutchSchedule(Dollars(100), Dollars(50), Dollars(15), time.Minute)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		elapsed  time.Duration
+		expected Money
+	}{
+		{-time.Minute, Dollars(100)},
+		{0, Dollars(100)},
+		{59 * time.Second, Dollars(100)},
+		{time.Minute, Dollars(85)},
+		{150 * time.Second, Dollars(70)},
+		{3 * time.Minute, Dollars(55)},
+		{4 * time.Minute, Dollars(50)},
+		{time.Hour, Dollars(50)},
+	}
+
+	for _, tt := range tests {
+		if got := schedule.PriceAt(tt.elapsed); !got.Equal(tt.expected) {
+			t.Errorf("Expected price %s after %s, got %s", tt.expected, tt.elapsed, got)
+		}
+	}
+	if schedule.FloorAfter() != 4*time.Minute {
+		t.Errorf("Expected the floor after 4m, got %s", schedule.FloorAfter())
+	}
+	if schedule.Duration() != 5*time.Minute {
+		t.Errorf("Expected the lot to be withdrawn after 5m, got %s", schedule.Duration())
+	}
+}
+
+func TestDutchSchedule_StartAtFloor(t *testing.T) {
+	schedule, err := NewDutchSchedule(Dollars(50), Dollars(50), Dollars(5), time.Minute)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if schedule.FloorAfter() != 0 || schedule.Duration() != time.Minute {
+		t.Errorf("Expected the floor to be offered for one interval, got %s and %s", schedule.FloorAfter(), schedule.Duration())
+	}
+	if got := schedule.PriceAt(30 * time.Second); !got.Equal(Dollars(50)) {
+		t.Errorf("Expected price 50.00, got %s", got)
+	}
+}
+
+func TestDutchSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule DutchSchedule
+		field    string
+	}{
+		{"zero start", DutchSchedule{Dollars(0), Dollars(0), Dollars(1), time.Minute}, "schedule.StartPrice"},
+		{"floor currency", DutchSchedule{Dollars(100), NewMoney(5000, EUR), Dollars(1), time.Minute}, "schedule.FloorPrice"},
+		{"step currency", DutchSchedule{Dollars(100), Dollars(50), NewMoney(100, EUR), time.Minute}, "schedule.Step"},
+		{"negative floor", DutchSchedule{Dollars(100), Dollars(-1), Dollars(1), time.Minute}, "schedule.FloorPrice"},
+		{"floor above start", DutchSchedule{Dollars(100), Dollars(150), Dollars(1), time.Minute}, "schedule.FloorPrice"},
+		{"zero step", DutchSchedule{Dollars(100), Dollars(50), Dollars(0), time.Minute}, "schedule.Step"},
+		{"zero interval", DutchSchedule{Dollars(100), Dollars(50), Dollars(1), 0}, "schedule.Interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			var inputErr *InputError
+			if !errors.As(err, &inputErr) {
+				t.Fatalf("Expected an InputError, got: %v", err)
+			}
+			if inputErr.InputField != tt.field {
+				t.Errorf("Expected field %s, got %s", tt.field, inputErr.InputField)
+			}
+			if !HasCode(err, CodeDutchScheduleInvalid) {
+				t.Errorf("Expected code %s, got %s", CodeDutchScheduleInvalid, CodeOf(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrorEnvelope is the canonical JSON form of an error returned to API clients
+type ErrorEnvelope struct {
+	Error *ErrorBody `json:"error"`
+}
+
+// ErrorBody describes one error of a cause chain; Cause holds the next one
+// Attributes carry the fields specific to an error type, such as a SystemError's severity
+type ErrorBody struct {
+	Type       ErrorType          `json:"type,omitempty"`
+	Code       ErrorCode          `json:"code,omitempty"`
+	Message    string             `json:"message"`
+	Operation  string             `json:"operation,omitempty"`
+	Details    []*ValidationError `json:"details,omitempty"`
+	Context    map[string]string  `json:"context,omitempty"`
+	Attributes map[string]string  `json:"attributes,omitempty"`
+	Cause      *ErrorBody         `json:"cause,omitempty"`
+}
+
+// NewErrorEnvelope builds the envelope for err, following its cause chain
+// Errors from outside this package are kept by message; a nil err gives an envelope with a nil body
+func NewErrorEnvelope(err error) ErrorEnvelope {
+	return ErrorEnvelope{Error: newErrorBody(err)}
+}
+
+// MarshalError encodes err as an ErrorEnvelope
+func MarshalError(err error) ([]byte, error) {
+	return json.Marshal(NewErrorEnvelope(err))
+}
+
+// Codes returns the codes of the body, its validation details and its causes, outermost first
+func (eb *ErrorBody) Codes() []ErrorCode {
+	var codes []ErrorCode
+	for body := eb; body != nil; body = body.Cause {
+		if body.Code != "" {
+			codes = append(codes, body.Code)
+		}
+		for _, detail := range body.Details {
+			if detail.Code != "" {
+				codes = append(codes, detail.Code)
+			}
+		}
+	}
+	return codes
+}
+
+// newErrorBody describes err and, recursively, the errors it wraps
+func newErrorBody(err error) *ErrorBody {
+	if err == nil {
+		return nil
+	}
+
+	if detail, ok := err.(*ValidationError); ok {
+		attributes := map[string]string{
+			"bidder_id": detail.BidderID,
+			"field":     detail.Field,
+			"value":     detail.Value,
+		}
+		if detail.Key != "" {
+			attributes["key"] = string(detail.Key)
+		}
+		if detail.Position > 0 {
+			attributes["position"] = fmt.Sprintf("%d", detail.Position)
+		}
+		if detail.Limit != "" {
+			attributes["limit"] = detail.Limit
+		}
+		if detail.Rule != "" {
+			attributes["rule"] = detail.Rule
+		}
+		return &ErrorBody{
+			Type:       ErrorTypeValidation,
+			Code:       detail.Code,
+			Message:    detail.Message,
+			Attributes: attributes,
+		}
+	}
+
+	auctionErr := auctionErrorOf(err)
+	if auctionErr == nil {
+		return &ErrorBody{Message: err.Error(), Cause: newErrorBody(errors.Unwrap(err))}
+	}
+
+	body := &ErrorBody{
+		Type:       auctionErr.Type,
+		Code:       auctionErr.Code,
+		Message:    auctionErr.Message,
+		Operation:  auctionErr.Operation,
+		Details:    auctionErr.Details,
+		Attributes: errorAttributes(err),
+		Cause:      newErrorBody(auctionErr.Cause),
+	}
+	if len(auctionErr.Context) > 0 {
+		body.Context = make(map[string]string, len(auctionErr.Context))
+		for key, value := range auctionErr.Context {
+			body.Context[key] = value
+		}
+	}
+	return body
+}
+
+// errorAttributes returns the fields an error type adds to AuctionError, or nil for a plain AuctionError
+func errorAttributes(err error) map[string]string {
+	switch e := err.(type) {
+	case *ProcessingError:
+		attributes := map[string]string{
+			"bidder_count":  fmt.Sprintf("%d", e.BidderCount),
+			"current_round": fmt.Sprintf("%d", e.CurrentRound),
+		}
+		if e.FailedBidder != "" {
+			attributes["failed_bidder"] = e.FailedBidder
+		}
+		return attributes
+	case *SystemError:
+		return map[string]string{"component": e.Component, "severity": e.Severity}
+	case *InputError:
+		return map[string]string{"input_field": e.InputField, "input_value": fmt.Sprintf("%v", e.InputValue)}
+	case *TimeoutError:
+		return map[string]string{"timeout_operation": e.Operation, "timeout_duration": e.TimeoutDuration}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestNewErrorEnvelope_CauseChain(t *testing.T) {
+	timeoutErr := NewTimeoutError("bidding process exceeded context deadline", "ProcessBids", "2s")
+	timeoutErr.Cause = context.DeadlineExceeded
+	timeoutErr.WithOperation("ProcessBids.ContextCheck").WithCode(CodeEngineContextDeadline)
+	timeoutErr.AddContext("bidder_count", "3")
+	err := fmt.Errorf("lot-7: %w", timeoutErr)
+
+	data, marshalErr := MarshalError(err)
+	if marshalErr != nil {
+		t.Fatalf("Expected no error, got: %v", marshalErr)
+	}
+
+	var envelope ErrorEnvelope
+	if unmarshalErr := json.Unmarshal(data, &envelope); unmarshalErr != nil {
+		t.Fatalf("Expected no error, got: %v", unmarshalErr)
+	}
+
+	outer := envelope.Error
+	if outer == nil || outer.Message != err.Error() || outer.Code != "" {
+		t.Fatalf("Expected the fmt wrapper as the outer body, got %+v", outer)
+	}
+
+	timeout := outer.Cause
+	if timeout == nil {
+		t.Fatal("Expected the timeout in the cause chain")
+	}
+	if timeout.Type != ErrorTypeTimeout || timeout.Code != CodeEngineContextDeadline {
+		t.Errorf("Expected a coded timeout, got %s %s", timeout.Type, timeout.Code)
+	}
+	if timeout.Operation != "ProcessBids.ContextCheck" || timeout.Context["bidder_count"] != "3" {
+		t.Errorf("Expected operation and context to survive, got %+v", timeout)
+	}
+	if timeout.Attributes["timeout_duration"] != "2s" || timeout.Attributes["timeout_operation"] != "ProcessBids" {
+		t.Errorf("Expected timeout attributes, got %v", timeout.Attributes)
+	}
+
+	cause := timeout.Cause
+	if cause == nil || cause.Message != context.DeadlineExceeded.Error() || cause.Cause != nil {
+		t.Errorf("Expected the context error at the end of the chain, got %+v", cause)
+	}
+}
+
+func TestNewErrorEnvelope_Validation(t *testing.T) {
+	auctionErr := NewAuctionError(ErrorTypeValidation, "validation failed for 2 out of 2 bidders", []*ValidationError{
+		NewValidationErrorWithValue("b1", "StartingBid", "starting bid cannot be greater than maximum bid", "starting: 3.00, max: 2.00").WithCode(CodeBidStartGreaterThanMax),
+		NewValidationErrorWithValue("b1", "ID", "duplicate bidder ID", "b1").WithCode(CodeBidderDuplicateID),
+	})
+	auctionErr.WithCode(CodeAuctionInvalid)
+
+	data, err := MarshalError(auctionErr)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var envelope ErrorEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	want := []ErrorCode{CodeAuctionInvalid, CodeBidStartGreaterThanMax, CodeBidderDuplicateID}
+	if got := envelope.Error.Codes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected codes %v, got %v", want, got)
+	}
+	if envelope.Error.Details[0].Value != "starting: 3.00, max: 2.00" {
+		t.Errorf("Expected detail values to survive, got %q", envelope.Error.Details[0].Value)
+	}
+	if envelope.Error.Cause != nil || envelope.Error.Context != nil {
+		t.Errorf("Expected no cause or context, got %+v", envelope.Error)
+	}
+}
+
+func TestNewErrorEnvelope_Attributes(t *testing.T) {
+	processingErr := NewProcessingError("failed", 3, 7)
+	processingErr.FailedBidder = "b2"
+
+	tests := []struct {
+		name string
+		err  error
+		want map[string]string
+	}{
+		{"processing", processingErr, map[string]string{"bidder_count": "3", "current_round": "7", "failed_bidder": "b2"}},
+		{"system", NewSystemError("failed", "BiddingEngine", "high"), map[string]string{"component": "BiddingEngine", "severity": "high"}},
+		{"input", NewInputError("bad", "bidders", 0), map[string]string{"input_field": "bidders", "input_value": "0"}},
+		{"auction", NewAuctionError(ErrorTypeSystem, "failed", nil), nil},
+		{"validation detail", NewValidationErrorWithValue("b1", "Name", "required", ""), map[string]string{"bidder_id": "b1", "field": "Name", "value": ""}},
+		{"localized detail", NewLocalizedValidationError("b1", "StartingBid", MsgBidStartAboveMax, "300.00", "200.00").WithPosition(2), map[string]string{"bidder_id": "b1", "field": "StartingBid", "value": "300.00", "key": "bid.start_above_max", "position": "2", "limit": "200.00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := NewErrorEnvelope(tt.err).Error
+			if !reflect.DeepEqual(body.Attributes, tt.want) {
+				t.Errorf("Expected attributes %v, got %v", tt.want, body.Attributes)
+			}
+		})
+	}
+}
+
+func TestNewErrorEnvelope_Nil(t *testing.T) {
+	if NewErrorEnvelope(nil).Error != nil {
+		t.Error("Expected a nil body for a nil error")
+	}
+	data, err := MarshalError(nil)
+	if err != nil || string(data) != `{"error":null}` {
+		t.Errorf("Expected {\"error\":null}, got %s (%v)", data, err)
+	}
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -16,12 +17,45 @@ const (
 	ErrorTypeTimeout    ErrorType = "timeout"
 )
 
+// Sentinel errors, one per ErrorType, for use with errors.Is
+// Every error in this file matches the sentinel of its type, wherever it sits in an error chain
+var (
+	ErrValidation = errors.New("auction: validation failed")
+	ErrProcessing = errors.New("auction: processing failed")
+	ErrSystem     = errors.New("auction: system failure")
+	ErrInput      = errors.New("auction: invalid input")
+	ErrTimeout    = errors.New("auction: timed out")
+)
+
+// Sentinel returns the sentinel error for the error type, or nil for an unknown type
+func (et ErrorType) Sentinel() error {
+	switch et {
+	case ErrorTypeValidation:
+		return ErrValidation
+	case ErrorTypeProcessing:
+		return ErrProcessing
+	case ErrorTypeSystem:
+		return ErrSystem
+	case ErrorTypeInput:
+		return ErrInput
+	case ErrorTypeTimeout:
+		return ErrTimeout
+	default:
+		return nil
+	}
+}
+
 // ValidationError represents a validation error for a specific bidder and field
 type ValidationError struct {
-	BidderID string `json:"bidder_id"` // ID of the bidder with validation error
-	Field    string `json:"field"`     // Field that failed validation
-	Message  string `json:"message"`   // Error message describing the validation failure
-	Value    string `json:"value"`     // The invalid value that caused the error
+	BidderID string     `json:"bidder_id"`          // ID of the bidder with validation error
+	Position int        `json:"position,omitempty"` // 1-based position of the bidder in the validated bid set (0 when unknown)
+	Field    string     `json:"field"`              // Field that failed validation
+	Code     ErrorCode  `json:"code,omitempty"`     // Stable machine-readable code of the failure
+	Key      MessageKey `json:"key,omitempty"`      // Key of the user-facing message, rendered per locale by Localize
+	Message  string     `json:"message"`            // Error message describing the validation failure, in English
+	Value    string     `json:"value"`              // The invalid value that caused the error
+	Limit    string     `json:"limit,omitempty"`    // The bound or expected value the invalid value was checked against
+	Rule     string     `json:"rule,omitempty"`     // Name of the validation rule that reported the error, if any
 }
 
 // NewValidationError creates a new ValidationError
@@ -43,22 +77,58 @@ func NewValidationErrorWithValue(bidderID, field, message, value string) *Valida
 	}
 }
 
+// NewLocalizedValidationError creates a new ValidationError rendered from a message key
+// value and limit are the message parameters; Message holds the English rendering
+func NewLocalizedValidationError(bidderID, field string, key MessageKey, value, limit string) *ValidationError {
+	ve := &ValidationError{
+		BidderID: bidderID,
+		Field:    field,
+		Key:      key,
+		Value:    value,
+		Limit:    limit,
+	}
+	ve.Message = ve.Localize(nil, LocaleEnglish)
+	return ve
+}
+
 // Error implements the error interface for ValidationError
 func (ve *ValidationError) Error() string {
+	bidder := ve.BidderID
+	if ve.Position > 0 {
+		bidder = fmt.Sprintf("%s at position %d", ve.BidderID, ve.Position)
+	}
 	if ve.Value != "" {
-		return fmt.Sprintf("validation error for bidder %s, field %s: %s (value: %s)", ve.BidderID, ve.Field, ve.Message, ve.Value)
+		return fmt.Sprintf("validation error for bidder %s, field %s: %s (value: %s)", bidder, ve.Field, ve.Message, ve.Value)
 	}
-	return fmt.Sprintf("validation error for bidder %s, field %s: %s", ve.BidderID, ve.Field, ve.Message)
+	return fmt.Sprintf("validation error for bidder %s, field %s: %s", bidder, ve.Field, ve.Message)
+}
+
+// WithPosition sets the 1-based position of the bidder in the validated bid set
+func (ve *ValidationError) WithPosition(position int) *ValidationError {
+	ve.Position = position
+	return ve
+}
+
+// WithCode sets the stable code of the validation error
+func (ve *ValidationError) WithCode(code ErrorCode) *ValidationError {
+	ve.Code = code
+	return ve
+}
+
+// Is reports whether target is ErrValidation
+func (ve *ValidationError) Is(target error) bool {
+	return target == ErrValidation
 }
 
 // AuctionError represents different types of errors that can occur during auction processing
 type AuctionError struct {
-	Type      ErrorType          `json:"type"`      // Type of error (validation, processing, system, etc.)
-	Message   string             `json:"message"`   // Main error message
-	Details   []*ValidationError `json:"details"`   // Detailed validation errors
-	Cause     error              `json:"-"`         // Underlying cause of the error (not serialized)
-	Context   map[string]string  `json:"context"`   // Additional context information
-	Operation string             `json:"operation"` // Operation that was being performed when error occurred
+	Type      ErrorType          `json:"type"`           // Type of error (validation, processing, system, etc.)
+	Code      ErrorCode          `json:"code,omitempty"` // Stable machine-readable code of the failure
+	Message   string             `json:"message"`        // Main error message
+	Details   []*ValidationError `json:"details"`        // Detailed validation errors
+	Cause     error              `json:"-"`              // Underlying cause of the error (serialized by NewErrorEnvelope)
+	Context   map[string]string  `json:"context"`        // Additional context information
+	Operation string             `json:"operation"`      // Operation that was being performed when error occurred
 }
 
 // NewAuctionError creates a new AuctionError
@@ -111,6 +181,71 @@ func (ae *AuctionError) Unwrap() error {
 	return ae.Cause
 }
 
+// Is reports whether target is the sentinel for the error's type
+func (ae *AuctionError) Is(target error) bool {
+	sentinel := ae.Type.Sentinel()
+	return sentinel != nil && target == sentinel
+}
+
+// As finds the first validation detail when target is a **ValidationError
+func (ae *AuctionError) As(target interface{}) bool {
+	if detail, ok := target.(**ValidationError); ok && len(ae.Details) > 0 {
+		*detail = ae.Details[0]
+		return true
+	}
+	return false
+}
+
+// Clone returns a copy of the error that shares no details or context with the original
+// The cause is shared, since it may be of any type
+func (ae *AuctionError) Clone() *AuctionError {
+	if ae == nil {
+		return nil
+	}
+	clone := *ae
+	if ae.Details != nil {
+		clone.Details = make([]*ValidationError, len(ae.Details))
+		for i, detail := range ae.Details {
+			copied := *detail
+			clone.Details[i] = &copied
+		}
+	}
+	if ae.Context != nil {
+		clone.Context = make(map[string]string, len(ae.Context))
+		for key, value := range ae.Context {
+			clone.Context[key] = value
+		}
+	}
+	return &clone
+}
+
+// CloneError returns a copy of an auction error, including one of the typed errors built on
+// AuctionError, so the copy can be changed without affecting err; other errors are returned as is
+func CloneError(err error) error {
+	switch typed := err.(type) {
+	case *AuctionError:
+		return typed.Clone()
+	case *ProcessingError:
+		clone := *typed
+		clone.AuctionError = typed.AuctionError.Clone()
+		return &clone
+	case *SystemError:
+		clone := *typed
+		clone.AuctionError = typed.AuctionError.Clone()
+		return &clone
+	case *InputError:
+		clone := *typed
+		clone.AuctionError = typed.AuctionError.Clone()
+		return &clone
+	case *TimeoutError:
+		clone := *typed
+		clone.AuctionError = typed.AuctionError.Clone()
+		return &clone
+	default:
+		return err
+	}
+}
+
 // AddValidationError adds a validation error to the auction error
 func (ae *AuctionError) AddValidationError(bidderID, field, message string) {
 	ae.Details = append(ae.Details, NewValidationError(bidderID, field, message))
@@ -149,6 +284,12 @@ func (ae *AuctionError) WithOperation(operation string) *AuctionError {
 	return ae
 }
 
+// WithCode sets the stable code of the error
+func (ae *AuctionError) WithCode(code ErrorCode) *AuctionError {
+	ae.Code = code
+	return ae
+}
+
 // WithContext adds multiple context values to the error
 func (ae *AuctionError) WithContext(context map[string]string) *AuctionError {
 	if ae.Context == nil {
@@ -204,6 +345,11 @@ func NewProcessingErrorWithCause(message string, cause error, bidderCount, curre
 	}
 }
 
+// As exposes the embedded AuctionError when target is a **AuctionError
+func (pe *ProcessingError) As(target interface{}) bool {
+	return asAuctionError(pe.AuctionError, target)
+}
+
 // SystemError represents system-level errors
 type SystemError struct {
 	*AuctionError
@@ -229,6 +375,11 @@ func NewSystemErrorWithCause(message, component, severity string, cause error) *
 	}
 }
 
+// As exposes the embedded AuctionError when target is a **AuctionError
+func (se *SystemError) As(target interface{}) bool {
+	return asAuctionError(se.AuctionError, target)
+}
+
 // InputError represents errors in user input
 type InputError struct {
 	*AuctionError
@@ -245,6 +396,11 @@ func NewInputError(message, inputField string, inputValue interface{}) *InputErr
 	}
 }
 
+// As exposes the embedded AuctionError when target is a **AuctionError
+func (ie *InputError) As(target interface{}) bool {
+	return asAuctionError(ie.AuctionError, target)
+}
+
 // TimeoutError represents timeout errors during processing
 type TimeoutError struct {
 	*AuctionError
@@ -260,3 +416,20 @@ func NewTimeoutError(message, operation, duration string) *TimeoutError {
 		Operation:       operation,
 	}
 }
+
+// As exposes the embedded AuctionError when target is a **AuctionError
+func (te *TimeoutError) As(target interface{}) bool {
+	return asAuctionError(te.AuctionError, target)
+}
+
+// asAuctionError implements As for the error types that embed an AuctionError
+func asAuctionError(embedded *AuctionError, target interface{}) bool {
+	if embedded == nil {
+		return false
+	}
+	if auctionErr, ok := target.(**AuctionError); ok {
+		*auctionErr = embedded
+		return true
+	}
+	return embedded.As(target)
+}
@@ -753,3 +753,157 @@ func TestSpecializedErrorTypes_Coverage(t *testing.T) {
 		t.Errorf("Expected timeout duration '30 seconds', got '%s'", timeoutErr.TimeoutDuration)
 	}
 }
+
+func TestErrorSentinels_Is(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		sentinel error
+	}{
+		{"validation detail", NewValidationError("bidder1", "MaxBid", "too low"), ErrValidation},
+		{"validation", NewAuctionError(ErrorTypeValidation, "invalid bids", nil), ErrValidation},
+		{"processing", NewProcessingError("round limit", 2, 10), ErrProcessing},
+		{"system", NewSystemError("disk full", "Storage", "high"), ErrSystem},
+		{"input", NewInputError("bad input", "field", "value"), ErrInput},
+		{"timeout", NewTimeoutError("too slow", "ProcessBids", "1s"), ErrTimeout},
+		{"wrapped timeout", fmt.Errorf("settling lot: %w", NewTimeoutError("too slow", "ProcessBids", "1s")), ErrTimeout},
+		{"timeout as cause", NewProcessingErrorWithCause("round failed", NewTimeoutError("too slow", "ProcessBids", "1s"), 2, 10), ErrTimeout},
+	}
+
+	sentinels := []error{ErrValidation, ErrProcessing, ErrSystem, ErrInput, ErrTimeout}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.sentinel) {
+				t.Errorf("Expected %v to match %v", tt.err, tt.sentinel)
+			}
+			for _, other := range sentinels {
+				if other == tt.sentinel || tt.name == "timeout as cause" {
+					continue
+				}
+				if errors.Is(tt.err, other) {
+					t.Errorf("Expected %v not to match %v", tt.err, other)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorType_Sentinel(t *testing.T) {
+	if ErrorTypeTimeout.Sentinel() != ErrTimeout {
+		t.Error("Expected the timeout type to map to ErrTimeout")
+	}
+	if ErrorType("unknown").Sentinel() != nil {
+		t.Error("Expected no sentinel for an unknown type")
+	}
+	if errors.Is(NewAuctionError(ErrorType("unknown"), "odd", nil), ErrValidation) {
+		t.Error("Expected an unknown type not to match any sentinel")
+	}
+}
+
+func TestSpecializedErrors_As(t *testing.T) {
+	timeoutErr := NewTimeoutError("too slow", "ProcessBids", "1s")
+	timeoutErr.AddContext("lot_id", "lot-1")
+	wrapped := fmt.Errorf("settling lot: %w", timeoutErr)
+
+	var auctionErr *AuctionError
+	if !errors.As(wrapped, &auctionErr) {
+		t.Fatal("Expected a TimeoutError to expose its AuctionError")
+	}
+	if auctionErr != timeoutErr.AuctionError {
+		t.Error("Expected the embedded AuctionError, not a copy")
+	}
+	if lotID, _ := auctionErr.GetContext("lot_id"); lotID != "lot-1" {
+		t.Errorf("Expected lot_id context, got %q", lotID)
+	}
+
+	var asTimeout *TimeoutError
+	if !errors.As(wrapped, &asTimeout) || asTimeout.TimeoutDuration != "1s" {
+		t.Error("Expected errors.As to find the TimeoutError")
+	}
+	var asInput *InputError
+	if errors.As(wrapped, &asInput) {
+		t.Error("Expected a TimeoutError not to match InputError")
+	}
+
+	for _, err := range []error{NewProcessingError("p", 1, 1), NewSystemError("s", "c", "low"), NewInputError("i", "f", "v")} {
+		auctionErr = nil
+		if !errors.As(err, &auctionErr) || auctionErr == nil {
+			t.Errorf("Expected %T to expose its AuctionError", err)
+		}
+	}
+}
+
+func TestAuctionError_AsValidationError(t *testing.T) {
+	auctionErr := NewAuctionError(ErrorTypeValidation, "invalid bids", nil)
+	auctionErr.AddValidationErrorWithValue("bidder1", "MaxBid", "too low", "5")
+	auctionErr.AddValidationError("bidder2", "Name", "required")
+
+	var detail *ValidationError
+	if !errors.As(fmt.Errorf("wrapped: %w", auctionErr), &detail) {
+		t.Fatal("Expected errors.As to find a validation detail")
+	}
+	if detail.BidderID != "bidder1" || detail.Field != "MaxBid" {
+		t.Errorf("Expected the first detail, got %+v", detail)
+	}
+
+	if errors.As(NewAuctionError(ErrorTypeValidation, "no details", nil), &detail) {
+		t.Error("Expected no validation detail on an error without details")
+	}
+}
+
+func TestAuctionError_Clone(t *testing.T) {
+	cause := errors.New("disk full")
+	original := NewAuctionErrorWithCause(ErrorTypeValidation, "invalid bids", cause)
+	original.AddValidationError("bidder1", "MaxBid", "too low")
+	original.AddContext("lot_id", "lot-1")
+
+	clone := original.Clone()
+	clone.Message = "changed"
+	clone.Details[0].Message = "changed"
+	clone.AddContext("lot_id", "lot-2")
+
+	if original.Message != "invalid bids" || original.Details[0].Message != "too low" || original.Context["lot_id"] != "lot-1" {
+		t.Errorf("Expected the original to be unchanged, got %+v", original)
+	}
+	if clone.Cause != cause {
+		t.Error("Expected the clone to share the cause")
+	}
+	if (*AuctionError)(nil).Clone() != nil {
+		t.Error("Expected a nil error to clone to nil")
+	}
+}
+
+func TestCloneError(t *testing.T) {
+	plain := errors.New("plain")
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"auction error", NewAuctionError(ErrorTypeValidation, "invalid", nil)},
+		{"processing error", NewProcessingError("failed", 2, 1)},
+		{"system error", NewSystemError("broken", "engine", "high")},
+		{"input error", NewInputError("bad input", "field", 1)},
+		{"timeout error", NewTimeoutError("too slow", "ProcessBids", "1s")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clone := CloneError(tt.err)
+			if clone == tt.err || clone.Error() != tt.err.Error() {
+				t.Fatalf("Expected an equal copy, got %v", clone)
+			}
+			var original, copied *AuctionError
+			if !errors.As(tt.err, &original) || !errors.As(clone, &copied) || original == copied {
+				t.Fatal("Expected the copy to have its own AuctionError")
+			}
+			copied.AddContext("changed", "yes")
+			if _, ok := original.GetContext("changed"); ok {
+				t.Error("Expected the original context to be unchanged")
+			}
+		})
+	}
+
+	if CloneError(plain) != plain {
+		t.Error("Expected other errors to be returned as is")
+	}
+}
@@ -0,0 +1,47 @@
+package models
+
+// AuctionFormat names the rules an auction is settled under
+type AuctionFormat string
+
+const (
+	FormatEnglish          AuctionFormat = "english"            // Ascending proxy bidding with auto-increments (the default)
+	FormatFirstPriceSealed AuctionFormat = "first_price_sealed" // One sealed bid each; the highest pays its own bid
+	FormatVickrey          AuctionFormat = "vickrey"            // One sealed bid each; the highest pays the second-highest bid
+	FormatDutch            AuctionFormat = "dutch"              // A falling asking price; the first to accept pays it
+	FormatUniformPrice     AuctionFormat = "uniform_price"      // Identical units to the highest bids, all at one clearing price
+	FormatPayAsBid         AuctionFormat = "pay_as_bid"         // Identical units to the highest bids, each at its own bid
+)
+
+// IsMultiUnit returns true if the format sells several identical units, settled with AllocateUnits
+func (f AuctionFormat) IsMultiUnit() bool {
+	return f == FormatUniformPrice || f == FormatPayAsBid
+}
+
+// SecondPriceRule sets what the winner of a Vickrey auction pays relative to the second-highest bid
+type SecondPriceRule string
+
+const (
+	SecondPriceExact    SecondPriceRule = "exact"     // The second-highest bid (the default)
+	SecondPricePlusTick SecondPriceRule = "plus_tick" // One tick above the second-highest bid
+)
+
+// IsValid returns true if the rule is a known second-price rule or empty
+func (r SecondPriceRule) IsValid() bool {
+	switch r {
+	case "", SecondPriceExact, SecondPricePlusTick:
+		return true
+	default:
+		return false
+	}
+}
+
+// UsesAutoIncrement returns true if bidders raise their bids by AutoIncrement under the format
+// Unknown formats are assumed to, so validation stays as strict as for English auctions
+func (f AuctionFormat) UsesAutoIncrement() bool {
+	switch f {
+	case FormatFirstPriceSealed, FormatVickrey, FormatDutch, FormatUniformPrice, FormatPayAsBid:
+		return false
+	default:
+		return true
+	}
+}
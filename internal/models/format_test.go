@@ -0,0 +1,65 @@
+package models
+
+import "testing"
+
+func TestAuctionFormat_UsesAutoIncrement(t *testing.T) {
+	tests := []struct {
+		format   AuctionFormat
+		expected bool
+	}{
+		{FormatEnglish, true},
+		{FormatFirstPriceSealed, false},
+		{FormatVickrey, false},
+		{FormatDutch, false},
+		{FormatUniformPrice, false},
+		{FormatPayAsBid, false},
+		{"", true},
+		{"custom", true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.format.UsesAutoIncrement(); got != tt.expected {
+			t.Errorf("Expected %q.UsesAutoIncrement() to be %v, got %v", tt.format, tt.expected, got)
+		}
+	}
+}
+
+func TestAuctionConfig_AuctionFormat(t *testing.T) {
+	if got := NewAuctionConfig().AuctionFormat(); got != FormatEnglish {
+		t.Errorf("Expected the default format to be %s, got %s", FormatEnglish, got)
+	}
+	if got := NewAuctionConfigWithFormat(FormatFirstPriceSealed).AuctionFormat(); got != FormatFirstPriceSealed {
+		t.Errorf("Expected format %s, got %s", FormatFirstPriceSealed, got)
+	}
+}
+
+func TestSecondPriceRule_IsValid(t *testing.T) {
+	tests := []struct {
+		rule     SecondPriceRule
+		expected bool
+	}{
+		{"", true},
+		{SecondPriceExact, true},
+		{SecondPricePlusTick, true},
+		{"third_price", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.rule.IsValid(); got != tt.expected {
+			t.Errorf("Expected %q.IsValid() to be %v, got %v", tt.rule, tt.expected, got)
+		}
+	}
+}
+
+func TestAuctionFormat_IsMultiUnit(t *testing.T) {
+	for _, format := range []AuctionFormat{FormatEnglish, FormatFirstPriceSealed, FormatVickrey, FormatDutch, ""} {
+		if format.IsMultiUnit() {
+			t.Errorf("Expected %q to sell a single unit", format)
+		}
+	}
+	for _, format := range []AuctionFormat{FormatUniformPrice, FormatPayAsBid} {
+		if !format.IsMultiUnit() {
+			t.Errorf("Expected %s to sell multiple units", format)
+		}
+	}
+}
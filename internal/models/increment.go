@@ -0,0 +1,95 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+)
+
+// IncrementSchedule determines the bid increment that applies at a given price level
+type IncrementSchedule interface {
+	IncrementAt(priceCents int64) int64
+}
+
+// IncrementTier is one row of a tiered increment table
+type IncrementTier struct {
+	FromCents      int64 `json:"from_cents"`      // Lowest price (inclusive) the tier applies to
+	IncrementCents int64 `json:"increment_cents"` // Increment used while the price is within the tier
+}
+
+// TieredIncrementSchedule is a site-wide increment table where each tier applies
+// from its FromCents up to the next tier's FromCents
+type TieredIncrementSchedule struct {
+	tiers []IncrementTier
+}
+
+// NewTieredIncrementSchedule creates a new TieredIncrementSchedule from the given tiers
+// The first tier must start at zero, tiers must be strictly ascending and every increment must be positive
+func NewTieredIncrementSchedule(tiers []IncrementTier) (*TieredIncrementSchedule, error) {
+	if len(tiers) == 0 {
+		inputErr := NewInputError("increment schedule requires at least one tier", "tiers", len(tiers))
+		inputErr.WithOperation("NewTieredIncrementSchedule")
+		return nil, inputErr
+	}
+
+	sorted := make([]IncrementTier, len(tiers))
+	copy(sorted, tiers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].FromCents < sorted[j].FromCents
+	})
+
+	if sorted[0].FromCents != 0 {
+		inputErr := NewInputError("first increment tier must start at zero", "tiers[0].FromCents", sorted[0].FromCents)
+		inputErr.WithOperation("NewTieredIncrementSchedule")
+		return nil, inputErr
+	}
+
+	for i, tier := range sorted {
+		if tier.IncrementCents <= 0 {
+			inputErr := NewInputError("increment must be greater than zero", fmt.Sprintf("tiers[%d].IncrementCents", i), tier.IncrementCents)
+			inputErr.WithOperation("NewTieredIncrementSchedule")
+			return nil, inputErr
+		}
+		if i > 0 && tier.FromCents == sorted[i-1].FromCents {
+			inputErr := NewInputError("duplicate increment tier start", fmt.Sprintf("tiers[%d].FromCents", i), tier.FromCents)
+			inputErr.WithOperation("NewTieredIncrementSchedule")
+			return nil, inputErr
+		}
+	}
+
+	return &TieredIncrementSchedule{tiers: sorted}, nil
+}
+
+// DefaultIncrementSchedule returns a conventional auction-site increment table
+func DefaultIncrementSchedule() *TieredIncrementSchedule {
+	schedule, _ := NewTieredIncrementSchedule([]IncrementTier{
+		{FromCents: 0, IncrementCents: 5},          // $0.05 under $1
+		{FromCents: 100, IncrementCents: 25},       // $0.25 under $5
+		{FromCents: 500, IncrementCents: 50},       // $0.50 under $25
+		{FromCents: 2500, IncrementCents: 100},     // $1.00 under $100
+		{FromCents: 10000, IncrementCents: 250},    // $2.50 under $250
+		{FromCents: 25000, IncrementCents: 500},    // $5.00 under $500
+		{FromCents: 50000, IncrementCents: 1000},   // $10.00 under $1,000
+		{FromCents: 100000, IncrementCents: 2500},  // $25.00 under $2,500
+		{FromCents: 250000, IncrementCents: 5000},  // $50.00 under $5,000
+		{FromCents: 500000, IncrementCents: 10000}, // $100.00 from $5,000
+	})
+	return schedule
+}
+
+// IncrementAt returns the increment in cents for the tier containing priceCents
+func (s *TieredIncrementSchedule) IncrementAt(priceCents int64) int64 {
+	index := sort.Search(len(s.tiers), func(i int) bool {
+		return s.tiers[i].FromCents > priceCents
+	}) - 1
+	if index < 0 {
+		index = 0
+	}
+	return s.tiers[index].IncrementCents
+}
+
+// Tiers returns a copy of the schedule's tiers in ascending order
+func (s *TieredIncrementSchedule) Tiers() []IncrementTier {
+	tiers := make([]IncrementTier, len(s.tiers))
+	copy(tiers, s.tiers)
+	return tiers
+}
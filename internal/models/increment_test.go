@@ -0,0 +1,82 @@
+package models
+
+import "testing"
+
+// TestNewTieredIncrementSchedule tests constructing a schedule from unordered tiers
+func TestNewTieredIncrementSchedule(t *testing.T) {
+	schedule, err := NewTieredIncrementSchedule([]IncrementTier{
+		{FromCents: 500, IncrementCents: 100},
+		{FromCents: 0, IncrementCents: 5},
+		{FromCents: 100, IncrementCents: 25},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tiers := schedule.Tiers()
+	if len(tiers) != 3 {
+		t.Fatalf("Expected 3 tiers, got %d", len(tiers))
+	}
+	for i := 1; i < len(tiers); i++ {
+		if tiers[i].FromCents <= tiers[i-1].FromCents {
+			t.Errorf("Expected tiers sorted ascending, got %+v", tiers)
+		}
+	}
+}
+
+// TestNewTieredIncrementSchedule_Invalid tests the validation of schedule tiers
+func TestNewTieredIncrementSchedule_Invalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		tiers         []IncrementTier
+		expectedField string
+	}{
+		{"no tiers", nil, "tiers"},
+		{"missing zero tier", []IncrementTier{{FromCents: 100, IncrementCents: 5}}, "tiers[0].FromCents"},
+		{"zero increment", []IncrementTier{{FromCents: 0, IncrementCents: 0}}, "tiers[0].IncrementCents"},
+		{"negative increment", []IncrementTier{{FromCents: 0, IncrementCents: 5}, {FromCents: 100, IncrementCents: -1}}, "tiers[1].IncrementCents"},
+		{"duplicate start", []IncrementTier{{FromCents: 0, IncrementCents: 5}, {FromCents: 0, IncrementCents: 10}}, "tiers[1].FromCents"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTieredIncrementSchedule(tt.tiers)
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+			inputErr, ok := err.(*InputError)
+			if !ok {
+				t.Fatalf("Expected InputError, got %T", err)
+			}
+			if inputErr.InputField != tt.expectedField {
+				t.Errorf("Expected input field '%s', got '%s'", tt.expectedField, inputErr.InputField)
+			}
+		})
+	}
+}
+
+// TestTieredIncrementSchedule_IncrementAt tests tier lookup at and around boundaries
+func TestTieredIncrementSchedule_IncrementAt(t *testing.T) {
+	schedule := DefaultIncrementSchedule()
+
+	tests := []struct {
+		priceCents int64
+		expected   int64
+	}{
+		{0, 5},
+		{99, 5},
+		{100, 25},
+		{499, 25},
+		{500, 50},
+		{2499, 50},
+		{2500, 100},
+		{1000000, 10000},
+		{-1, 5},
+	}
+
+	for _, tt := range tests {
+		if got := schedule.IncrementAt(tt.priceCents); got != tt.expected {
+			t.Errorf("IncrementAt(%d) = %d, expected %d", tt.priceCents, got, tt.expected)
+		}
+	}
+}
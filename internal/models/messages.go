@@ -0,0 +1,212 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MessageKey identifies a user-facing validation message independently of its wording
+type MessageKey string
+
+// Validation message keys
+// Templates may use the placeholders {bidder_id}, {field}, {position}, {value} and {limit};
+// {field} renders the field's label, looked up under FieldLabelKey(field) in the same locale
+const (
+	MsgBidderIDRequired       MessageKey = "bidder.id_required"
+	MsgBidderNameRequired     MessageKey = "bidder.name_required"
+	MsgBidderDuplicateID      MessageKey = "bidder.duplicate_id"      // {value} is the ID
+	MsgBidderCurrencyMismatch MessageKey = "bidder.currency_mismatch" // {value} is the bidder's currency, {limit} the auction's
+	MsgBidderNoExchangeRate   MessageKey = "bidder.no_exchange_rate"  // {value} is the bidder's currency, {limit} the settlement currency
+	MsgBidderNotFound         MessageKey = "bidder.not_found"         // {value} is the ID
+	MsgAmountCurrencyMismatch MessageKey = "amount.currency_mismatch" // {value} is the amount's currency, {limit} the starting bid's
+	MsgAmountNegative         MessageKey = "amount.negative"          // {value} is the amount
+	MsgAmountNotPositive      MessageKey = "amount.not_positive"      // {value} is the amount
+	MsgBidStartAboveMax       MessageKey = "bid.start_above_max"      // {value} is the starting bid, {limit} the maximum bid
+	MsgBidMaxNotRaised        MessageKey = "bid.max_not_raised"       // {value} is the new maximum bid, {limit} the current one
+	MsgValidationUnexpected   MessageKey = "validation.unexpected"    // {value} is the underlying error
+	MsgBidBelowMinimum        MessageKey = "bid.below_minimum"        // {value} is the amount, {limit} the minimum
+	MsgBidAboveCap            MessageKey = "bid.above_cap"            // {value} is the amount, {limit} the cap
+	MsgIncrementOffGrid       MessageKey = "increment.off_grid"       // {value} is the amount, {limit} the price grid
+	MsgBidderNameTooShort     MessageKey = "bidder.name_too_short"    // {value} is the name, {limit} the minimum length
+	MsgBidderNameTooLong      MessageKey = "bidder.name_too_long"     // {value} is the name, {limit} the maximum length
+	MsgBidderIDFormat         MessageKey = "bidder.id_format"         // {value} is the ID, {limit} the required pattern
+	MsgBidderDuplicateName    MessageKey = "bidder.duplicate_name"    // {value} is the name, {limit} the ID that registered it first
+	MsgBidMaxOutlier          MessageKey = "bid.max_outlier"          // {value} is the maximum bid, {limit} the median maximum bid
+	MsgEntryInFuture          MessageKey = "entry.in_future"          // {value} is the entry time, {limit} the current time
+	MsgEntryAfterClose        MessageKey = "entry.after_close"        // {value} is the entry time, {limit} the close time
+	MsgAuctionBelowOpening    MessageKey = "auction.below_opening"    // {value} is the highest maximum bid, {limit} the opening price
+)
+
+// FieldLabelKey returns the key of the user-facing label of a bidder field such as "MaxBid"
+func FieldLabelKey(field string) MessageKey {
+	return MessageKey("field." + field)
+}
+
+// Locale is a BCP 47 language tag such as "en", "fr", "de" or "ja-JP"
+type Locale string
+
+// Built-in locales, always available from NewStaticMessageCatalog
+const (
+	LocaleEnglish Locale = "en" // Default locale
+	LocaleGerman  Locale = "de"
+)
+
+// language returns the locale's primary language subtag ("fr" for "fr-CA")
+func (l Locale) language() Locale {
+	if i := strings.IndexAny(string(l), "-_"); i >= 0 {
+		return l[:i]
+	}
+	return l
+}
+
+// MessageCatalog supplies the message templates used to render validation errors for a locale
+type MessageCatalog interface {
+	// Template returns the template for key in locale, reporting false when the catalog has none
+	Template(locale Locale, key MessageKey) (string, bool)
+}
+
+// englishMessages are the built-in English templates
+var englishMessages = map[MessageKey]string{
+	MsgBidderIDRequired:       "bidder ID is required",
+	MsgBidderNameRequired:     "bidder name is required",
+	MsgBidderDuplicateID:      "duplicate bidder ID",
+	MsgBidderCurrencyMismatch: "bidder currency does not match auction currency",
+	MsgBidderNoExchangeRate:   "no exchange rate to settlement currency",
+	MsgBidderNotFound:         "bidder not found in auction",
+	MsgAmountCurrencyMismatch: "{field} currency does not match starting bid currency",
+	MsgAmountNegative:         "{field} cannot be negative",
+	MsgAmountNotPositive:      "{field} must be greater than zero",
+	MsgBidStartAboveMax:       "starting bid cannot be greater than maximum bid",
+	MsgBidMaxNotRaised:        "new maximum bid must exceed the current maximum bid",
+	MsgValidationUnexpected:   "unexpected validation error",
+	MsgBidBelowMinimum:        "{field} is below the minimum of {limit}",
+	MsgBidAboveCap:            "{field} exceeds the cap of {limit}",
+	MsgIncrementOffGrid:       "{field} must be a multiple of the price grid of {limit}",
+	MsgBidderNameTooShort:     "bidder name must be at least {limit} characters",
+	MsgBidderNameTooLong:      "bidder name must be at most {limit} characters",
+	MsgBidderIDFormat:         "bidder ID does not have the required format",
+	MsgBidderDuplicateName:    "bidder name is already registered under bidder ID {limit}",
+	MsgBidMaxOutlier:          "{field} is far outside the other bids (median {limit})",
+	MsgEntryInFuture:          "{field} is in the future",
+	MsgEntryAfterClose:        "{field} is after the auction close",
+	MsgAuctionBelowOpening:    "no maximum bid reaches the opening price of {limit}",
+
+	FieldLabelKey("StartingBid"):   "starting bid",
+	FieldLabelKey("MaxBid"):        "maximum bid",
+	FieldLabelKey("AutoIncrement"): "auto-increment amount",
+	FieldLabelKey("EntryTime"):     "entry time",
+	FieldLabelKey("Quantity"):      "quantity",
+}
+
+// germanMessages are the built-in German templates
+var germanMessages = map[MessageKey]string{
+	MsgBidderIDRequired:       "Bieter-ID ist erforderlich",
+	MsgBidderNameRequired:     "Bietername ist erforderlich",
+	MsgBidderDuplicateID:      "doppelte Bieter-ID",
+	MsgBidderCurrencyMismatch: "Währung des Bieters stimmt nicht mit der Auktionswährung überein",
+	MsgBidderNoExchangeRate:   "kein Wechselkurs zur Abrechnungswährung",
+	MsgBidderNotFound:         "Bieter nicht in der Auktion gefunden",
+	MsgAmountCurrencyMismatch: "Währung von {field} stimmt nicht mit der Währung des Startgebots überein",
+	MsgAmountNegative:         "{field} darf nicht negativ sein",
+	MsgAmountNotPositive:      "{field} muss größer als null sein",
+	MsgBidStartAboveMax:       "Startgebot darf nicht höher als das Höchstgebot sein",
+	MsgBidMaxNotRaised:        "neues Höchstgebot muss das aktuelle Höchstgebot übersteigen",
+	MsgValidationUnexpected:   "unerwarteter Validierungsfehler",
+	MsgBidBelowMinimum:        "{field} liegt unter dem Minimum von {limit}",
+	MsgBidAboveCap:            "{field} überschreitet die Obergrenze von {limit}",
+	MsgIncrementOffGrid:       "{field} muss ein Vielfaches des Preisrasters von {limit} sein",
+	MsgBidderNameTooShort:     "Bietername muss mindestens {limit} Zeichen lang sein",
+	MsgBidderNameTooLong:      "Bietername darf höchstens {limit} Zeichen lang sein",
+	MsgBidderIDFormat:         "Bieter-ID hat nicht das erforderliche Format",
+	MsgBidderDuplicateName:    "Bietername ist bereits unter der Bieter-ID {limit} registriert",
+	MsgBidMaxOutlier:          "{field} weicht stark von den übrigen Geboten ab (Median {limit})",
+	MsgEntryInFuture:          "{field} liegt in der Zukunft",
+	MsgEntryAfterClose:        "{field} liegt nach dem Auktionsende",
+	MsgAuctionBelowOpening:    "kein Höchstgebot erreicht den Eröffnungspreis von {limit}",
+
+	FieldLabelKey("StartingBid"):   "Startgebot",
+	FieldLabelKey("MaxBid"):        "Höchstgebot",
+	FieldLabelKey("AutoIncrement"): "Erhöhungsschritt",
+	FieldLabelKey("EntryTime"):     "Eingangszeit",
+	FieldLabelKey("Quantity"):      "Menge",
+}
+
+// StaticMessageCatalog serves fixed tables of message templates per locale
+// A locale with a region ("fr-CA") falls back to its language ("fr")
+type StaticMessageCatalog struct {
+	messages map[Locale]map[MessageKey]string
+}
+
+// NewStaticMessageCatalog creates a new StaticMessageCatalog holding the English and German templates
+func NewStaticMessageCatalog() *StaticMessageCatalog {
+	catalog := &StaticMessageCatalog{messages: make(map[Locale]map[MessageKey]string)}
+	catalog.SetMessages(LocaleEnglish, englishMessages)
+	catalog.SetMessages(LocaleGerman, germanMessages)
+	return catalog
+}
+
+// SetMessages registers templates for a locale, replacing earlier templates for the same keys
+func (c *StaticMessageCatalog) SetMessages(locale Locale, messages map[MessageKey]string) {
+	if c.messages[locale] == nil {
+		c.messages[locale] = make(map[MessageKey]string, len(messages))
+	}
+	for key, template := range messages {
+		c.messages[locale][key] = template
+	}
+}
+
+// Template returns the template registered for the locale, or for its language
+func (c *StaticMessageCatalog) Template(locale Locale, key MessageKey) (string, bool) {
+	if template, ok := c.messages[locale][key]; ok {
+		return template, true
+	}
+	template, ok := c.messages[locale.language()][key]
+	return template, ok
+}
+
+// defaultCatalog renders messages when no catalog is given
+var defaultCatalog MessageCatalog = NewStaticMessageCatalog()
+
+// Localize renders the error's message in locale using catalog (the built-in templates when nil)
+// Missing templates fall back to English and then to Message, so every error renders
+func (ve *ValidationError) Localize(catalog MessageCatalog, locale Locale) string {
+	if catalog == nil {
+		catalog = defaultCatalog
+	}
+	if ve.Key == "" {
+		return ve.Message
+	}
+
+	template, ok := lookupTemplate(catalog, locale, ve.Key)
+	if !ok {
+		return ve.Message
+	}
+
+	field := ve.Field
+	if label, ok := lookupTemplate(catalog, locale, FieldLabelKey(ve.Field)); ok {
+		field = label
+	}
+	position := ""
+	if ve.Position > 0 {
+		position = strconv.Itoa(ve.Position)
+	}
+	return strings.NewReplacer(
+		"{bidder_id}", ve.BidderID,
+		"{field}", field,
+		"{position}", position,
+		"{value}", ve.Value,
+		"{limit}", ve.Limit,
+	).Replace(template)
+}
+
+// lookupTemplate finds key in locale, then in English in catalog, then in the built-in English templates
+func lookupTemplate(catalog MessageCatalog, locale Locale, key MessageKey) (string, bool) {
+	if template, ok := catalog.Template(locale, key); ok {
+		return template, true
+	}
+	if template, ok := catalog.Template(LocaleEnglish, key); ok {
+		return template, true
+	}
+	template, ok := englishMessages[key]
+	return template, ok
+}
@@ -0,0 +1,142 @@
+package models
+
+import "testing"
+
+// frenchGermanJapanese returns a catalog with a few translated templates
+func frenchGermanJapanese() *StaticMessageCatalog {
+	catalog := NewStaticMessageCatalog()
+	catalog.SetMessages("fr", map[MessageKey]string{
+		MsgBidStartAboveMax:          "l'enchère de départ ({value}) ne peut pas dépasser l'enchère maximale ({limit})",
+		MsgAmountNegative:            "le champ {field} ne peut pas être négatif",
+		FieldLabelKey("MaxBid"):      "enchère maximale",
+		MsgBidderDuplicateID:         "identifiant en double à la position {position} : {value}",
+		MsgBidderCurrencyMismatch:    "devise {value} refusée, l'enchère est en {limit}",
+		MsgBidderNameRequired:        "le nom est obligatoire",
+		FieldLabelKey("StartingBid"): "enchère de départ",
+	})
+	catalog.SetMessages("de", map[MessageKey]string{
+		MsgBidStartAboveMax: "Startgebot ({value}) darf das Höchstgebot ({limit}) nicht übersteigen",
+	})
+	catalog.SetMessages("ja", map[MessageKey]string{
+		MsgBidStartAboveMax: "開始入札額（{value}）は最高入札額（{limit}）を超えられません",
+	})
+	return catalog
+}
+
+func TestValidationError_Localize(t *testing.T) {
+	catalog := frenchGermanJapanese()
+	startAboveMax := NewLocalizedValidationError("b1", "StartingBid", MsgBidStartAboveMax, "300.00", "200.00")
+	negativeMax := NewLocalizedValidationError("b1", "MaxBid", MsgAmountNegative, "-5.00", "0")
+	duplicate := NewLocalizedValidationError("b1", "ID", MsgBidderDuplicateID, "b1", "").WithPosition(3)
+
+	tests := []struct {
+		name   string
+		detail *ValidationError
+		locale Locale
+		want   string
+	}{
+		{"english", startAboveMax, LocaleEnglish, "starting bid cannot be greater than maximum bid"},
+		{"french", startAboveMax, "fr", "l'enchère de départ (300.00) ne peut pas dépasser l'enchère maximale (200.00)"},
+		{"german", startAboveMax, "de", "Startgebot (300.00) darf das Höchstgebot (200.00) nicht übersteigen"},
+		{"japanese with region", startAboveMax, "ja-JP", "開始入札額（300.00）は最高入札額（200.00）を超えられません"},
+		{"unknown locale falls back to english", startAboveMax, "pt-BR", "starting bid cannot be greater than maximum bid"},
+		{"missing key falls back to english", NewLocalizedValidationError("b1", "ID", MsgBidderIDRequired, "", ""), "fr", "bidder ID is required"},
+		{"localized field label", negativeMax, "fr", "le champ enchère maximale ne peut pas être négatif"},
+		{"english field label", negativeMax, "en-GB", "maximum bid cannot be negative"},
+		{"position parameter", duplicate, "fr", "identifiant en double à la position 3 : b1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.detail.Localize(catalog, tt.locale); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidationError_LocalizeGerman(t *testing.T) {
+	catalog := NewStaticMessageCatalog()
+	for key := range englishMessages {
+		if _, ok := catalog.Template(LocaleGerman, key); !ok {
+			t.Errorf("Expected a German template for %s", key)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		catalog MessageCatalog
+		detail  *ValidationError
+		locale  Locale
+		want    string
+	}{
+		{"plain message", catalog, NewLocalizedValidationError("b1", "ID", MsgBidderIDRequired, "", ""), LocaleGerman, "Bieter-ID ist erforderlich"},
+		{"field label", catalog, NewLocalizedValidationError("b1", "AutoIncrement", MsgAmountNotPositive, "0.00", "0"), LocaleGerman, "Erhöhungsschritt muss größer als null sein"},
+		{"limit parameter with region", catalog, NewLocalizedValidationError("b1", "MaxBid", MsgBidBelowMinimum, "5.00", "10.00"), "de-AT", "Höchstgebot liegt unter dem Minimum von 10.00"},
+		{"nil catalog", nil, NewLocalizedValidationError("b1", "Name", MsgBidderNameTooShort, "Al", "3"), "de-CH", "Bietername muss mindestens 3 Zeichen lang sein"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.detail.Localize(tt.catalog, tt.locale); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidationError_LocalizeFallbacks(t *testing.T) {
+	detail := NewLocalizedValidationError("b1", "AutoIncrement", MsgAmountNotPositive, "0.00", "0")
+	if detail.Message != "auto-increment amount must be greater than zero" {
+		t.Errorf("Expected the English rendering as Message, got %q", detail.Message)
+	}
+	if got := detail.Localize(nil, "fr"); got != detail.Message {
+		t.Errorf("Expected a nil catalog to render English, got %q", got)
+	}
+
+	// A catalog without English still falls back to the built-in templates
+	if got := detail.Localize(emptyCatalog{}, "fr"); got != detail.Message {
+		t.Errorf("Expected the built-in English template, got %q", got)
+	}
+
+	legacy := NewValidationErrorWithValue("b1", "Name", "custom message", "")
+	if got := legacy.Localize(frenchGermanJapanese(), "fr"); got != "custom message" {
+		t.Errorf("Expected an error without a key to keep its message, got %q", got)
+	}
+
+	unknown := &ValidationError{BidderID: "b1", Key: "custom.key", Message: "custom fallback"}
+	if got := unknown.Localize(frenchGermanJapanese(), "fr"); got != "custom fallback" {
+		t.Errorf("Expected an unknown key to keep its message, got %q", got)
+	}
+}
+
+func TestStaticMessageCatalog_SetMessages(t *testing.T) {
+	catalog := NewStaticMessageCatalog()
+	catalog.SetMessages(LocaleEnglish, map[MessageKey]string{MsgBidderNameRequired: "please tell us your name"})
+
+	if template, _ := catalog.Template(LocaleEnglish, MsgBidderNameRequired); template != "please tell us your name" {
+		t.Errorf("Expected the override, got %q", template)
+	}
+	if _, ok := catalog.Template(LocaleEnglish, MsgBidderIDRequired); !ok {
+		t.Error("Expected other English templates to remain")
+	}
+	if _, ok := catalog.Template("fr-CA", MsgBidderIDRequired); ok {
+		t.Error("Expected no French template")
+	}
+	if englishMessages[MsgBidderNameRequired] != "bidder name is required" {
+		t.Error("Expected overrides not to change the built-in templates")
+	}
+}
+
+func TestValidationError_ErrorWithPosition(t *testing.T) {
+	detail := NewLocalizedValidationError("b1", "ID", MsgBidderDuplicateID, "b1", "").WithPosition(2)
+	want := "validation error for bidder b1 at position 2, field ID: duplicate bidder ID (value: b1)"
+	if detail.Error() != want {
+		t.Errorf("Expected %q, got %q", want, detail.Error())
+	}
+}
+
+// emptyCatalog has no templates at all
+type emptyCatalog struct{}
+
+func (emptyCatalog) Template(locale Locale, key MessageKey) (string, bool) { return "", false }
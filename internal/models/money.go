@@ -0,0 +1,242 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code
+type Currency string
+
+const (
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	GBP Currency = "GBP"
+	JPY Currency = "JPY"
+	KWD Currency = "KWD"
+)
+
+// DefaultCurrency is assumed for amounts that do not specify a currency
+const DefaultCurrency = USD
+
+// currencyMinorDigits lists the number of minor-unit digits for known currencies
+var currencyMinorDigits = map[Currency]int{
+	USD: 2,
+	EUR: 2,
+	GBP: 2,
+	JPY: 0,
+	KWD: 3,
+}
+
+// MinorDigits returns the number of decimal places used by the currency's minor unit
+// Unknown currencies default to two decimal places
+func (c Currency) MinorDigits() int {
+	if digits, ok := currencyMinorDigits[c]; ok {
+		return digits
+	}
+	return 2
+}
+
+// minorFactor returns the number of minor units in one major unit
+func (c Currency) minorFactor() int64 {
+	factor := int64(1)
+	for i := 0; i < c.MinorDigits(); i++ {
+		factor *= 10
+	}
+	return factor
+}
+
+// Money is an immutable monetary amount held as integer minor units of a currency
+// The zero value is zero in DefaultCurrency
+type Money struct {
+	amount   int64    // Amount in minor units (e.g. cents)
+	currency Currency // ISO 4217 currency code
+}
+
+// NewMoney creates a new Money from an amount in minor units
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{amount: amount, currency: currency}
+}
+
+// MoneyFromMajor creates a new Money from an amount in major units, rounded to the nearest minor unit
+func MoneyFromMajor(amount float64, currency Currency) Money {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	return Money{amount: int64(math.Round(amount * float64(currency.minorFactor()))), currency: currency}
+}
+
+// Dollars creates a new USD Money from a dollar amount
+func Dollars(amount float64) Money {
+	return MoneyFromMajor(amount, USD)
+}
+
+// Amount returns the amount in minor units
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency returns the currency of the amount
+func (m Money) Currency() Currency {
+	if m.currency == "" {
+		return DefaultCurrency
+	}
+	return m.currency
+}
+
+// Float64 returns the amount in major units
+// It is intended for display and legacy interfaces, not for arithmetic
+func (m Money) Float64() float64 {
+	return float64(m.amount) / float64(m.Currency().minorFactor())
+}
+
+// IsZero returns true if the amount is zero
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsNegative returns true if the amount is below zero
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// IsPositive returns true if the amount is above zero
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+// Add returns the sum of two amounts in the same currency
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other, "Add"); err != nil {
+		return Money{}, err
+	}
+	sum := m.amount + other.amount
+	if (other.amount > 0 && sum < m.amount) || (other.amount < 0 && sum > m.amount) {
+		return Money{}, m.overflowError("Add", other)
+	}
+	return Money{amount: sum, currency: m.Currency()}, nil
+}
+
+// Sub returns the difference of two amounts in the same currency
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.checkCurrency(other, "Sub"); err != nil {
+		return Money{}, err
+	}
+	diff := m.amount - other.amount
+	if (other.amount > 0 && diff > m.amount) || (other.amount < 0 && diff < m.amount) {
+		return Money{}, m.overflowError("Sub", other)
+	}
+	return Money{amount: diff, currency: m.Currency()}, nil
+}
+
+// Mul returns the amount multiplied by an integer factor
+func (m Money) Mul(factor int64) (Money, error) {
+	if m.amount == 0 || factor == 0 {
+		return Money{amount: 0, currency: m.Currency()}, nil
+	}
+	product := m.amount * factor
+	if product/factor != m.amount || (m.amount == -1 && factor == math.MinInt64) || (factor == -1 && m.amount == math.MinInt64) {
+		inputErr := NewInputError("monetary amount overflow", "factor", factor)
+		inputErr.WithOperation("Money.Mul")
+		inputErr.AddContext("amount", m.String())
+		return Money{}, inputErr
+	}
+	return Money{amount: product, currency: m.Currency()}, nil
+}
+
+// Cmp compares two amounts in the same currency, returning -1, 0 or +1
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.checkCurrency(other, "Cmp"); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Equal returns true if both amounts and currencies match
+func (m Money) Equal(other Money) bool {
+	return m.amount == other.amount && m.Currency() == other.Currency()
+}
+
+// SameCurrency returns true if both amounts use the same currency
+func (m Money) SameCurrency(other Money) bool {
+	return m.Currency() == other.Currency()
+}
+
+// Decimal formats the amount in major units with the currency's minor digits (e.g. "12.34")
+func (m Money) Decimal() string {
+	digits := m.Currency().MinorDigits()
+	if digits == 0 {
+		return strconv.FormatInt(m.amount, 10)
+	}
+
+	factor := m.Currency().minorFactor()
+	sign := ""
+	amount := m.amount
+	if amount < 0 {
+		sign = "-"
+	}
+	whole := amount / factor
+	frac := amount % factor
+	if whole < 0 {
+		whole = -whole
+	}
+	if frac < 0 {
+		frac = -frac
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, whole, digits, frac)
+}
+
+// String formats the amount with its currency code (e.g. "12.34 USD")
+func (m Money) String() string {
+	return m.Decimal() + " " + string(m.Currency())
+}
+
+// MarshalJSON encodes the amount as {"amount": <minor units>, "currency": "<code>"}
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   int64    `json:"amount"`
+		Currency Currency `json:"currency"`
+	}{m.amount, m.Currency()})
+}
+
+// UnmarshalJSON decodes the object form written by MarshalJSON
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var decoded struct {
+		Amount   int64    `json:"amount"`
+		Currency Currency `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*m = NewMoney(decoded.Amount, Currency(strings.ToUpper(string(decoded.Currency))))
+	return nil
+}
+
+// checkCurrency returns an error if the two amounts use different currencies
+func (m Money) checkCurrency(other Money, operation string) error {
+	if m.SameCurrency(other) {
+		return nil
+	}
+	inputErr := NewInputError(fmt.Sprintf("currency mismatch: %s and %s", m.Currency(), other.Currency()), "currency", string(other.Currency()))
+	inputErr.WithOperation("Money." + operation)
+	return inputErr
+}
+
+// overflowError builds the error returned when an operation exceeds the int64 range
+func (m Money) overflowError(operation string, other Money) error {
+	inputErr := NewInputError("monetary amount overflow", "amount", other.amount)
+	inputErr.WithOperation("Money." + operation)
+	inputErr.AddContext("left", m.String())
+	inputErr.AddContext("right", other.String())
+	return inputErr
+}
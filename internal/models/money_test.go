@@ -0,0 +1,188 @@
+package models
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+// TestMoneyFromMajor tests conversion from major units with each currency's minor digits
+func TestMoneyFromMajor(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency Currency
+		expected int64
+	}{
+		{10.99, USD, 1099},
+		{0.1 + 0.2, EUR, 30},
+		{1500, JPY, 1500},
+		{1.2345, KWD, 1235},
+		{2.5, "", 250},
+	}
+
+	for _, tt := range tests {
+		money := MoneyFromMajor(tt.amount, tt.currency)
+		if money.Amount() != tt.expected {
+			t.Errorf("MoneyFromMajor(%v, %s) = %d, expected %d", tt.amount, tt.currency, money.Amount(), tt.expected)
+		}
+	}
+}
+
+// TestMoney_ZeroValue tests that the zero value is zero in the default currency
+func TestMoney_ZeroValue(t *testing.T) {
+	var money Money
+
+	if !money.IsZero() {
+		t.Error("Expected zero value to be zero")
+	}
+	if money.Currency() != DefaultCurrency {
+		t.Errorf("Expected currency %s, got %s", DefaultCurrency, money.Currency())
+	}
+	if !money.Equal(NewMoney(0, USD)) {
+		t.Error("Expected zero value to equal USD 0")
+	}
+}
+
+// TestMoney_Add tests checked addition
+func TestMoney_Add(t *testing.T) {
+	sum, err := Dollars(10.50).Add(Dollars(0.25))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sum.Amount() != 1075 || sum.Currency() != USD {
+		t.Errorf("Expected 1075 USD, got %s", sum)
+	}
+
+	if _, err := Dollars(1).Add(NewMoney(100, EUR)); err == nil {
+		t.Error("Expected currency mismatch error")
+	} else if inputErr, ok := err.(*InputError); !ok || inputErr.InputField != "currency" {
+		t.Errorf("Expected InputError on currency, got %v", err)
+	}
+
+	if _, err := NewMoney(math.MaxInt64, USD).Add(NewMoney(1, USD)); err == nil {
+		t.Error("Expected overflow error")
+	}
+	if _, err := NewMoney(math.MinInt64, USD).Add(NewMoney(-1, USD)); err == nil {
+		t.Error("Expected underflow error")
+	}
+}
+
+// TestMoney_Sub tests checked subtraction
+func TestMoney_Sub(t *testing.T) {
+	diff, err := Dollars(10.50).Sub(Dollars(0.75))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if diff.Amount() != 975 {
+		t.Errorf("Expected 975, got %d", diff.Amount())
+	}
+
+	if _, err := NewMoney(math.MinInt64, USD).Sub(NewMoney(1, USD)); err == nil {
+		t.Error("Expected overflow error")
+	}
+	if _, err := NewMoney(100, GBP).Sub(NewMoney(100, USD)); err == nil {
+		t.Error("Expected currency mismatch error")
+	}
+}
+
+// TestMoney_Mul tests checked multiplication
+func TestMoney_Mul(t *testing.T) {
+	product, err := Dollars(2.50).Mul(4)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if product.Amount() != 1000 {
+		t.Errorf("Expected 1000, got %d", product.Amount())
+	}
+
+	if zero, err := NewMoney(math.MaxInt64, USD).Mul(0); err != nil || !zero.IsZero() {
+		t.Errorf("Expected zero product, got %s (%v)", zero, err)
+	}
+	if _, err := NewMoney(math.MaxInt64/2+1, USD).Mul(2); err == nil {
+		t.Error("Expected overflow error")
+	}
+	if _, err := NewMoney(math.MinInt64, USD).Mul(-1); err == nil {
+		t.Error("Expected overflow error negating the minimum amount")
+	}
+}
+
+// TestMoney_Cmp tests comparisons
+func TestMoney_Cmp(t *testing.T) {
+	tests := []struct {
+		a, b     Money
+		expected int
+	}{
+		{Dollars(1), Dollars(2), -1},
+		{Dollars(2), Dollars(2), 0},
+		{Dollars(3), Dollars(2), 1},
+	}
+	for _, tt := range tests {
+		got, err := tt.a.Cmp(tt.b)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if got != tt.expected {
+			t.Errorf("Cmp(%s, %s) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+
+	if _, err := NewMoney(1, JPY).Cmp(NewMoney(1, USD)); err == nil {
+		t.Error("Expected currency mismatch error")
+	}
+}
+
+// TestMoney_Format tests decimal formatting for currencies with different minor digits
+func TestMoney_Format(t *testing.T) {
+	tests := []struct {
+		money    Money
+		decimal  string
+		str      string
+		floatVal float64
+	}{
+		{NewMoney(1234, USD), "12.34", "12.34 USD", 12.34},
+		{NewMoney(-5, EUR), "-0.05", "-0.05 EUR", -0.05},
+		{NewMoney(1500, JPY), "1500", "1500 JPY", 1500},
+		{NewMoney(1250, KWD), "1.250", "1.250 KWD", 1.25},
+	}
+
+	for _, tt := range tests {
+		if tt.money.Decimal() != tt.decimal {
+			t.Errorf("Expected decimal '%s', got '%s'", tt.decimal, tt.money.Decimal())
+		}
+		if tt.money.String() != tt.str {
+			t.Errorf("Expected string '%s', got '%s'", tt.str, tt.money.String())
+		}
+		if tt.money.Float64() != tt.floatVal {
+			t.Errorf("Expected float %v, got %v", tt.floatVal, tt.money.Float64())
+		}
+	}
+}
+
+// TestMoney_JSON tests the object encoding of a standalone Money value
+func TestMoney_JSON(t *testing.T) {
+	data, err := json.Marshal(NewMoney(1250, KWD))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if string(data) != `{"amount":1250,"currency":"KWD"}` {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+
+	var decoded Money
+	if err := json.Unmarshal([]byte(`{"amount":99,"currency":"eur"}`), &decoded); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !decoded.Equal(NewMoney(99, EUR)) {
+		t.Errorf("Expected 99 EUR, got %s", decoded)
+	}
+}
+
+// TestCurrency_MinorDigits tests minor-unit digits for known and unknown currencies
+func TestCurrency_MinorDigits(t *testing.T) {
+	tests := map[Currency]int{USD: 2, EUR: 2, GBP: 2, JPY: 0, KWD: 3, "XYZ": 2}
+	for currency, expected := range tests {
+		if currency.MinorDigits() != expected {
+			t.Errorf("%s: expected %d minor digits, got %d", currency, expected, currency.MinorDigits())
+		}
+	}
+}
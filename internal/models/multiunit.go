@@ -0,0 +1,116 @@
+package models
+
+// ClearingRule sets the uniform price every winner of a uniform-price auction pays per unit
+type ClearingRule string
+
+const (
+	ClearingHighestLosing ClearingRule = "highest_losing" // The highest bid that went unfilled (the default)
+	ClearingLowestWinning ClearingRule = "lowest_winning" // The lowest bid that won units
+)
+
+// IsValid returns true if the rule is a known clearing rule or empty
+func (r ClearingRule) IsValid() bool {
+	switch r {
+	case "", ClearingHighestLosing, ClearingLowestWinning:
+		return true
+	default:
+		return false
+	}
+}
+
+// MultiUnitConfig describes a lot of identical units sold in one auction
+// Each bidder asks for Bidder.Quantity units at up to MaxBid per unit
+type MultiUnitConfig struct {
+	Units             int          `json:"units"`                          // Number of identical units for sale
+	PartialFills      bool         `json:"partial_fills"`                  // Whether the marginal bidder may get fewer units than requested
+	Clearing          ClearingRule `json:"clearing,omitempty"`             // Uniform price rule (ClearingHighestLosing if empty, unused under pay-as-bid)
+	MaxUnitsPerBidder int          `json:"max_units_per_bidder,omitempty"` // Most units one bidder may win (0 means no limit)
+}
+
+// ClearingRule returns the uniform price rule
+func (c MultiUnitConfig) ClearingRule() ClearingRule {
+	if c.Clearing == "" {
+		return ClearingHighestLosing
+	}
+	return c.Clearing
+}
+
+// UnitsFor returns the number of units the bidder may win: their request, capped by MaxUnitsPerBidder
+func (c MultiUnitConfig) UnitsFor(bidder *Bidder) int {
+	if c.MaxUnitsPerBidder > 0 && bidder.Units() > c.MaxUnitsPerBidder {
+		return c.MaxUnitsPerBidder
+	}
+	return bidder.Units()
+}
+
+// Validate returns an InputError if no units are for sale, the per-bidder limit is negative or the
+// clearing rule is unknown
+func (c MultiUnitConfig) Validate() error {
+	var inputErr *InputError
+	switch {
+	case c.Units <= 0:
+		inputErr = NewInputError("units for sale must be positive", "config.MultiUnit.Units", c.Units)
+	case c.MaxUnitsPerBidder < 0:
+		inputErr = NewInputError("per-bidder unit limit cannot be negative", "config.MultiUnit.MaxUnitsPerBidder", c.MaxUnitsPerBidder)
+	case !c.Clearing.IsValid():
+		inputErr = NewInputError("unsupported clearing rule", "config.MultiUnit.Clearing", string(c.Clearing))
+	default:
+		return nil
+	}
+	inputErr.WithOperation("MultiUnitConfig.Validate").WithCode(CodeEngineMultiUnitInvalid)
+	return inputErr
+}
+
+// UnitAllocation is one winner's share of a multi-unit auction
+type UnitAllocation struct {
+	Bidder    *Bidder `json:"bidder"`     // Winning bidder
+	Requested int     `json:"requested"`  // Units the bidder asked for
+	Units     int     `json:"units"`      // Units won, at most the per-bidder limit
+	UnitPrice Money   `json:"unit_price"` // Price paid per unit: the clearing price, or the bidder's own bid under pay-as-bid
+	Amount    Money   `json:"amount"`     // Total owed for the units won
+}
+
+// IsPartial returns true if the bidder won fewer units than requested
+func (a UnitAllocation) IsPartial() bool {
+	return a.Units < a.Requested
+}
+
+// MultiUnitResult represents the outcome of a multi-unit auction
+// Amounts are in the settlement currency; allocations are in priority order, highest bid first
+type MultiUnitResult struct {
+	Format        AuctionFormat      `json:"format"`             // Rules the auction was settled under
+	Units         int                `json:"units"`              // Units offered
+	UnitsSold     int                `json:"units_sold"`         // Units allocated to winners
+	Clearing      ClearingRule       `json:"clearing,omitempty"` // Rule that set the uniform price (empty under pay-as-bid)
+	ClearingPrice Money              `json:"clearing_price"`     // Uniform price per unit, or the lowest winning bid under pay-as-bid (zero when nothing sold)
+	Allocations   []UnitAllocation   `json:"allocations"`        // Winners with their units and amounts owed
+	Revenue       Money              `json:"revenue"`            // Total owed by all winners
+	TotalBidders  int                `json:"total_bidders"`      // Number of participants
+	AllBidders    []Bidder           `json:"all_bidders"`        // All bids in entry order
+	ReservePrice  Money              `json:"reserve_price"`      // Minimum price per unit
+	Warnings      []*ValidationError `json:"warnings,omitempty"` // Validation findings that did not fail the auction
+}
+
+// Allocation returns the allocation of the given bidder, reporting false if they won nothing
+func (r *MultiUnitResult) Allocation(bidderID string) (UnitAllocation, bool) {
+	for _, allocation := range r.Allocations {
+		if allocation.Bidder.ID == bidderID {
+			return allocation, true
+		}
+	}
+	return UnitAllocation{}, false
+}
+
+// UnitsUnsold returns the number of units nobody won
+func (r *MultiUnitResult) UnitsUnsold() int {
+	return r.Units - r.UnitsSold
+}
+
+// RevenueByWinner returns the amount each winner owes, keyed by bidder ID
+func (r *MultiUnitResult) RevenueByWinner() map[string]Money {
+	revenue := make(map[string]Money, len(r.Allocations))
+	for _, allocation := range r.Allocations {
+		revenue[allocation.Bidder.ID] = allocation.Amount
+	}
+	return revenue
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMultiUnitConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config MultiUnitConfig
+		field  string // Empty when the config is valid
+	}{
+		{"valid", MultiUnitConfig{Units: 50}, ""},
+		{"lowest winning", MultiUnitConfig{Units: 1, Clearing: ClearingLowestWinning}, ""},
+		{"no units", MultiUnitConfig{}, "config.MultiUnit.Units"},
+		{"negative units", MultiUnitConfig{Units: -5}, "config.MultiUnit.Units"},
+		{"per-bidder limit", MultiUnitConfig{Units: 5, MaxUnitsPerBidder: 2}, ""},
+		{"negative per-bidder limit", MultiUnitConfig{Units: 5, MaxUnitsPerBidder: -1}, "config.MultiUnit.MaxUnitsPerBidder"},
+		{"unknown clearing rule", MultiUnitConfig{Units: 5, Clearing: "median"}, "config.MultiUnit.Clearing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.field == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got: %v", err)
+				}
+				return
+			}
+			var inputErr *InputError
+			if !errors.As(err, &inputErr) || inputErr.InputField != tt.field {
+				t.Fatalf("Expected an InputError for %s, got: %v", tt.field, err)
+			}
+			if !HasCode(err, CodeEngineMultiUnitInvalid) {
+				t.Errorf("Expected code %s, got %s", CodeEngineMultiUnitInvalid, CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestMultiUnitConfig_ClearingRule(t *testing.T) {
+	if got := (MultiUnitConfig{}).ClearingRule(); got != ClearingHighestLosing {
+		t.Errorf("Expected the default rule to be %s, got %s", ClearingHighestLosing, got)
+	}
+	if got := (MultiUnitConfig{Clearing: ClearingLowestWinning}).ClearingRule(); got != ClearingLowestWinning {
+		t.Errorf("Expected rule %s, got %s", ClearingLowestWinning, got)
+	}
+	config := NewAuctionConfigWithUnits(MultiUnitConfig{Units: 3})
+	if config.AuctionFormat() != FormatUniformPrice || config.MultiUnit.Units != 3 {
+		t.Errorf("Unexpected config: %+v", config)
+	}
+	config = NewAuctionConfigWithPayAsBid(MultiUnitConfig{Units: 3})
+	if config.AuctionFormat() != FormatPayAsBid || config.MultiUnit.Units != 3 {
+		t.Errorf("Unexpected config: %+v", config)
+	}
+}
+
+func TestMultiUnitConfig_UnitsFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    int
+		quantity int
+		expected int
+	}{
+		{"no limit", 0, 8, 8},
+		{"under the limit", 5, 3, 3},
+		{"at the limit", 5, 5, 5},
+		{"over the limit", 5, 8, 5},
+		{"default quantity", 5, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := MultiUnitConfig{Units: 10, MaxUnitsPerBidder: tt.limit}
+			if got := config.UnitsFor(&Bidder{ID: "alice", Quantity: tt.quantity}); got != tt.expected {
+				t.Errorf("Expected %d units, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMultiUnitResult_Allocation(t *testing.T) {
+	alice := Bidder{ID: "alice", Quantity: 3}
+	result := &MultiUnitResult{
+		Units:       5,
+		UnitsSold:   2,
+		Allocations: []UnitAllocation{{Bidder: &alice, Requested: 3, Units: 2, UnitPrice: Dollars(10), Amount: Dollars(20)}},
+	}
+
+	allocation, ok := result.Allocation("alice")
+	if !ok || allocation.Units != 2 || !allocation.IsPartial() {
+		t.Errorf("Expected Alice's partial allocation of 2 units, got %+v", allocation)
+	}
+	if _, ok := result.Allocation("bob"); ok {
+		t.Error("Expected Bob to have no allocation")
+	}
+	if result.UnitsUnsold() != 3 {
+		t.Errorf("Expected 3 units unsold, got %d", result.UnitsUnsold())
+	}
+}
+
+func TestMultiUnitResult_RevenueByWinner(t *testing.T) {
+	alice, bob := Bidder{ID: "alice"}, Bidder{ID: "bob"}
+	result := &MultiUnitResult{
+		Allocations: []UnitAllocation{
+			{Bidder: &alice, Requested: 2, Units: 2, UnitPrice: Dollars(50), Amount: Dollars(100)},
+			{Bidder: &bob, Requested: 3, Units: 3, UnitPrice: Dollars(40), Amount: Dollars(120)},
+		},
+	}
+
+	revenue := result.RevenueByWinner()
+	if len(revenue) != 2 || !revenue["alice"].Equal(Dollars(100)) || !revenue["bob"].Equal(Dollars(120)) {
+		t.Errorf("Expected Alice to owe 100.00 and Bob 120.00, got %v", revenue)
+	}
+	if len((&MultiUnitResult{}).RevenueByWinner()) != 0 {
+		t.Error("Expected no revenue without winners")
+	}
+}
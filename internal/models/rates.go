@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RateProvider supplies the exchange rates used to normalize bids into an auction's settlement currency
+type RateProvider interface {
+	// Rate returns the value of one major unit of from expressed in major units of to
+	Rate(from, to Currency) (*big.Rat, error)
+}
+
+// currencyPair identifies a directed exchange rate
+type currencyPair struct {
+	from Currency
+	to   Currency
+}
+
+// StaticRateProvider serves a fixed table of exchange rates
+// A rate registered in one direction is also used, inverted, for the opposite direction
+type StaticRateProvider struct {
+	rates map[currencyPair]*big.Rat
+}
+
+// NewStaticRateProvider creates a new StaticRateProvider with no rates
+func NewStaticRateProvider() *StaticRateProvider {
+	return &StaticRateProvider{rates: make(map[currencyPair]*big.Rat)}
+}
+
+// SetRate registers the exchange rate from one currency to another as an exact decimal string (e.g. "1.0850")
+func (p *StaticRateProvider) SetRate(from, to Currency, rate string) error {
+	parsed, ok := new(big.Rat).SetString(rate)
+	if !ok || parsed.Sign() <= 0 {
+		inputErr := NewInputError("exchange rate must be a positive decimal", "rate", rate)
+		inputErr.WithOperation("StaticRateProvider.SetRate")
+		inputErr.AddContext("from", string(from))
+		inputErr.AddContext("to", string(to))
+		return inputErr
+	}
+	p.rates[currencyPair{from: from, to: to}] = parsed
+	return nil
+}
+
+// Rate returns the registered rate, the inverse of the opposite rate, or 1 for identical currencies
+func (p *StaticRateProvider) Rate(from, to Currency) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+	if rate, ok := p.rates[currencyPair{from: from, to: to}]; ok {
+		return new(big.Rat).Set(rate), nil
+	}
+	if rate, ok := p.rates[currencyPair{from: to, to: from}]; ok {
+		return new(big.Rat).Inv(rate), nil
+	}
+	inputErr := NewInputError(fmt.Sprintf("no exchange rate from %s to %s", from, to), "currency", string(from))
+	inputErr.WithOperation("StaticRateProvider.Rate")
+	return nil, inputErr
+}
+
+// Convert returns the amount expressed in another currency at the given major-unit rate
+// The result is rounded half away from zero to the target currency's minor unit
+func (m Money) Convert(to Currency, rate *big.Rat) (Money, error) {
+	if m.Currency() == to {
+		return m, nil
+	}
+
+	scaled := new(big.Rat).Mul(new(big.Rat).SetInt64(m.amount), rate)
+	scaled.Mul(scaled, new(big.Rat).SetFrac64(to.minorFactor(), m.Currency().minorFactor()))
+
+	// Round half away from zero: truncate |x| + 1/2, then restore the sign
+	half := big.NewRat(1, 2)
+	abs := new(big.Rat).Abs(scaled)
+	abs.Add(abs, half)
+	rounded := new(big.Int).Quo(abs.Num(), abs.Denom())
+	if scaled.Sign() < 0 {
+		rounded.Neg(rounded)
+	}
+
+	if !rounded.IsInt64() {
+		inputErr := NewInputError("monetary amount overflow", "amount", m.amount)
+		inputErr.WithOperation("Money.Convert")
+		inputErr.AddContext("from", m.String())
+		inputErr.AddContext("to", string(to))
+		return Money{}, inputErr
+	}
+	return Money{amount: rounded.Int64(), currency: to}, nil
+}
+
+// ConvertWith converts the amount into another currency using a rate provider
+func (m Money) ConvertWith(provider RateProvider, to Currency) (Money, error) {
+	rate, err := provider.Rate(m.Currency(), to)
+	if err != nil {
+		return Money{}, err
+	}
+	return m.Convert(to, rate)
+}
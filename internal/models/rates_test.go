@@ -0,0 +1,106 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+)
+
+// TestStaticRateProvider_Rate tests direct, inverse, identity and missing rates
+func TestStaticRateProvider_Rate(t *testing.T) {
+	rates := NewStaticRateProvider()
+	if err := rates.SetRate(EUR, USD, "1.25"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		from, to Currency
+		expected *big.Rat
+	}{
+		{EUR, USD, big.NewRat(5, 4)},
+		{USD, EUR, big.NewRat(4, 5)},
+		{JPY, JPY, big.NewRat(1, 1)},
+	}
+	for _, tt := range tests {
+		rate, err := rates.Rate(tt.from, tt.to)
+		if err != nil {
+			t.Fatalf("Rate(%s, %s): expected no error, got: %v", tt.from, tt.to, err)
+		}
+		if rate.Cmp(tt.expected) != 0 {
+			t.Errorf("Rate(%s, %s) = %s, expected %s", tt.from, tt.to, rate.RatString(), tt.expected.RatString())
+		}
+	}
+
+	if _, err := rates.Rate(GBP, USD); err == nil {
+		t.Error("Expected error for missing rate")
+	} else if _, ok := err.(*InputError); !ok {
+		t.Errorf("Expected InputError, got %T", err)
+	}
+}
+
+// TestStaticRateProvider_SetRateInvalid tests that malformed and non-positive rates are rejected
+func TestStaticRateProvider_SetRateInvalid(t *testing.T) {
+	rates := NewStaticRateProvider()
+	for _, rate := range []string{"", "abc", "0", "-1.5"} {
+		if err := rates.SetRate(EUR, USD, rate); err == nil {
+			t.Errorf("Expected error for rate %q", rate)
+		}
+	}
+}
+
+// TestMoney_Convert tests conversion between currencies with different minor digits
+func TestMoney_Convert(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Money
+		to       Currency
+		rate     string
+		expected Money
+	}{
+		{"EUR to USD", NewMoney(10000, EUR), USD, "1.0850", NewMoney(10850, USD)},
+		{"JPY to USD", NewMoney(15000, JPY), USD, "0.0065", NewMoney(9750, USD)},
+		{"USD to JPY", NewMoney(9999, USD), JPY, "153.27", NewMoney(15325, JPY)},
+		{"USD to KWD", NewMoney(10000, USD), KWD, "0.3075", NewMoney(30750, KWD)},
+		{"KWD to JPY", NewMoney(1250, KWD), JPY, "498.4", NewMoney(623, JPY)},
+		{"rounds half away from zero", NewMoney(1, USD), EUR, "0.5", NewMoney(1, EUR)},
+		{"negative rounds half away from zero", NewMoney(-1, USD), EUR, "0.5", NewMoney(-1, EUR)},
+		{"same currency", NewMoney(1234, USD), USD, "2", NewMoney(1234, USD)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, _ := new(big.Rat).SetString(tt.rate)
+			converted, err := tt.amount.Convert(tt.to, rate)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if !converted.Equal(tt.expected) {
+				t.Errorf("Expected %s, got %s", tt.expected, converted)
+			}
+		})
+	}
+}
+
+// TestMoney_ConvertOverflow tests that conversions beyond the int64 range are reported
+func TestMoney_ConvertOverflow(t *testing.T) {
+	if _, err := NewMoney(1<<62, USD).Convert(JPY, big.NewRat(1000, 1)); err == nil {
+		t.Error("Expected overflow error")
+	}
+}
+
+// TestMoney_ConvertWith tests conversion through a rate provider
+func TestMoney_ConvertWith(t *testing.T) {
+	rates := NewStaticRateProvider()
+	_ = rates.SetRate(GBP, USD, "1.25")
+
+	converted, err := NewMoney(8000, USD).ConvertWith(rates, GBP)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !converted.Equal(NewMoney(6400, GBP)) {
+		t.Errorf("Expected 64.00 GBP, got %s", converted)
+	}
+
+	if _, err := NewMoney(100, KWD).ConvertWith(rates, USD); err == nil {
+		t.Error("Expected error for missing rate")
+	}
+}
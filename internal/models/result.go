@@ -1,58 +1,192 @@
 package models
 
+import "encoding/json"
+
 // BidResult represents the outcome of an auction bidding process
+// In a multi-currency auction WinningBid, ReservePrice and AllBidders are in the settlement
+// currency, while WinningBidLocal is the same price in the winner's own currency
 type BidResult struct {
-	Winner        *Bidder  `json:"winner"`         // Winning bidder
-	WinningBid    float64  `json:"winning_bid"`    // Final winning amount
-	TotalBidders  int      `json:"total_bidders"`  // Number of participants
-	BiddingRounds int      `json:"bidding_rounds"` // Number of increment rounds
-	AllBidders    []Bidder `json:"all_bidders"`    // Final state of all bidders
-
-	// Internal field for precise calculations
-	winningBidCents int64 // Winning bid in cents
+	Winner          *Bidder            `json:"winner"`                // Winning bidder
+	WinningBid      Money              `json:"winning_bid"`           // Final winning amount
+	WinningBidLocal Money              `json:"winning_bid_local"`     // Final winning amount in the winner's currency
+	TotalBidders    int                `json:"total_bidders"`         // Number of participants
+	BiddingRounds   int                `json:"bidding_rounds"`        // Number of increment rounds
+	AllBidders      []Bidder           `json:"all_bidders"`           // Final state of all bidders
+	ReservePrice    Money              `json:"reserve_price"`         // Reserve price configured for the auction
+	ReserveMet      bool               `json:"reserve_met"`           // Whether the top bidder's maximum met the reserve
+	Extensions      []CloseExtension   `json:"extensions,omitempty"`  // Soft-close extensions of a live auction, oldest first
+	TieBreak        TieBreakRule       `json:"tie_break,omitempty"`   // Rule that decided a tie for the top bid (empty when there was none)
+	AuditTrail      []AuditEntry       `json:"audit_trail,omitempty"` // Recorded increments in order, when the engine audits
+	Warnings        []*ValidationError `json:"warnings,omitempty"`    // Validation findings that did not fail the auction
+	Format          AuctionFormat      `json:"format,omitempty"`      // Rules the auction was settled under
 }
 
 // NewBidResult creates a new BidResult with the provided parameters
-func NewBidResult(winner *Bidder, winningBid float64, totalBidders, biddingRounds int, allBidders []Bidder) *BidResult {
-	result := &BidResult{
-		Winner:        winner,
-		WinningBid:    winningBid,
-		TotalBidders:  totalBidders,
-		BiddingRounds: biddingRounds,
-		AllBidders:    allBidders,
-	}
-
-	// Store precise winning bid in cents
-	result.winningBidCents = DollarsToCents(winningBid)
-
-	// Ensure all bidders have synced float fields
-	for i := range result.AllBidders {
-		result.AllBidders[i].SyncFloatFields()
-	}
-
-	return result
-}
-
-// NewBidResultFromCents creates a new BidResult with winning bid specified in cents
-func NewBidResultFromCents(winner *Bidder, winningBidCents int64, totalBidders, biddingRounds int, allBidders []Bidder) *BidResult {
-	result := &BidResult{
+func NewBidResult(winner *Bidder, winningBid Money, totalBidders, biddingRounds int, allBidders []Bidder) *BidResult {
+	return &BidResult{
 		Winner:          winner,
-		WinningBid:      CentsToDollars(winningBidCents),
+		WinningBid:      winningBid,
+		WinningBidLocal: winningBid,
 		TotalBidders:    totalBidders,
 		BiddingRounds:   biddingRounds,
 		AllBidders:      allBidders,
-		winningBidCents: winningBidCents,
+		ReservePrice:    NewMoney(0, winningBid.Currency()),
+		ReserveMet:      true,
 	}
+}
 
-	// Ensure all bidders have synced float fields
-	for i := range result.AllBidders {
-		result.AllBidders[i].SyncFloatFields()
+// NewBidResultFromCents creates a new BidResult with winning bid specified in minor units
+// of the winner's currency (DefaultCurrency when there is no winner)
+func NewBidResultFromCents(winner *Bidder, winningBidCents int64, totalBidders, biddingRounds int, allBidders []Bidder) *BidResult {
+	currency := DefaultCurrency
+	if winner != nil {
+		currency = winner.Currency()
+	} else if len(allBidders) > 0 {
+		currency = allBidders[0].Currency()
 	}
+	return NewBidResult(winner, NewMoney(winningBidCents, currency), totalBidders, biddingRounds, allBidders)
+}
+
+// NewBidResultInCurrency creates a new BidResult with winning bid specified in minor units of the given currency
+func NewBidResultInCurrency(winner *Bidder, winningBidCents int64, currency Currency, totalBidders, biddingRounds int, allBidders []Bidder) *BidResult {
+	return NewBidResult(winner, NewMoney(winningBidCents, currency), totalBidders, biddingRounds, allBidders)
+}
 
-	return result
+// Currency returns the currency the result's amounts are expressed in
+func (br *BidResult) Currency() Currency {
+	return br.WinningBid.Currency()
 }
 
-// GetWinningBidCents returns the winning bid in cents for precise calculations
+// GetWinningBidCents returns the winning bid in minor units for precise calculations
 func (br *BidResult) GetWinningBidCents() int64 {
-	return br.winningBidCents
+	return br.WinningBid.Amount()
+}
+
+// GetReservePriceCents returns the reserve price in minor units for precise calculations
+func (br *BidResult) GetReservePriceCents() int64 {
+	return br.ReservePrice.Amount()
+}
+
+// WithReserve records the auction's reserve price and whether it was met
+func (br *BidResult) WithReserve(reservePriceCents int64, met bool) *BidResult {
+	br.ReservePrice = NewMoney(reservePriceCents, br.Currency())
+	br.ReserveMet = met
+	return br
+}
+
+// LocalCurrency returns the currency of the winner's own bids
+func (br *BidResult) LocalCurrency() Currency {
+	return br.WinningBidLocal.Currency()
+}
+
+// WithLocalWinningBid records the winning bid in the winner's own currency
+func (br *BidResult) WithLocalWinningBid(local Money) *BidResult {
+	br.WinningBidLocal = local
+	return br
+}
+
+// Clone returns a copy of the result that shares no mutable state with the original
+func (br *BidResult) Clone() *BidResult {
+	if br == nil {
+		return nil
+	}
+	clone := *br
+	if br.Winner != nil {
+		winner := *br.Winner
+		clone.Winner = &winner
+	}
+	if br.AllBidders != nil {
+		clone.AllBidders = append([]Bidder(nil), br.AllBidders...)
+	}
+	if br.Extensions != nil {
+		clone.Extensions = append([]CloseExtension(nil), br.Extensions...)
+	}
+	if br.AuditTrail != nil {
+		clone.AuditTrail = append([]AuditEntry(nil), br.AuditTrail...)
+	}
+	if br.Warnings != nil {
+		clone.Warnings = make([]*ValidationError, len(br.Warnings))
+		for i, warning := range br.Warnings {
+			copied := *warning
+			clone.Warnings[i] = &copied
+		}
+	}
+	return &clone
+}
+
+// bidResultJSON is the wire format of a BidResult: amounts are plain numbers in major units
+// (as they were before Money was introduced) plus a currency code
+type bidResultJSON struct {
+	Winner          *Bidder            `json:"winner"`
+	Currency        Currency           `json:"currency"`
+	WinningBid      float64            `json:"winning_bid"`
+	LocalCurrency   Currency           `json:"local_currency"`
+	WinningBidLocal float64            `json:"winning_bid_local"`
+	TotalBidders    int                `json:"total_bidders"`
+	BiddingRounds   int                `json:"bidding_rounds"`
+	AllBidders      []Bidder           `json:"all_bidders"`
+	ReservePrice    float64            `json:"reserve_price"`
+	ReserveMet      bool               `json:"reserve_met"`
+	Extensions      []CloseExtension   `json:"extensions,omitempty"`
+	TieBreak        TieBreakRule       `json:"tie_break,omitempty"`
+	AuditTrail      []AuditEntry       `json:"audit_trail,omitempty"`
+	Warnings        []*ValidationError `json:"warnings,omitempty"`
+	Format          AuctionFormat      `json:"format,omitempty"`
+}
+
+// MarshalJSON encodes the result using the backward compatible numeric amount fields
+func (br BidResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bidResultJSON{
+		Winner:          br.Winner,
+		Currency:        br.Currency(),
+		WinningBid:      br.WinningBid.Float64(),
+		LocalCurrency:   br.LocalCurrency(),
+		WinningBidLocal: br.WinningBidLocal.Float64(),
+		TotalBidders:    br.TotalBidders,
+		BiddingRounds:   br.BiddingRounds,
+		AllBidders:      br.AllBidders,
+		ReservePrice:    br.ReservePrice.Float64(),
+		ReserveMet:      br.ReserveMet,
+		Extensions:      br.Extensions,
+		TieBreak:        br.TieBreak,
+		AuditTrail:      br.AuditTrail,
+		Warnings:        br.Warnings,
+		Format:          br.Format,
+	})
+}
+
+// UnmarshalJSON decodes numeric amount fields; a missing currency defaults to DefaultCurrency
+// and a missing local winning bid defaults to the settlement winning bid
+func (br *BidResult) UnmarshalJSON(data []byte) error {
+	var decoded bidResultJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	currency := decoded.Currency
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	winningBid := MoneyFromMajor(decoded.WinningBid, currency)
+	winningBidLocal := winningBid
+	if decoded.LocalCurrency != "" {
+		winningBidLocal = MoneyFromMajor(decoded.WinningBidLocal, decoded.LocalCurrency)
+	}
+
+	*br = BidResult{
+		Winner:          decoded.Winner,
+		WinningBid:      winningBid,
+		WinningBidLocal: winningBidLocal,
+		TotalBidders:    decoded.TotalBidders,
+		BiddingRounds:   decoded.BiddingRounds,
+		AllBidders:      decoded.AllBidders,
+		ReservePrice:    MoneyFromMajor(decoded.ReservePrice, currency),
+		ReserveMet:      decoded.ReserveMet,
+		Extensions:      decoded.Extensions,
+		TieBreak:        decoded.TieBreak,
+		AuditTrail:      decoded.AuditTrail,
+		Warnings:        decoded.Warnings,
+		Format:          decoded.Format,
+	}
+	return nil
 }
@@ -1,24 +1,26 @@
 package models
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 )
 
 // TestNewBidResult tests the NewBidResult constructor
 func TestNewBidResult(t *testing.T) {
-	winner := NewBidder("1", "Alice", 10.00, 20.00, 5.00)
+	winner := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00))
 	winningBid := 15.50
 	totalBidders := 3
 	biddingRounds := 5
 
 	allBidders := []Bidder{
 		*winner,
-		*NewBidder("2", "Bob", 12.00, 18.00, 3.00),
-		*NewBidder("3", "Charlie", 8.00, 16.00, 2.00),
+		*NewBidder("2", "Bob", Dollars(12.00), Dollars(18.00), Dollars(3.00)),
+		*NewBidder("3", "Charlie", Dollars(8.00), Dollars(16.00), Dollars(2.00)),
 	}
 
-	result := NewBidResult(winner, winningBid, totalBidders, biddingRounds, allBidders)
+	result := NewBidResult(winner, Dollars(winningBid), totalBidders, biddingRounds, allBidders)
 
 	if result == nil {
 		t.Fatal("Expected result, got nil")
@@ -32,8 +34,8 @@ func TestNewBidResult(t *testing.T) {
 		t.Errorf("Expected winner ID '%s', got '%s'", winner.ID, result.Winner.ID)
 	}
 
-	if result.WinningBid != winningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", winningBid, result.WinningBid)
+	if result.WinningBid.Float64() != winningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", winningBid, result.WinningBid.Float64())
 	}
 
 	if result.TotalBidders != totalBidders {
@@ -57,22 +59,22 @@ func TestNewBidResult(t *testing.T) {
 	// Test that all bidders have synced float fields
 	for i, bidder := range result.AllBidders {
 		expectedCurrentBid := CentsToDollars(bidder.GetCurrentBidCents())
-		if bidder.CurrentBid != expectedCurrentBid {
-			t.Errorf("Bidder %d: expected current bid %.2f, got %.2f", i, expectedCurrentBid, bidder.CurrentBid)
+		if bidder.CurrentBid.Float64() != expectedCurrentBid {
+			t.Errorf("Bidder %d: expected current bid %.2f, got %.2f", i, expectedCurrentBid, bidder.CurrentBid.Float64())
 		}
 	}
 }
 
 // TestNewBidResultFromCents tests the NewBidResultFromCents constructor
 func TestNewBidResultFromCents(t *testing.T) {
-	winner := NewBidder("1", "Alice", 10.00, 20.00, 5.00)
+	winner := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00))
 	winningBidCents := int64(1550) // 15.50 in cents
 	totalBidders := 2
 	biddingRounds := 3
 
 	allBidders := []Bidder{
 		*winner,
-		*NewBidder("2", "Bob", 12.00, 18.00, 3.00),
+		*NewBidder("2", "Bob", Dollars(12.00), Dollars(18.00), Dollars(3.00)),
 	}
 
 	result := NewBidResultFromCents(winner, winningBidCents, totalBidders, biddingRounds, allBidders)
@@ -90,8 +92,8 @@ func TestNewBidResultFromCents(t *testing.T) {
 	}
 
 	expectedWinningBid := CentsToDollars(winningBidCents)
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	if result.TotalBidders != totalBidders {
@@ -121,7 +123,7 @@ func TestBidResult_WinnerCheck(t *testing.T) {
 	}{
 		{
 			name:     "Has winner",
-			winner:   NewBidder("1", "Alice", 10.00, 20.00, 5.00),
+			winner:   NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00)),
 			expected: true,
 		},
 		{
@@ -133,7 +135,7 @@ func TestBidResult_WinnerCheck(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := NewBidResult(tt.winner, 15.00, 1, 0, []Bidder{})
+			result := NewBidResult(tt.winner, Dollars(15.00), 1, 0, []Bidder{})
 
 			hasWinner := result.Winner != nil
 			if hasWinner != tt.expected {
@@ -174,8 +176,8 @@ func TestBidResult_GetWinningBidCents(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			winner := NewBidder("1", "Alice", 10.00, 20.00, 5.00)
-			result := NewBidResult(winner, tt.winningBid, 1, 0, []Bidder{*winner})
+			winner := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00))
+			result := NewBidResult(winner, Dollars(tt.winningBid), 1, 0, []Bidder{*winner})
 
 			cents := result.GetWinningBidCents()
 			if cents != tt.expectedCents {
@@ -188,7 +190,7 @@ func TestBidResult_GetWinningBidCents(t *testing.T) {
 // TestBidResult_NoWinnerScenario tests result with no winner
 func TestBidResult_NoWinnerScenario(t *testing.T) {
 	// Create a result with no winner (empty auction)
-	result := NewBidResult(nil, 0.0, 0, 0, []Bidder{})
+	result := NewBidResult(nil, Dollars(0.0), 0, 0, []Bidder{})
 
 	if result == nil {
 		t.Fatal("Expected result, got nil")
@@ -198,8 +200,8 @@ func TestBidResult_NoWinnerScenario(t *testing.T) {
 		t.Error("Expected winner to be nil")
 	}
 
-	if result.WinningBid != 0.0 {
-		t.Errorf("Expected winning bid 0.0, got %.2f", result.WinningBid)
+	if result.WinningBid.Float64() != 0.0 {
+		t.Errorf("Expected winning bid 0.0, got %.2f", result.WinningBid.Float64())
 	}
 
 	if result.GetWinningBidCents() != 0 {
@@ -220,9 +222,9 @@ func TestBidResult_ComplexScenario(t *testing.T) {
 	baseTime := time.Now()
 
 	// Create multiple bidders with different states
-	alice := NewBidder("1", "Alice", 100.00, 500.00, 50.00)
-	bob := NewBidder("2", "Bob", 110.00, 450.00, 40.00)
-	charlie := NewBidder("3", "Charlie", 90.00, 300.00, 30.00)
+	alice := NewBidder("1", "Alice", Dollars(100.00), Dollars(500.00), Dollars(50.00))
+	bob := NewBidder("2", "Bob", Dollars(110.00), Dollars(450.00), Dollars(40.00))
+	charlie := NewBidder("3", "Charlie", Dollars(90.00), Dollars(300.00), Dollars(30.00))
 
 	// Set entry times
 	alice.EntryTime = baseTime
@@ -238,7 +240,7 @@ func TestBidResult_ComplexScenario(t *testing.T) {
 	allBidders := []Bidder{*alice, *bob, *charlie}
 	winningBid := 470.00 // Alice wins, pays Bob's max + her increment
 
-	result := NewBidResult(alice, winningBid, 3, 8, allBidders)
+	result := NewBidResult(alice, Dollars(winningBid), 3, 8, allBidders)
 
 	// Verify result structure
 	if result.Winner == nil {
@@ -249,8 +251,8 @@ func TestBidResult_ComplexScenario(t *testing.T) {
 		t.Errorf("Expected winner '1', got '%s'", result.Winner.ID)
 	}
 
-	if result.WinningBid != winningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", winningBid, result.WinningBid)
+	if result.WinningBid.Float64() != winningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", winningBid, result.WinningBid.Float64())
 	}
 
 	if result.TotalBidders != 3 {
@@ -268,27 +270,27 @@ func TestBidResult_ComplexScenario(t *testing.T) {
 	// Verify that all bidders have proper float field sync
 	for i, bidder := range result.AllBidders {
 		expectedCurrent := CentsToDollars(bidder.GetCurrentBidCents())
-		if bidder.CurrentBid != expectedCurrent {
+		if bidder.CurrentBid.Float64() != expectedCurrent {
 			t.Errorf("Bidder %d: current bid not synced, expected %.2f, got %.2f",
-				i, expectedCurrent, bidder.CurrentBid)
+				i, expectedCurrent, bidder.CurrentBid.Float64())
 		}
 
 		expectedStarting := CentsToDollars(bidder.GetStartingBidCents())
-		if bidder.StartingBid != expectedStarting {
+		if bidder.StartingBid.Float64() != expectedStarting {
 			t.Errorf("Bidder %d: starting bid not synced, expected %.2f, got %.2f",
-				i, expectedStarting, bidder.StartingBid)
+				i, expectedStarting, bidder.StartingBid.Float64())
 		}
 
 		expectedMax := CentsToDollars(bidder.GetMaxBidCents())
-		if bidder.MaxBid != expectedMax {
+		if bidder.MaxBid.Float64() != expectedMax {
 			t.Errorf("Bidder %d: max bid not synced, expected %.2f, got %.2f",
-				i, expectedMax, bidder.MaxBid)
+				i, expectedMax, bidder.MaxBid.Float64())
 		}
 
 		expectedIncrement := CentsToDollars(bidder.GetAutoIncrementCents())
-		if bidder.AutoIncrement != expectedIncrement {
+		if bidder.AutoIncrement.Float64() != expectedIncrement {
 			t.Errorf("Bidder %d: auto increment not synced, expected %.2f, got %.2f",
-				i, expectedIncrement, bidder.AutoIncrement)
+				i, expectedIncrement, bidder.AutoIncrement.Float64())
 		}
 	}
 
@@ -301,12 +303,12 @@ func TestBidResult_ComplexScenario(t *testing.T) {
 
 // TestBidResult_PrecisionConsistency tests precision consistency between constructors
 func TestBidResult_PrecisionConsistency(t *testing.T) {
-	winner := NewBidder("1", "Alice", 10.01, 20.99, 0.33)
+	winner := NewBidder("1", "Alice", Dollars(10.01), Dollars(20.99), Dollars(0.33))
 	winningBid := 15.67
 	allBidders := []Bidder{*winner}
 
 	// Create result using float constructor
-	result1 := NewBidResult(winner, winningBid, 1, 0, allBidders)
+	result1 := NewBidResult(winner, Dollars(winningBid), 1, 0, allBidders)
 
 	// Create result using cents constructor
 	winningBidCents := DollarsToCents(winningBid)
@@ -319,9 +321,9 @@ func TestBidResult_PrecisionConsistency(t *testing.T) {
 	}
 
 	// Both should have the same dollar value (within precision tolerance)
-	if result1.WinningBid != result2.WinningBid {
+	if result1.WinningBid.Float64() != result2.WinningBid.Float64() {
 		t.Errorf("Dollar mismatch: result1=%.2f, result2=%.2f",
-			result1.WinningBid, result2.WinningBid)
+			result1.WinningBid.Float64(), result2.WinningBid.Float64())
 	}
 
 	// Both should have same winner status
@@ -331,3 +333,183 @@ func TestBidResult_PrecisionConsistency(t *testing.T) {
 		t.Error("Winner status mismatch between constructors")
 	}
 }
+
+// TestBidResult_WithReserve tests recording reserve information on a result
+func TestBidResult_WithReserve(t *testing.T) {
+	result := NewBidResultFromCents(nil, 0, 0, 0, nil)
+
+	if !result.ReserveMet {
+		t.Error("Expected results without a reserve to report the reserve as met")
+	}
+
+	result.WithReserve(12550, false)
+
+	if result.ReserveMet {
+		t.Error("Expected reserve to be reported as not met")
+	}
+	if result.GetReservePriceCents() != 12550 {
+		t.Errorf("Expected reserve price cents 12550, got %d", result.GetReservePriceCents())
+	}
+	if result.ReservePrice.Float64() != 125.50 {
+		t.Errorf("Expected reserve price 125.50, got %.2f", result.ReservePrice.Float64())
+	}
+}
+
+// TestBidResult_JSONBackwardCompatible tests that results still encode amounts as plain numbers
+func TestBidResult_JSONBackwardCompatible(t *testing.T) {
+	winner := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00))
+	result := NewBidResult(winner, Dollars(15.50), 1, 0, []Bidder{*winner})
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if fields["winning_bid"] != 15.5 || fields["currency"] != "USD" {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+
+	var decoded BidResult
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !decoded.WinningBid.Equal(Dollars(15.50)) {
+		t.Errorf("Expected winning bid 15.50 USD, got %s", decoded.WinningBid)
+	}
+	if decoded.Winner == nil || !decoded.Winner.MaxBid.Equal(Dollars(20.00)) {
+		t.Errorf("Expected winner to round trip, got %+v", decoded.Winner)
+	}
+}
+
+// TestBidResult_WithLocalWinningBid tests reporting the winning bid in the winner's currency
+func TestBidResult_WithLocalWinningBid(t *testing.T) {
+	winner := NewBidder("1", "Alice", Dollars(110.00), Dollars(220.00), Dollars(11.00))
+	result := NewBidResult(winner, Dollars(206.00), 1, 0, []Bidder{*winner})
+
+	if !result.WinningBidLocal.Equal(result.WinningBid) {
+		t.Errorf("Expected local winning bid to default to the winning bid, got %s", result.WinningBidLocal)
+	}
+
+	result.WithLocalWinningBid(NewMoney(18727, EUR))
+	if result.LocalCurrency() != EUR || result.Currency() != USD {
+		t.Errorf("Expected EUR local and USD settlement, got %s and %s", result.LocalCurrency(), result.Currency())
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var decoded BidResult
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !decoded.WinningBidLocal.Equal(NewMoney(18727, EUR)) || !decoded.WinningBid.Equal(Dollars(206.00)) {
+		t.Errorf("Expected amounts to round trip, got %s and %s", decoded.WinningBidLocal, decoded.WinningBid)
+	}
+
+	var legacy BidResult
+	if err := json.Unmarshal([]byte(`{"winning_bid":12.5}`), &legacy); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !legacy.WinningBidLocal.Equal(Dollars(12.50)) {
+		t.Errorf("Expected missing local bid to default to the winning bid, got %s", legacy.WinningBidLocal)
+	}
+}
+
+// TestBidResult_TieBreakJSON tests that the deciding tie-break rule and the format round trip, and the rule is omitted when unset
+func TestBidResult_TieBreakJSON(t *testing.T) {
+	winner := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00))
+	result := NewBidResult(winner, Dollars(15.50), 1, 0, []Bidder{*winner})
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if strings.Contains(string(data), "tie_break") {
+		t.Errorf("Expected no tie_break field without a tie, got %s", data)
+	}
+
+	result.TieBreak = TieBreakHighestMaxBid
+	result.Format = FormatFirstPriceSealed
+	data, err = json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var decoded BidResult
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if decoded.TieBreak != TieBreakHighestMaxBid {
+		t.Errorf("Expected tie-break rule to round trip, got %q", decoded.TieBreak)
+	}
+	if decoded.Format != FormatFirstPriceSealed {
+		t.Errorf("Expected format to round trip, got %q", decoded.Format)
+	}
+}
+
+// TestBidResult_Clone tests that a clone can be modified without affecting the original
+func TestBidResult_Clone(t *testing.T) {
+	winner := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00))
+	result := NewBidResult(winner, Dollars(15.00), 1, 1, []Bidder{*winner})
+	result.AuditTrail = []AuditEntry{{Round: 1, BidderID: "1", PreviousCents: 1000, NewCents: 1500, LeaderID: "1"}}
+	result.Warnings = []*ValidationError{NewValidationErrorWithValue("1", "Name", "short name", "Alice")}
+
+	clone := result.Clone()
+	clone.Winner.Name = "Mallory"
+	clone.AllBidders[0].Name = "Mallory"
+	clone.AuditTrail[0].LeaderID = "2"
+	clone.Warnings[0].Message = "changed"
+	clone.WinningBid = Dollars(1.00)
+
+	if result.Winner.Name != "Alice" || result.AllBidders[0].Name != "Alice" {
+		t.Error("Expected the original bidders to be unchanged")
+	}
+	if result.AuditTrail[0].LeaderID != "1" || !result.WinningBid.Equal(Dollars(15.00)) {
+		t.Error("Expected the original trail and winning bid to be unchanged")
+	}
+	if result.Warnings[0].Message != "short name" {
+		t.Error("Expected the original warnings to be unchanged")
+	}
+
+	var missing *BidResult
+	if missing.Clone() != nil {
+		t.Error("Expected a nil result to clone to nil")
+	}
+}
+
+// TestBidResult_WarningsJSON tests that warnings round trip and are omitted when there are none
+func TestBidResult_WarningsJSON(t *testing.T) {
+	winner := NewBidder("1", "Alice", Dollars(10.00), Dollars(20.00), Dollars(5.00))
+	result := NewBidResult(winner, Dollars(15.00), 1, 0, []Bidder{*winner})
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if strings.Contains(string(data), "warnings") {
+		t.Errorf("Expected no warnings field without warnings, got %s", data)
+	}
+
+	warning := NewLocalizedValidationError("1", "MaxBid", MsgBidAboveCap, "20.00", "15.00").WithCode(CodeBidMaxAboveCap).WithPosition(1)
+	warning.Rule = "max_bid_cap"
+	result.Warnings = []*ValidationError{warning}
+	data, err = json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	var decoded BidResult
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(decoded.Warnings) != 1 {
+		t.Fatalf("Expected 1 warning, got %d", len(decoded.Warnings))
+	}
+	got := decoded.Warnings[0]
+	if got.Code != CodeBidMaxAboveCap || got.Rule != "max_bid_cap" || got.Position != 1 || got.Limit != "15.00" {
+		t.Errorf("Expected the warning to round trip, got %+v", got)
+	}
+}
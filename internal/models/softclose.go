@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// SoftClosePolicy extends a live auction when a bid takes the lead shortly before it closes,
+// giving other bidders a chance to respond. The zero value disables soft close.
+type SoftClosePolicy struct {
+	Window    time.Duration `json:"window"`    // A leader change this close to the end triggers an extension
+	Extension time.Duration `json:"extension"` // How far each trigger pushes the close time back
+	HardCap   time.Duration `json:"hard_cap"`  // Latest close relative to the original close time (0 means no cap)
+}
+
+// NewSoftClosePolicy creates a new SoftClosePolicy without a hard cap
+func NewSoftClosePolicy(window, extension time.Duration) SoftClosePolicy {
+	return SoftClosePolicy{Window: window, Extension: extension}
+}
+
+// Enabled returns true if the policy can extend an auction
+func (p SoftClosePolicy) Enabled() bool {
+	return p.Window > 0 && p.Extension > 0
+}
+
+// Extend returns the close time after a leader change at bidTime, and whether it moved
+// The close time is pushed back by Extension but never beyond originalClose plus HardCap
+func (p SoftClosePolicy) Extend(originalClose, currentClose, bidTime time.Time) (time.Time, bool) {
+	if !p.Enabled() || !bidTime.Before(currentClose) || currentClose.Sub(bidTime) > p.Window {
+		return currentClose, false
+	}
+
+	extended := currentClose.Add(p.Extension)
+	if p.HardCap > 0 {
+		if limit := originalClose.Add(p.HardCap); extended.After(limit) {
+			extended = limit
+		}
+	}
+	if !extended.After(currentClose) {
+		return currentClose, false
+	}
+	return extended, true
+}
+
+// CloseExtension records one soft-close extension of a live auction
+type CloseExtension struct {
+	BidderID      string    `json:"bidder_id"`      // Bidder whose bid took the lead
+	BidTime       time.Time `json:"bid_time"`       // When the triggering bid arrived
+	PreviousClose time.Time `json:"previous_close"` // Close time before the extension
+	NewClose      time.Time `json:"new_close"`      // Close time after the extension
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// TestSoftClosePolicy_Extend tests when a leader change extends the close time
+func TestSoftClosePolicy_Extend(t *testing.T) {
+	original := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy := NewSoftClosePolicy(5*time.Minute, 2*time.Minute)
+
+	tests := []struct {
+		name         string
+		policy       SoftClosePolicy
+		currentClose time.Time
+		bidTime      time.Time
+		expected     time.Time
+		extended     bool
+	}{
+		{"before window", policy, original, original.Add(-6 * time.Minute), original, false},
+		{"window boundary", policy, original, original.Add(-5 * time.Minute), original.Add(2 * time.Minute), true},
+		{"inside window", policy, original, original.Add(-time.Second), original.Add(2 * time.Minute), true},
+		{"at close", policy, original, original, original, false},
+		{"after previous extension", policy, original.Add(2 * time.Minute), original.Add(time.Minute), original.Add(4 * time.Minute), true},
+		{"disabled", SoftClosePolicy{}, original, original.Add(-time.Second), original, false},
+		{"capped", SoftClosePolicy{Window: 5 * time.Minute, Extension: 2 * time.Minute, HardCap: 3 * time.Minute}, original.Add(2 * time.Minute), original.Add(time.Minute), original.Add(3 * time.Minute), true},
+		{"at cap", SoftClosePolicy{Window: 5 * time.Minute, Extension: 2 * time.Minute, HardCap: 3 * time.Minute}, original.Add(3 * time.Minute), original.Add(2 * time.Minute), original.Add(3 * time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, extended := tt.policy.Extend(original, tt.currentClose, tt.bidTime)
+			if extended != tt.extended || !got.Equal(tt.expected) {
+				t.Errorf("Expected (%v, %v), got (%v, %v)", tt.expected, tt.extended, got, extended)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package models
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"sync/atomic"
+)
+
+// TieBreakRule names the rule that decided between bidders tied on their final bid
+type TieBreakRule string
+
+const (
+	TieBreakNone             TieBreakRule = ""                  // No tie at the top
+	TieBreakEarliestEntry    TieBreakRule = "earliest_entry"    // Earliest EntryTime won
+	TieBreakEarliestSequence TieBreakRule = "earliest_sequence" // Lowest Sequence won
+	TieBreakHighestMaxBid    TieBreakRule = "highest_max_bid"   // Highest MaxBid won
+	TieBreakRandom           TieBreakRule = "random"            // Seeded random draw won
+	TieBreakInputOrder       TieBreakRule = "input_order"       // No rule separated the bidders; the first in input order won
+)
+
+// TieBreaker ranks two bidders that are tied on their current bid
+type TieBreaker interface {
+	// Rule returns the name recorded in the result when this tie-breaker decides a tie
+	Rule() TieBreakRule
+	// Compare returns a negative number if a should win the tie, a positive number if b should,
+	// and zero if the tie-breaker cannot separate them
+	Compare(a, b *Bidder) int
+}
+
+// earliestEntry prefers the bidder who entered first
+type earliestEntry struct{}
+
+// NewEarliestEntryTieBreaker creates a TieBreaker that prefers the earliest EntryTime
+func NewEarliestEntryTieBreaker() TieBreaker {
+	return earliestEntry{}
+}
+
+func (earliestEntry) Rule() TieBreakRule {
+	return TieBreakEarliestEntry
+}
+
+func (earliestEntry) Compare(a, b *Bidder) int {
+	return a.EntryTime.Compare(b.EntryTime)
+}
+
+// earliestSequence prefers the bidder ingested first
+type earliestSequence struct{}
+
+// NewEarliestSequenceTieBreaker creates a TieBreaker that prefers the lowest Sequence
+func NewEarliestSequenceTieBreaker() TieBreaker {
+	return earliestSequence{}
+}
+
+func (earliestSequence) Rule() TieBreakRule {
+	return TieBreakEarliestSequence
+}
+
+func (earliestSequence) Compare(a, b *Bidder) int {
+	switch {
+	case a.Sequence < b.Sequence:
+		return -1
+	case a.Sequence > b.Sequence:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// highestMaxBid prefers the bidder willing to pay the most
+type highestMaxBid struct{}
+
+// NewHighestMaxBidTieBreaker creates a TieBreaker that prefers the highest MaxBid
+func NewHighestMaxBidTieBreaker() TieBreaker {
+	return highestMaxBid{}
+}
+
+func (highestMaxBid) Rule() TieBreakRule {
+	return TieBreakHighestMaxBid
+}
+
+func (highestMaxBid) Compare(a, b *Bidder) int {
+	switch {
+	case a.GetMaxBidCents() > b.GetMaxBidCents():
+		return -1
+	case a.GetMaxBidCents() < b.GetMaxBidCents():
+		return 1
+	default:
+		return 0
+	}
+}
+
+// seededRandom orders bidders by a hash of the seed and their ID
+type seededRandom struct {
+	seed int64
+}
+
+// NewSeededRandomTieBreaker creates a TieBreaker that picks a pseudo-random winner
+// The draw depends only on the seed and the bidders' IDs, so the same auction always
+// resolves the same way regardless of input order
+func NewSeededRandomTieBreaker(seed int64) TieBreaker {
+	return seededRandom{seed: seed}
+}
+
+func (seededRandom) Rule() TieBreakRule {
+	return TieBreakRandom
+}
+
+func (r seededRandom) Compare(a, b *Bidder) int {
+	ka, kb := r.key(a.ID), r.key(b.ID)
+	switch {
+	case ka < kb:
+		return -1
+	case ka > kb:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// key returns the bidder's draw for this seed
+func (r seededRandom) key(id string) uint64 {
+	hash := fnv.New64a()
+	var seed [8]byte
+	binary.LittleEndian.PutUint64(seed[:], uint64(r.seed))
+	hash.Write(seed[:])
+	hash.Write([]byte(id))
+	return hash.Sum64()
+}
+
+// BreakTie picks the winner among bidders tied on their current bid and returns the rule that decided
+// The primary tie-breaker is applied first, then earliest entry and earliest sequence; if none of
+// them separates the bidders the first in slice order wins. A single bidder is not a tie.
+func BreakTie(tied []*Bidder, primary TieBreaker) (*Bidder, TieBreakRule) {
+	if len(tied) == 0 {
+		return nil, TieBreakNone
+	}
+	if len(tied) == 1 {
+		return tied[0], TieBreakNone
+	}
+	if primary == nil {
+		primary = NewEarliestEntryTieBreaker()
+	}
+
+	candidates := tied
+	for _, breaker := range []TieBreaker{primary, NewEarliestEntryTieBreaker(), NewEarliestSequenceTieBreaker()} {
+		candidates = bestBy(candidates, breaker)
+		if len(candidates) == 1 {
+			return candidates[0], breaker.Rule()
+		}
+	}
+	return candidates[0], TieBreakInputOrder
+}
+
+// bestBy returns the candidates that no other candidate beats, in their original order
+func bestBy(candidates []*Bidder, breaker TieBreaker) []*Bidder {
+	best := []*Bidder{candidates[0]}
+	for _, candidate := range candidates[1:] {
+		switch cmp := breaker.Compare(candidate, best[0]); {
+		case cmp < 0:
+			best = []*Bidder{candidate}
+		case cmp == 0:
+			best = append(best, candidate)
+		}
+	}
+	return best
+}
+
+// Sequencer hands out monotonically increasing sequence numbers to bids as they are ingested
+// The zero value is ready to use and it is safe for concurrent use
+type Sequencer struct {
+	last atomic.Uint64
+}
+
+// NewSequencer creates a Sequencer whose first number follows last
+func NewSequencer(last uint64) *Sequencer {
+	sequencer := &Sequencer{}
+	sequencer.last.Store(last)
+	return sequencer
+}
+
+// Next returns the next sequence number, starting at 1
+func (s *Sequencer) Next() uint64 {
+	return s.last.Add(1)
+}
+
+// Assign numbers every bidder that has no sequence number yet, in slice order
+func (s *Sequencer) Assign(bidders []Bidder) {
+	for i := range bidders {
+		if bidders[i].Sequence == 0 {
+			bidders[i].Sequence = s.Next()
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package models
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// tiedBidders returns three bidders with equal bids and distinct entry times, sequences and maxima
+func tiedBidders() []*Bidder {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	alice := &Bidder{ID: "alice", MaxBid: Dollars(150), EntryTime: base, Sequence: 3}
+	bob := &Bidder{ID: "bob", MaxBid: Dollars(200), EntryTime: base.Add(time.Second), Sequence: 1}
+	carol := &Bidder{ID: "carol", MaxBid: Dollars(175), EntryTime: base.Add(2 * time.Second), Sequence: 2}
+	return []*Bidder{alice, bob, carol}
+}
+
+func TestBreakTie_Rules(t *testing.T) {
+	tests := []struct {
+		name       string
+		breaker    TieBreaker
+		expectedID string
+		expected   TieBreakRule
+	}{
+		{"earliest entry", NewEarliestEntryTieBreaker(), "alice", TieBreakEarliestEntry},
+		{"earliest sequence", NewEarliestSequenceTieBreaker(), "bob", TieBreakEarliestSequence},
+		{"highest max bid", NewHighestMaxBidTieBreaker(), "bob", TieBreakHighestMaxBid},
+		{"default", nil, "alice", TieBreakEarliestEntry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winner, rule := BreakTie(tiedBidders(), tt.breaker)
+			if winner.ID != tt.expectedID {
+				t.Errorf("Expected %s to win, got %s", tt.expectedID, winner.ID)
+			}
+			if rule != tt.expected {
+				t.Errorf("Expected rule %q, got %q", tt.expected, rule)
+			}
+		})
+	}
+}
+
+// TestBreakTie_FallsBack tests that an undecided primary rule falls back to entry time,
+// then sequence, then input order
+func TestBreakTie_FallsBack(t *testing.T) {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	first := &Bidder{ID: "first", MaxBid: Dollars(150), EntryTime: base.Add(time.Second), Sequence: 1}
+	second := &Bidder{ID: "second", MaxBid: Dollars(150), EntryTime: base, Sequence: 2}
+
+	winner, rule := BreakTie([]*Bidder{first, second}, NewHighestMaxBidTieBreaker())
+	if winner.ID != "second" || rule != TieBreakEarliestEntry {
+		t.Errorf("Expected earliest entry to decide, got %s by %q", winner.ID, rule)
+	}
+
+	second.EntryTime = first.EntryTime
+	winner, rule = BreakTie([]*Bidder{second, first}, NewHighestMaxBidTieBreaker())
+	if winner.ID != "first" || rule != TieBreakEarliestSequence {
+		t.Errorf("Expected earliest sequence to decide, got %s by %q", winner.ID, rule)
+	}
+
+	second.Sequence = first.Sequence
+	winner, rule = BreakTie([]*Bidder{second, first}, NewHighestMaxBidTieBreaker())
+	if winner.ID != "second" || rule != TieBreakInputOrder {
+		t.Errorf("Expected input order to decide, got %s by %q", winner.ID, rule)
+	}
+}
+
+func TestBreakTie_NoTie(t *testing.T) {
+	if winner, rule := BreakTie(nil, nil); winner != nil || rule != TieBreakNone {
+		t.Errorf("Expected no winner and no rule, got %v by %q", winner, rule)
+	}
+
+	only := &Bidder{ID: "only"}
+	if winner, rule := BreakTie([]*Bidder{only}, NewHighestMaxBidTieBreaker()); winner != only || rule != TieBreakNone {
+		t.Errorf("Expected the single bidder without a rule, got %v by %q", winner, rule)
+	}
+}
+
+// TestSeededRandomTieBreaker tests that the draw is reproducible for a seed, independent of
+// input order, and varies with the seed
+func TestSeededRandomTieBreaker(t *testing.T) {
+	bidders := tiedBidders()
+	reversed := []*Bidder{bidders[2], bidders[1], bidders[0]}
+
+	winners := make(map[string]bool)
+	for seed := int64(0); seed < 50; seed++ {
+		breaker := NewSeededRandomTieBreaker(seed)
+		winner, rule := BreakTie(bidders, breaker)
+		if rule != TieBreakRandom {
+			t.Fatalf("Expected rule %q, got %q", TieBreakRandom, rule)
+		}
+		again, _ := BreakTie(reversed, breaker)
+		if again.ID != winner.ID {
+			t.Fatalf("seed %d: expected %s regardless of input order, got %s", seed, winner.ID, again.ID)
+		}
+		winners[winner.ID] = true
+	}
+	if len(winners) != len(bidders) {
+		t.Errorf("Expected every bidder to win for some seed, got %v", winners)
+	}
+}
+
+func TestSequencer(t *testing.T) {
+	var sequencer Sequencer
+	if sequencer.Next() != 1 || sequencer.Next() != 2 {
+		t.Fatal("Expected sequence numbers to start at 1 and increase by one")
+	}
+
+	bidders := []Bidder{{ID: "a"}, {ID: "b", Sequence: 42}, {ID: "c"}}
+	sequencer.Assign(bidders)
+	if bidders[0].Sequence != 3 || bidders[1].Sequence != 42 || bidders[2].Sequence != 4 {
+		t.Errorf("Expected sequences 3, 42, 4, got %d, %d, %d", bidders[0].Sequence, bidders[1].Sequence, bidders[2].Sequence)
+	}
+}
+
+func TestNewSequencer(t *testing.T) {
+	sequencer := NewSequencer(41)
+	if sequencer.Next() != 42 || sequencer.Next() != 43 {
+		t.Error("Expected sequence numbers to follow the given last number")
+	}
+}
+
+func TestSequencer_Concurrent(t *testing.T) {
+	var sequencer Sequencer
+	const workers, perWorker = 8, 100
+
+	seen := make(chan uint64, workers*perWorker)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				seen <- sequencer.Next()
+			}
+		}()
+	}
+	wg.Wait()
+	close(seen)
+
+	unique := make(map[uint64]bool)
+	for sequence := range seen {
+		if unique[sequence] {
+			t.Fatalf("Sequence %d handed out twice", sequence)
+		}
+		unique[sequence] = true
+	}
+	if len(unique) != workers*perWorker {
+		t.Errorf("Expected %d distinct sequences, got %d", workers*perWorker, len(unique))
+	}
+}
@@ -0,0 +1,186 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// AllocateUnits sells the identical units described by the configuration's MultiUnit settings
+func (be *BiddingEngine) AllocateUnits(bidders []models.Bidder) (*models.MultiUnitResult, error) {
+	return be.AllocateUnitsContext(context.Background(), bidders)
+}
+
+// AllocateUnitsContext sells identical units like AllocateUnits, stopping when the context ends
+// Each bidder asks for Quantity units at up to MaxBid per unit, capped by the per-bidder limit. Units go
+// to the highest bids first; equal bids are ranked by the engine's tie-breaker and then by entry time. A
+// bid that does not fit in the units left is skipped, or partly filled when partial fills are allowed, and
+// allocation carries on with the bids below it, so a skipped bid can lose to lower bids that fit. Bids
+// below the reserve win nothing. The configuration must name a multi-unit format: under
+// FormatUniformPrice every winner pays the clearing price, and under FormatPayAsBid their own bid
+func (be *BiddingEngine) AllocateUnitsContext(ctx context.Context, bidders []models.Bidder) (*models.MultiUnitResult, error) {
+	result, err := be.allocateUnits(ctx, bidders)
+	if err != nil {
+		return nil, models.AddRequestContext(ctx, err)
+	}
+	return result, nil
+}
+
+// allocateUnits settles the bids for AllocateUnitsContext
+func (be *BiddingEngine) allocateUnits(ctx context.Context, bidders []models.Bidder) (*models.MultiUnitResult, error) {
+	startedWall := time.Now()
+	if err := ContextError(ctx, startedWall, len(bidders), 0); err != nil {
+		return nil, err
+	}
+	if err := be.checkReserve("AllocateUnits"); err != nil {
+		return nil, err
+	}
+
+	format := be.config.AuctionFormat()
+	if !format.IsMultiUnit() {
+		inputErr := models.NewInputError("auction format does not sell multiple units", "config.Format", string(format))
+		inputErr.WithOperation("AllocateUnits").WithCode(models.CodeEngineFormatUnsupported)
+		return nil, inputErr
+	}
+	spec := be.config.MultiUnit
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	workingBidders, err := be.prepareBidders(bidders)
+	if err != nil {
+		return nil, err
+	}
+	currency := be.config.Settlement()
+	if !be.config.IsMultiCurrency() && len(workingBidders) > 0 {
+		currency = workingBidders[0].Currency()
+	}
+
+	allocations, highestLosingCents := be.fillUnits(be.rankBids(workingBidders), spec)
+	if err := ContextError(ctx, startedWall, len(workingBidders), 0); err != nil {
+		return nil, err
+	}
+
+	// Pay-as-bid has no clearing rule; its clearing price reports the lowest winning bid
+	clearing := spec.ClearingRule()
+	if format == models.FormatPayAsBid {
+		clearing = ""
+	}
+	result := &models.MultiUnitResult{
+		Format:        format,
+		Units:         spec.Units,
+		Clearing:      clearing,
+		ClearingPrice: models.NewMoney(0, currency),
+		Allocations:   allocations,
+		Revenue:       models.NewMoney(0, currency),
+		TotalBidders:  len(bidders),
+		AllBidders:    workingBidders,
+		ReservePrice:  models.NewMoney(be.config.ReservePriceCents, currency),
+	}
+	if len(allocations) > 0 {
+		result.ClearingPrice = models.NewMoney(be.clearingPriceCents(clearing, allocations, highestLosingCents), currency)
+	}
+	for i := range allocations {
+		allocation := &allocations[i]
+		allocation.UnitPrice = result.ClearingPrice
+		if format == models.FormatPayAsBid {
+			allocation.UnitPrice = allocation.Bidder.MaxBid
+		}
+		if err := settleAllocation(allocation, result); err != nil {
+			return nil, err
+		}
+	}
+
+	be.debug("units allocated", "bidders", len(bidders), "format", string(format), "units", spec.Units, "units_sold", result.UnitsSold, "clearing_price_cents", result.ClearingPrice.Amount())
+	return result, nil
+}
+
+// rankBids returns the bidders in allocation order: highest maximum bid first, then by the tie-breaker
+// Bidders must already be sorted by entry time, which decides between bids the tie-breaker cannot separate
+func (be *BiddingEngine) rankBids(bidders []models.Bidder) []*models.Bidder {
+	ranked := make([]*models.Bidder, len(bidders))
+	for i := range bidders {
+		ranked[i] = &bidders[i]
+	}
+	tieBreaker := be.TieBreaker()
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].GetMaxBidCents() != ranked[j].GetMaxBidCents() {
+			return ranked[i].GetMaxBidCents() > ranked[j].GetMaxBidCents()
+		}
+		return tieBreaker.Compare(ranked[i], ranked[j]) < 0
+	})
+	return ranked
+}
+
+// fillUnits allocates units to the ranked bids and returns the allocations with the highest bid that went
+// wholly or partly unfilled, or -1 if every bid was filled. A bid too large for the units left is skipped
+// and lower bids that fit still win, so the highest unfilled bid can exceed the lowest winning bid. Units
+// above the per-bidder limit are never eligible, so a bid cut to the limit does not count as unfilled
+func (be *BiddingEngine) fillUnits(ranked []*models.Bidder, spec models.MultiUnitConfig) ([]models.UnitAllocation, int64) {
+	allocations := []models.UnitAllocation{}
+	remaining := spec.Units
+	highestLosingCents := int64(-1)
+	for _, bidder := range ranked {
+		requested, eligible := bidder.Units(), spec.UnitsFor(bidder)
+		units := 0
+		if be.config.ReserveMetBy(bidder.GetMaxBidCents()) {
+			if eligible <= remaining {
+				units = eligible
+			} else if spec.PartialFills {
+				units = remaining
+			}
+		}
+		if units < eligible && highestLosingCents < 0 {
+			highestLosingCents = bidder.GetMaxBidCents()
+		}
+		if units > 0 {
+			remaining -= units
+			allocations = append(allocations, models.UnitAllocation{Bidder: bidder, Requested: requested, Units: units})
+		}
+	}
+	return allocations, highestLosingCents
+}
+
+// clearingPriceCents returns the clearing rule's unit price, kept between the reserve and the lowest winning bid
+// With every bid filled the highest losing rule falls back to the reserve, or to the lowest winning bid. When
+// a skipped bid outranks the lowest winning bid, the cap applies and the highest losing rule charges the
+// lowest winning bid, the same price as the lowest winning rule
+func (be *BiddingEngine) clearingPriceCents(rule models.ClearingRule, allocations []models.UnitAllocation, highestLosingCents int64) int64 {
+	lowestWinningCents := allocations[len(allocations)-1].Bidder.GetMaxBidCents()
+
+	priceCents := lowestWinningCents
+	if rule == models.ClearingHighestLosing {
+		switch {
+		case highestLosingCents >= 0:
+			priceCents = highestLosingCents
+		case be.config.HasReserve():
+			priceCents = be.config.ReservePriceCents
+		}
+	}
+	if priceCents < be.config.ReservePriceCents {
+		priceCents = be.config.ReservePriceCents
+	}
+	if priceCents > lowestWinningCents {
+		priceCents = lowestWinningCents
+	}
+	return priceCents
+}
+
+// settleAllocation prices the allocation's units at its unit price and adds them to the result's totals
+func settleAllocation(allocation *models.UnitAllocation, result *models.MultiUnitResult) error {
+	amount, err := allocation.UnitPrice.Mul(int64(allocation.Units))
+	if err == nil {
+		result.Revenue, err = result.Revenue.Add(amount)
+	}
+	if err != nil {
+		processingErr := models.NewProcessingErrorWithCause("failed to price allocated units", err, result.TotalBidders, 0)
+		processingErr.WithOperation("AllocateUnits.Price").WithCode(models.CodeEnginePriceFailed)
+		processingErr.AddContext("bidder_id", allocation.Bidder.ID)
+		return processingErr
+	}
+	allocation.Amount = amount
+	result.UnitsSold += allocation.Units
+	return nil
+}
@@ -0,0 +1,370 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// unitBid returns a sealed bid for quantity units at up to perUnit dollars each, entered seconds after a fixed base time
+func unitBid(id string, perUnit float64, quantity, seconds int) models.Bidder {
+	bid := sealedBid(id, perUnit, seconds)
+	bid.Quantity = quantity
+	return bid
+}
+
+// allocated maps each winner's ID to the units they won
+func allocated(result *models.MultiUnitResult) map[string]int {
+	units := make(map[string]int, len(result.Allocations))
+	for _, allocation := range result.Allocations {
+		units[allocation.Bidder.ID] = allocation.Units
+	}
+	return units
+}
+
+func TestBiddingEngine_AllocateUnits(t *testing.T) {
+	tests := []struct {
+		name          string
+		multiUnit     models.MultiUnitConfig
+		reserve       int64
+		bidders       []models.Bidder
+		expected      map[string]int
+		expectedCents int64 // Clearing price per unit
+	}{
+		{
+			name:      "highest losing bid sets the price",
+			multiUnit: models.MultiUnitConfig{Units: 5},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 3},
+			expectedCents: 3000,
+		},
+		{
+			name:      "lowest winning bid sets the price",
+			multiUnit: models.MultiUnitConfig{Units: 5, Clearing: models.ClearingLowestWinning},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 3},
+			expectedCents: 4000,
+		},
+		{
+			name:      "marginal bid is partly filled",
+			multiUnit: models.MultiUnitConfig{Units: 4, PartialFills: true},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 2},
+			expectedCents: 4000,
+		},
+		{
+			name:      "marginal bid that does not fit is skipped",
+			multiUnit: models.MultiUnitConfig{Units: 4},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "carol": 2},
+			expectedCents: 3000, // Bob's unfilled 40.00 is capped at Carol's winning 30.00
+		},
+		{
+			name:      "large high bid that does not fit loses to lower bids",
+			multiUnit: models.MultiUnitConfig{Units: 4},
+			bidders: []models.Bidder{
+				unitBid("alice", 60, 5, 0), unitBid("bob", 50, 2, 1), unitBid("carol", 40, 2, 2), unitBid("dave", 30, 1, 3),
+			},
+			expected:      map[string]int{"bob": 2, "carol": 2},
+			expectedCents: 4000, // Alice's unfilled 60.00 is capped at Carol's winning 40.00, not Dave's 30.00
+		},
+		{
+			name:      "per-bidder limit caps the allocation",
+			multiUnit: models.MultiUnitConfig{Units: 5, MaxUnitsPerBidder: 2},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 4, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 2},
+			expectedCents: 3000, // Alice's units above the limit are not unfilled bids
+		},
+		{
+			name:      "quantity defaults to one unit",
+			multiUnit: models.MultiUnitConfig{Units: 2},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 0, 0), unitBid("bob", 40, 0, 1), unitBid("carol", 30, 0, 2),
+			},
+			expected:      map[string]int{"alice": 1, "bob": 1},
+			expectedCents: 3000,
+		},
+		{
+			name:      "equal bids go to the earliest entry",
+			multiUnit: models.MultiUnitConfig{Units: 3},
+			bidders: []models.Bidder{
+				unitBid("alice", 40, 2, 2), unitBid("bob", 40, 2, 1), unitBid("carol", 50, 1, 0),
+			},
+			expected:      map[string]int{"carol": 1, "bob": 2},
+			expectedCents: 4000,
+		},
+		{
+			name:      "undersubscribed without a reserve pays the lowest winning bid",
+			multiUnit: models.MultiUnitConfig{Units: 10},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 3},
+			expectedCents: 4000,
+		},
+		{
+			name:      "undersubscribed with a reserve pays the reserve",
+			multiUnit: models.MultiUnitConfig{Units: 10},
+			reserve:   2500,
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 3},
+			expectedCents: 2500,
+		},
+		{
+			name:      "bids below the reserve win nothing",
+			multiUnit: models.MultiUnitConfig{Units: 10},
+			reserve:   3500,
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 3},
+			expectedCents: 3500,
+		},
+		{
+			name:      "nothing sold",
+			multiUnit: models.MultiUnitConfig{Units: 3},
+			reserve:   10000,
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0),
+			},
+			expected: map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := models.NewAuctionConfigWithUnits(tt.multiUnit)
+			config.ReservePriceCents = tt.reserve
+			result, err := NewBiddingEngine(WithConfig(config)).AllocateUnits(tt.bidders)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			units := allocated(result)
+			if len(units) != len(tt.expected) {
+				t.Fatalf("Expected allocations %v, got %v", tt.expected, units)
+			}
+			sold := 0
+			for id, expected := range tt.expected {
+				if units[id] != expected {
+					t.Errorf("Expected %s to win %d units, got %d", id, expected, units[id])
+				}
+				sold += expected
+			}
+			if result.ClearingPrice.Amount() != tt.expectedCents {
+				t.Errorf("Expected clearing price %d cents, got %d", tt.expectedCents, result.ClearingPrice.Amount())
+			}
+			if result.UnitsSold != sold || result.UnitsUnsold() != tt.multiUnit.Units-sold {
+				t.Errorf("Expected %d units sold, got %d", sold, result.UnitsSold)
+			}
+
+			revenue := int64(0)
+			for _, allocation := range result.Allocations {
+				if !allocation.UnitPrice.Equal(result.ClearingPrice) {
+					t.Errorf("Expected %s to pay the clearing price, got %s", allocation.Bidder.ID, allocation.UnitPrice)
+				}
+				if allocation.Amount.Amount() != tt.expectedCents*int64(allocation.Units) {
+					t.Errorf("Expected %s to owe %d units at the clearing price, got %s", allocation.Bidder.ID, allocation.Units, allocation.Amount)
+				}
+				revenue += allocation.Amount.Amount()
+			}
+			if result.Revenue.Amount() != revenue {
+				t.Errorf("Expected revenue %d cents, got %d", revenue, result.Revenue.Amount())
+			}
+			if result.Format != models.FormatUniformPrice || result.TotalBidders != len(tt.bidders) {
+				t.Errorf("Unexpected result: %+v", result)
+			}
+		})
+	}
+}
+
+func TestBiddingEngine_AllocateUnitsTieBreaker(t *testing.T) {
+	// Alice entered first, but the sequence tie-breaker prefers Bob, who was ingested first
+	config := models.NewAuctionConfigWithUnits(models.MultiUnitConfig{Units: 2})
+	bidders := []models.Bidder{unitBid("alice", 40, 2, 0), unitBid("bob", 40, 2, 1)}
+	bidders[0].Sequence, bidders[1].Sequence = 2, 1
+
+	result, err := NewBiddingEngine(WithConfig(config), WithTieBreaker(models.NewEarliestSequenceTieBreaker())).AllocateUnits(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if units := allocated(result); units["bob"] != 2 || len(units) != 1 {
+		t.Errorf("Expected the tie-breaker to give Bob both units, got %v", units)
+	}
+}
+
+func TestBiddingEngine_AllocateUnitsPayAsBid(t *testing.T) {
+	tests := []struct {
+		name          string
+		multiUnit     models.MultiUnitConfig
+		reserve       int64
+		bidders       []models.Bidder
+		expected      map[string]int   // Units won
+		expectedCents map[string]int64 // Amount owed
+	}{
+		{
+			name:      "each winner pays their own bid",
+			multiUnit: models.MultiUnitConfig{Units: 5},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 3},
+			expectedCents: map[string]int64{"alice": 10000, "bob": 12000},
+		},
+		{
+			name:      "marginal bid is partly filled at its own price",
+			multiUnit: models.MultiUnitConfig{Units: 6, PartialFills: true},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 3, "carol": 1},
+			expectedCents: map[string]int64{"alice": 10000, "bob": 12000, "carol": 3000},
+		},
+		{
+			name:      "per-bidder limit frees units for lower bids",
+			multiUnit: models.MultiUnitConfig{Units: 5, MaxUnitsPerBidder: 3},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 5, 0), unitBid("bob", 40, 1, 1), unitBid("carol", 30, 4, 2),
+			},
+			expected:      map[string]int{"alice": 3, "bob": 1},
+			expectedCents: map[string]int64{"alice": 15000, "bob": 4000},
+		},
+		{
+			name:      "large high bid that does not fit loses to lower bids",
+			multiUnit: models.MultiUnitConfig{Units: 4},
+			bidders: []models.Bidder{
+				unitBid("alice", 60, 5, 0), unitBid("bob", 50, 2, 1), unitBid("carol", 40, 2, 2),
+			},
+			expected:      map[string]int{"bob": 2, "carol": 2},
+			expectedCents: map[string]int64{"bob": 10000, "carol": 8000},
+		},
+		{
+			name:      "equal marginal bids go to the earliest entry",
+			multiUnit: models.MultiUnitConfig{Units: 4, PartialFills: true},
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 2, 2), unitBid("carol", 40, 2, 1),
+			},
+			expected:      map[string]int{"alice": 2, "carol": 2},
+			expectedCents: map[string]int64{"alice": 10000, "carol": 8000},
+		},
+		{
+			name:      "bids below the reserve win nothing",
+			multiUnit: models.MultiUnitConfig{Units: 10},
+			reserve:   3500,
+			bidders: []models.Bidder{
+				unitBid("alice", 50, 2, 0), unitBid("bob", 40, 3, 1), unitBid("carol", 30, 2, 2),
+			},
+			expected:      map[string]int{"alice": 2, "bob": 3},
+			expectedCents: map[string]int64{"alice": 10000, "bob": 12000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := models.NewAuctionConfigWithPayAsBid(tt.multiUnit)
+			config.ReservePriceCents = tt.reserve
+			result, err := NewBiddingEngine(WithConfig(config)).AllocateUnits(tt.bidders)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			units := allocated(result)
+			if len(units) != len(tt.expected) {
+				t.Fatalf("Expected allocations %v, got %v", tt.expected, units)
+			}
+			revenue := int64(0)
+			for id, owed := range result.RevenueByWinner() {
+				allocation, _ := result.Allocation(id)
+				if units[id] != tt.expected[id] {
+					t.Errorf("Expected %s to win %d units, got %d", id, tt.expected[id], units[id])
+				}
+				if owed.Amount() != tt.expectedCents[id] {
+					t.Errorf("Expected %s to owe %d cents, got %d", id, tt.expectedCents[id], owed.Amount())
+				}
+				if !allocation.UnitPrice.Equal(allocation.Bidder.MaxBid) {
+					t.Errorf("Expected %s to pay their own bid %s, got %s", id, allocation.Bidder.MaxBid, allocation.UnitPrice)
+				}
+				revenue += owed.Amount()
+			}
+			if result.Revenue.Amount() != revenue {
+				t.Errorf("Expected revenue %d cents, got %d", revenue, result.Revenue.Amount())
+			}
+
+			lowest := result.Allocations[len(result.Allocations)-1].Bidder.MaxBid
+			if !result.ClearingPrice.Equal(lowest) || result.Clearing != "" {
+				t.Errorf("Expected the lowest winning bid %s without a clearing rule, got %s (%q)", lowest, result.ClearingPrice, result.Clearing)
+			}
+			if result.Format != models.FormatPayAsBid {
+				t.Errorf("Expected format %s, got %s", models.FormatPayAsBid, result.Format)
+			}
+		})
+	}
+}
+
+func TestBiddingEngine_AllocateUnitsErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config models.AuctionConfig
+		code   models.ErrorCode
+	}{
+		{"single-unit format", models.NewAuctionConfig(), models.CodeEngineFormatUnsupported},
+		{"no units", models.NewAuctionConfigWithUnits(models.MultiUnitConfig{}), models.CodeEngineMultiUnitInvalid},
+		{"negative per-bidder limit", models.NewAuctionConfigWithPayAsBid(models.MultiUnitConfig{Units: 1, MaxUnitsPerBidder: -1}), models.CodeEngineMultiUnitInvalid},
+		{"unknown clearing rule", models.NewAuctionConfigWithUnits(models.MultiUnitConfig{Units: 1, Clearing: "median"}), models.CodeEngineMultiUnitInvalid},
+		{"negative reserve", models.AuctionConfig{Format: models.FormatUniformPrice, MultiUnit: models.MultiUnitConfig{Units: 1}, ReservePriceCents: -1}, models.CodeEngineReserveNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBiddingEngine(WithConfig(tt.config)).AllocateUnits([]models.Bidder{unitBid("alice", 50, 1, 0)})
+			if !errors.Is(err, models.ErrInput) {
+				t.Fatalf("Expected an input error, got: %v", err)
+			}
+			if !models.HasCode(err, tt.code) {
+				t.Errorf("Expected code %s, got %s", tt.code, models.CodeOf(err))
+			}
+		})
+	}
+
+	// Multi-unit formats are settled with AllocateUnits, not ProcessBids
+	_, err := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithUnits(models.MultiUnitConfig{Units: 1}))).ProcessBids(stalledTie())
+	if !models.HasCode(err, models.CodeEngineFormatUnsupported) {
+		t.Errorf("Expected code %s, got %s", models.CodeEngineFormatUnsupported, models.CodeOf(err))
+	}
+}
+
+func TestBiddingEngine_AllocateUnitsMultiCurrency(t *testing.T) {
+	config := models.NewAuctionConfigWithSettlement(models.USD, currencyTestRates())
+	config.Format = models.FormatUniformPrice
+	config.MultiUnit = models.MultiUnitConfig{Units: 2}
+
+	// Alice's 100.00 EUR is 110.00 USD, above Bob's 80.00 GBP (100.00 USD) and Carol's 90.00 USD
+	bidders := []models.Bidder{
+		{ID: "alice", Name: "Alice", StartingBid: models.NewMoney(10000, models.EUR), MaxBid: models.NewMoney(10000, models.EUR)},
+		{ID: "bob", Name: "Bob", StartingBid: models.NewMoney(8000, models.GBP), MaxBid: models.NewMoney(8000, models.GBP)},
+		{ID: "carol", Name: "Carol", StartingBid: models.NewMoney(9000, models.USD), MaxBid: models.NewMoney(9000, models.USD)},
+	}
+	result, err := NewBiddingEngine(WithConfig(config)).AllocateUnits(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if units := allocated(result); units["alice"] != 1 || units["bob"] != 1 {
+		t.Errorf("Expected Alice and Bob to win, got %v", units)
+	}
+	if !result.ClearingPrice.Equal(models.NewMoney(9000, models.USD)) || !result.Revenue.Equal(models.NewMoney(18000, models.USD)) {
+		t.Errorf("Expected 90.00 USD per unit, got %s and revenue %s", result.ClearingPrice, result.Revenue)
+	}
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"log/slog"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// EngineOption configures a BiddingEngine created by NewBiddingEngine
+type EngineOption func(*BiddingEngine)
+
+// WithMaxRounds sets the iterative strategy's round limit; values below 1 keep the default
+func WithMaxRounds(maxRounds int) EngineOption {
+	return func(be *BiddingEngine) {
+		if maxRounds > 0 {
+			be.maxRounds = maxRounds
+		}
+	}
+}
+
+// WithDeadline limits how long ProcessBids may run, measured on the wall clock; 0 means no limit
+func WithDeadline(deadline time.Duration) EngineOption {
+	return func(be *BiddingEngine) {
+		if deadline > 0 {
+			be.deadline = deadline
+		}
+	}
+}
+
+// WithStrategy sets how proxy bids are settled
+func WithStrategy(strategy ResolutionStrategy) EngineOption {
+	return func(be *BiddingEngine) {
+		be.strategy = strategy
+	}
+}
+
+// WithFormat settles bids under the given format instead of the one the configuration names
+func WithFormat(format Format) EngineOption {
+	return func(be *BiddingEngine) {
+		be.format = format
+	}
+}
+
+// WithConfig applies lot-level settings such as the reserve price and increment schedule
+func WithConfig(config models.AuctionConfig) EngineOption {
+	return func(be *BiddingEngine) {
+		be.config = config
+	}
+}
+
+// WithClock sets the clock the engine reads the current time from
+func WithClock(clock models.Clock) EngineOption {
+	return func(be *BiddingEngine) {
+		if clock != nil {
+			be.clock = clock
+		}
+	}
+}
+
+// WithTieBreaker sets how ties at the top are settled
+func WithTieBreaker(tieBreaker models.TieBreaker) EngineOption {
+	return func(be *BiddingEngine) {
+		be.tieBreaker = tieBreaker
+	}
+}
+
+// WithAudit records an audit trail in each result and, when sink is not nil, streams entries to it
+func WithAudit(mode models.AuditMode, sink models.AuditSink) EngineOption {
+	return func(be *BiddingEngine) {
+		be.auditMode = mode
+		be.auditSink = sink
+	}
+}
+
+// WithObserver reports the engine's progress to the given observer
+func WithObserver(observer EngineObserver) EngineOption {
+	return func(be *BiddingEngine) {
+		be.observer = observer
+	}
+}
+
+// WithLogger sets the logger the engine reports settled auctions and timeouts to
+func WithLogger(logger *slog.Logger) EngineOption {
+	return func(be *BiddingEngine) {
+		be.logger = logger
+	}
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/clocktest"
+	"auction-bidding-algorithm/internal/models"
+)
+
+// steppingClock moves forward by a fixed step every time it is read
+type steppingClock struct {
+	mu   sync.Mutex
+	now  time.Time
+	step time.Duration
+}
+
+func (sc *steppingClock) Now() time.Time {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.now = sc.now.Add(sc.step)
+	return sc.now
+}
+
+// longAuction returns two bidders whose tiny increments need thousands of rounds
+func longAuction() []models.Bidder {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bidders := []models.Bidder{
+		*models.NewBidder("1", "Alice", models.Dollars(1.00), models.Dollars(500.00), models.Dollars(0.02)),
+		*models.NewBidder("2", "Bob", models.Dollars(1.01), models.Dollars(499.00), models.Dollars(0.02)),
+	}
+	bidders[0].EntryTime = base
+	bidders[1].EntryTime = base.Add(time.Second)
+	return bidders
+}
+
+func TestNewBiddingEngine_Options(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	breaker := models.NewHighestMaxBidTieBreaker()
+	config := models.NewAuctionConfigWithReserve(5000)
+
+	engine := NewBiddingEngine(
+		WithMaxRounds(50000),
+		WithDeadline(time.Second),
+		WithStrategy(StrategyClosedForm),
+		WithConfig(config),
+		WithClock(clock),
+		WithTieBreaker(breaker),
+		WithAudit(models.AuditLeaderChanges, nil),
+	)
+
+	if engine.MaxRounds() != 50000 || engine.Deadline() != time.Second {
+		t.Errorf("Expected 50000 rounds and a 1s deadline, got %d and %s", engine.MaxRounds(), engine.Deadline())
+	}
+	if engine.Strategy() != StrategyClosedForm || engine.Clock() != clock || engine.TieBreaker() != breaker {
+		t.Error("Expected strategy, clock and tie-breaker from the options")
+	}
+	if engine.Config().ReservePriceCents != 5000 || engine.auditMode != models.AuditLeaderChanges {
+		t.Error("Expected config and audit mode from the options")
+	}
+}
+
+func TestNewBiddingEngine_OptionDefaults(t *testing.T) {
+	engine := NewBiddingEngine(WithMaxRounds(0), WithDeadline(-time.Second), WithClock(nil))
+	if engine.MaxRounds() != 1000 {
+		t.Errorf("Expected the default round limit, got %d", engine.MaxRounds())
+	}
+	if engine.Deadline() != 0 {
+		t.Errorf("Expected no deadline, got %s", engine.Deadline())
+	}
+	if engine.Clock() == nil {
+		t.Error("Expected the default clock to be kept")
+	}
+}
+
+func TestProcessBids_MaxRoundsOption(t *testing.T) {
+	if _, err := NewBiddingEngine().ProcessBids(longAuction()); err == nil {
+		t.Fatal("Expected the default round limit to be exceeded")
+	}
+
+	result, err := NewBiddingEngine(WithMaxRounds(100000)).ProcessBids(longAuction())
+	if err != nil {
+		t.Fatalf("Expected no error with a raised round limit, got: %v", err)
+	}
+	if result.Winner.ID != "1" {
+		t.Errorf("Expected Alice to win, got %s", result.Winner.Name)
+	}
+}
+
+func TestProcessBids_DeadlineOption(t *testing.T) {
+	// The engine's clock races a second ahead on every read, but the deadline is measured in real time
+	clock := &steppingClock{now: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC), step: time.Second}
+	if _, err := NewBiddingEngine(WithMaxRounds(100000), WithDeadline(time.Minute), WithClock(clock)).ProcessBids(longAuction()); err != nil {
+		t.Fatalf("Expected the engine's clock not to count against the deadline, got: %v", err)
+	}
+
+	// A round that stalls in real time runs past the deadline
+	stall := roundHook(func(round int) {
+		if round == 3 {
+			time.Sleep(60 * time.Millisecond)
+		}
+	})
+	engine := NewBiddingEngine(WithMaxRounds(100000), WithDeadline(50*time.Millisecond), WithClock(clock), WithObserver(stall))
+
+	_, err := engine.ProcessBids(longAuction())
+	timeoutErr, ok := err.(*models.TimeoutError)
+	if !ok {
+		t.Fatalf("Expected TimeoutError, got %T: %v", err, err)
+	}
+	if timeoutErr.AuctionError.Operation != "ProcessBids.DeadlineCheck" || timeoutErr.TimeoutDuration != "50ms" {
+		t.Errorf("Expected a deadline timeout of 50ms, got %s after %s", timeoutErr.AuctionError.Operation, timeoutErr.TimeoutDuration)
+	}
+	if rounds, _ := timeoutErr.GetContext("rounds_completed"); rounds == "" || rounds == "0" {
+		t.Errorf("Expected some rounds before the deadline, got %q", rounds)
+	}
+
+	// The closed-form strategy finishes well inside the same budget
+	closedForm := NewBiddingEngine(WithStrategy(StrategyClosedForm), WithDeadline(50*time.Millisecond), WithClock(clock))
+	if _, err := closedForm.ProcessBids(longAuction()); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
+
+func TestProcessBids_LoggerOption(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	if _, err := NewBiddingEngine(WithLogger(logger)).ProcessBids(auditBidders()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(buffer.String(), "bids processed") || !strings.Contains(buffer.String(), "winner_id=bob") {
+		t.Errorf("Expected a debug record for the settled auction, got %q", buffer.String())
+	}
+
+	buffer.Reset()
+	if _, err := NewBiddingEngine(WithLogger(logger)).ProcessBids(longAuction()); err == nil {
+		t.Fatal("Expected the round limit to be exceeded")
+	}
+	if !strings.Contains(buffer.String(), "level=WARN") || !strings.Contains(buffer.String(), "bid processing failed") {
+		t.Errorf("Expected a warning for the timeout, got %q", buffer.String())
+	}
+}
@@ -19,8 +19,8 @@ func TestFloatingPointPrecision(t *testing.T) {
 		{
 			name: "Precise decimal calculations with small increments",
 			bidders: []models.Bidder{
-				*models.NewBidder("1", "Alice", 10.01, 10.99, 0.01),
-				*models.NewBidder("2", "Bob", 10.02, 10.98, 0.01),
+				*models.NewBidder("1", "Alice", models.Dollars(10.01), models.Dollars(10.99), models.Dollars(0.01)),
+				*models.NewBidder("2", "Bob", models.Dollars(10.02), models.Dollars(10.98), models.Dollars(0.01)),
 			},
 			expectedWinnerID:   "1",
 			expectedWinningBid: 10.99, // Alice's max bid since she can outbid Bob
@@ -29,8 +29,8 @@ func TestFloatingPointPrecision(t *testing.T) {
 		{
 			name: "Precision with fractional cents that round",
 			bidders: []models.Bidder{
-				*models.NewBidder("1", "Alice", 10.01, 20.01, 0.01), // Use valid cent increments
-				*models.NewBidder("2", "Bob", 10.00, 19.99, 0.01),
+				*models.NewBidder("1", "Alice", models.Dollars(10.01), models.Dollars(20.01), models.Dollars(0.01)), // Use valid cent increments
+				*models.NewBidder("2", "Bob", models.Dollars(10.00), models.Dollars(19.99), models.Dollars(0.01)),
 			},
 			expectedWinnerID:   "1",   // Alice has higher max bid
 			expectedWinningBid: 20.00, // Alice pays Bob's max (19.99) + increment (0.01) = 20.00
@@ -39,8 +39,8 @@ func TestFloatingPointPrecision(t *testing.T) {
 		{
 			name: "Avoid floating point accumulation errors",
 			bidders: []models.Bidder{
-				*models.NewBidder("1", "Alice", 0.1, 1.0, 0.1),
-				*models.NewBidder("2", "Bob", 0.2, 0.9, 0.1),
+				*models.NewBidder("1", "Alice", models.Dollars(0.1), models.Dollars(1.0), models.Dollars(0.1)),
+				*models.NewBidder("2", "Bob", models.Dollars(0.2), models.Dollars(0.9), models.Dollars(0.1)),
 			},
 			expectedWinnerID:   "1",
 			expectedWinningBid: 1.0, // Alice should win with her max bid
@@ -49,8 +49,8 @@ func TestFloatingPointPrecision(t *testing.T) {
 		{
 			name: "Large monetary values with small increments",
 			bidders: []models.Bidder{
-				*models.NewBidder("1", "Alice", 999999.99, 1000000.00, 0.01),
-				*models.NewBidder("2", "Bob", 999999.98, 999999.99, 0.01),
+				*models.NewBidder("1", "Alice", models.Dollars(999999.99), models.Dollars(1000000.00), models.Dollars(0.01)),
+				*models.NewBidder("2", "Bob", models.Dollars(999999.98), models.Dollars(999999.99), models.Dollars(0.01)),
 			},
 			expectedWinnerID:   "1",
 			expectedWinningBid: 1000000.00, // Alice's max bid
@@ -59,8 +59,8 @@ func TestFloatingPointPrecision(t *testing.T) {
 		{
 			name: "Minimum winning bid calculation precision",
 			bidders: []models.Bidder{
-				*models.NewBidder("1", "Alice", 10.00, 15.00, 0.25),
-				*models.NewBidder("2", "Bob", 10.00, 12.50, 0.25),
+				*models.NewBidder("1", "Alice", models.Dollars(10.00), models.Dollars(15.00), models.Dollars(0.25)),
+				*models.NewBidder("2", "Bob", models.Dollars(10.00), models.Dollars(12.50), models.Dollars(0.25)),
 			},
 			expectedWinnerID:   "1",
 			expectedWinningBid: 12.75, // Bob's max (12.50) + Alice's increment (0.25)
@@ -92,10 +92,10 @@ func TestFloatingPointPrecision(t *testing.T) {
 			}
 
 			// Check winning bid with reasonable precision tolerance (1 cent)
-			if abs(result.WinningBid-tt.expectedWinningBid) > 0.01 {
+			if abs(result.WinningBid.Float64()-tt.expectedWinningBid) > 0.01 {
 				t.Errorf("Expected winning bid %.2f, got %.2f (diff: %.4f)",
-					tt.expectedWinningBid, result.WinningBid,
-					abs(result.WinningBid-tt.expectedWinningBid))
+					tt.expectedWinningBid, result.WinningBid.Float64(),
+					abs(result.WinningBid.Float64()-tt.expectedWinningBid))
 			}
 
 			t.Logf("Test passed: %s", tt.description)
@@ -139,7 +139,7 @@ func TestCentsConversion(t *testing.T) {
 
 // TestBidderPrecisionMethods tests the precision methods on Bidder
 func TestBidderPrecisionMethods(t *testing.T) {
-	bidder := models.NewBidder("1", "Alice", 10.01, 20.99, 0.25)
+	bidder := models.NewBidder("1", "Alice", models.Dollars(10.01), models.Dollars(20.99), models.Dollars(0.25))
 
 	// Test that cents values are properly initialized
 	if bidder.GetStartingBidCents() != 1001 {
@@ -169,8 +169,8 @@ func TestBidderPrecisionMethods(t *testing.T) {
 
 	// Test that float field is properly synced
 	expectedFloat := models.CentsToDollars(newCents)
-	if abs(bidder.CurrentBid-expectedFloat) > 0.001 {
-		t.Errorf("Float field not properly synced: expected %.3f, got %.3f", expectedFloat, bidder.CurrentBid)
+	if abs(bidder.CurrentBid.Float64()-expectedFloat) > 0.001 {
+		t.Errorf("Float field not properly synced: expected %.3f, got %.3f", expectedFloat, bidder.CurrentBid.Float64())
 	}
 }
 
@@ -180,8 +180,8 @@ func TestMinimumWinningBidPrecision(t *testing.T) {
 
 	// Create bidders with values that could cause floating-point precision issues
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.01, 15.33, 0.17),
-		*models.NewBidder("2", "Bob", 10.02, 14.44, 0.11),
+		*models.NewBidder("1", "Alice", models.Dollars(10.01), models.Dollars(15.33), models.Dollars(0.17)),
+		*models.NewBidder("2", "Bob", models.Dollars(10.02), models.Dollars(14.44), models.Dollars(0.11)),
 	}
 
 	// Set entry times
@@ -198,8 +198,8 @@ func TestMinimumWinningBidPrecision(t *testing.T) {
 	// Test that the winning bid calculation is precise
 	// Alice should win, and should pay Bob's max (14.44) + Alice's increment (0.17) = 14.61
 	expectedWinningBid := 14.61
-	if abs(result.WinningBid-expectedWinningBid) > 0.01 {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if abs(result.WinningBid.Float64()-expectedWinningBid) > 0.01 {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	// Test the cents-based calculation directly
@@ -11,8 +11,8 @@ import (
 func TestPrecisionHandlingImplementation(t *testing.T) {
 	// Test case that would fail with floating-point precision issues
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 10.01, 10.99, 0.01),
-		*models.NewBidder("2", "Bob", 10.02, 10.98, 0.01),
+		*models.NewBidder("1", "Alice", models.Dollars(10.01), models.Dollars(10.99), models.Dollars(0.01)),
+		*models.NewBidder("2", "Bob", models.Dollars(10.02), models.Dollars(10.98), models.Dollars(0.01)),
 	}
 
 	// Set entry times to ensure deterministic ordering
@@ -40,8 +40,8 @@ func TestPrecisionHandlingImplementation(t *testing.T) {
 	// Verify that the winning bid is calculated precisely
 	// Alice should pay Bob's max (10.98) + Alice's increment (0.01) = 10.99
 	expectedWinningBid := 10.99
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	// Verify that cents-based calculations are used internally
@@ -52,7 +52,7 @@ func TestPrecisionHandlingImplementation(t *testing.T) {
 	}
 
 	t.Logf("Precision test passed: Winner=%s, WinningBid=%.2f, WinningBidCents=%d",
-		result.Winner.ID, result.WinningBid, winningBidCents)
+		result.Winner.ID, result.WinningBid.Float64(), winningBidCents)
 }
 
 // TestDecimalArithmeticAccuracy tests that decimal arithmetic is accurate for monetary calculations
@@ -97,7 +97,7 @@ func TestDecimalArithmeticAccuracy(t *testing.T) {
 
 // TestBidderPrecisionOperations tests that bidder operations use precise arithmetic
 func TestBidderPrecisionOperations(t *testing.T) {
-	bidder := models.NewBidder("1", "Alice", 10.01, 20.99, 0.25)
+	bidder := models.NewBidder("1", "Alice", models.Dollars(10.01), models.Dollars(20.99), models.Dollars(0.25))
 
 	// Verify initial state
 	if bidder.GetStartingBidCents() != 1001 {
@@ -129,8 +129,8 @@ func TestBidderPrecisionOperations(t *testing.T) {
 
 	// Verify that float field is properly synced
 	expectedFloat := models.CentsToDollars(newCents)
-	if bidder.CurrentBid != expectedFloat {
-		t.Errorf("Float field not synced: expected %.2f, got %.2f", expectedFloat, bidder.CurrentBid)
+	if bidder.CurrentBid.Float64() != expectedFloat {
+		t.Errorf("Float field not synced: expected %.2f, got %.2f", expectedFloat, bidder.CurrentBid.Float64())
 	}
 
 	t.Logf("Bidder precision test passed: %d cents -> %d cents (increment: %d)",
@@ -141,9 +141,9 @@ func TestBidderPrecisionOperations(t *testing.T) {
 func TestPrecisionInComplexScenario(t *testing.T) {
 	// Create a scenario that would be problematic with floating-point arithmetic
 	bidders := []models.Bidder{
-		*models.NewBidder("1", "Alice", 0.10, 1.00, 0.10), // Repeated 0.1 additions
-		*models.NewBidder("2", "Bob", 0.20, 0.90, 0.10),
-		*models.NewBidder("3", "Charlie", 0.15, 0.85, 0.05),
+		*models.NewBidder("1", "Alice", models.Dollars(0.10), models.Dollars(1.00), models.Dollars(0.10)), // Repeated 0.1 additions
+		*models.NewBidder("2", "Bob", models.Dollars(0.20), models.Dollars(0.90), models.Dollars(0.10)),
+		*models.NewBidder("3", "Charlie", models.Dollars(0.15), models.Dollars(0.85), models.Dollars(0.05)),
 	}
 
 	// Set entry times
@@ -171,8 +171,8 @@ func TestPrecisionInComplexScenario(t *testing.T) {
 	// Verify that the winning bid calculation is precise
 	// Alice should pay Bob's max (0.90) + Alice's increment (0.10) = 1.00
 	expectedWinningBid := 1.00
-	if result.WinningBid != expectedWinningBid {
-		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid)
+	if result.WinningBid.Float64() != expectedWinningBid {
+		t.Errorf("Expected winning bid %.2f, got %.2f", expectedWinningBid, result.WinningBid.Float64())
 	}
 
 	// Verify cents calculation
@@ -182,5 +182,5 @@ func TestPrecisionInComplexScenario(t *testing.T) {
 	}
 
 	t.Logf("Complex precision test passed: Winner=%s, WinningBid=%.2f, Rounds=%d",
-		result.Winner.ID, result.WinningBid, result.BiddingRounds)
+		result.Winner.ID, result.WinningBid.Float64(), result.BiddingRounds)
 }
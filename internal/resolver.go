@@ -0,0 +1,475 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// ResolutionStrategy selects how the BiddingEngine settles proxy bids
+type ResolutionStrategy int
+
+const (
+	// StrategyIterative increments losing bidders round by round until nothing changes
+	StrategyIterative ResolutionStrategy = iota
+	// StrategyClosedForm computes the settled bids directly in O(n log n)
+	StrategyClosedForm
+)
+
+// String returns the strategy name
+func (rs ResolutionStrategy) String() string {
+	switch rs {
+	case StrategyIterative:
+		return "iterative"
+	case StrategyClosedForm:
+		return "closed_form"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(rs))
+	}
+}
+
+// resolveClosedForm settles all bids without simulating individual rounds.
+//
+// A bidder can only ever hold a bid on its ladder: the prices reached from StartingBid by
+// repeated increments, up to its reachable top. One iterative round is a monotone map over
+// those ladders, so the loop in resolveIterative always stops at the least fixed point above
+// the starting bids. A fixed point with leading price H has every bidder whose reachable top
+// is at least H sitting exactly on H, and everyone else exhausted below it. The settled price
+// is therefore the smallest H, no lower than the highest starting bid, that lies on the ladder
+// of every bidder able to reach it. Walking the distinct reachable tops downwards adds bidders
+// one at a time, so the ladder constraints can be merged incrementally.
+//
+// With per-bidder increments each ladder is a congruence merged with the Chinese remainder
+// theorem. With a tiered increment schedule every ladder is an arithmetic progression of the
+// tier's increment within each tier, so bidders agree on a tier exactly when their residues do.
+//
+// The result matches the iterative strategy in every field except BiddingRounds and the audit
+// trail. The returned round count is the largest number of increments made by a single bidder,
+// which only bounds the iterative round count from below, since the iterative path lets each
+// bidder increment at most once per round.
+//
+// No rounds are simulated, so an audit log receives one round 0 entry per bidder that moved.
+func (be *BiddingEngine) resolveClosedForm(bidders []models.Bidder, audit *auditLog) (int, error) {
+	if len(bidders) <= 1 {
+		return 0, nil
+	}
+
+	var spans []tierSpan
+	if be.config.HasIncrementSchedule() {
+		tiered, ok := be.config.IncrementSchedule.(tieredSchedule)
+		if !ok {
+			inputErr := models.NewInputError("closed-form resolution requires a tiered increment schedule", "config.IncrementSchedule", fmt.Sprintf("%T", be.config.IncrementSchedule))
+			inputErr.WithOperation("resolveClosedForm").WithCode(models.CodeEngineStrategyUnsupported)
+			return 0, inputErr
+		}
+		spans = newTierSpans(tiered.Tiers())
+	}
+
+	ladders := make([]bidLadder, len(bidders))
+	var highestStart int64
+	for i := range bidders {
+		bidder := &bidders[i]
+		start := bidder.GetCurrentBidCents()
+		if start < 0 {
+			systemErr := models.NewSystemError("bidder has negative current bid", "BiddingEngine", "high")
+			systemErr.WithOperation("resolveClosedForm").WithCode(models.CodeEngineNegativeBid)
+			systemErr.AddContext("bidder_id", bidder.ID)
+			systemErr.AddContext("current_bid_cents", fmt.Sprintf("%d", start))
+			return 0, systemErr
+		}
+
+		if spans != nil {
+			ladders[i] = newTieredLadder(i, bidder, spans)
+		} else {
+			step := bidder.GetAutoIncrementCents()
+			ladders[i] = bidLadder{
+				index: i,
+				start: start,
+				step:  step,
+				top:   start + bidder.RemainingIncrements()*step,
+			}
+		}
+		if start > highestStart {
+			highestStart = start
+		}
+	}
+
+	// Highest reachable top first; bidders sharing a top join the same band
+	sort.Slice(ladders, func(i, j int) bool {
+		return ladders[i].top > ladders[j].top
+	})
+
+	var grid settlementGrid
+	if spans != nil {
+		grid = newTierGrid(spans)
+	} else {
+		grid = newPriceGrid(ladders[0].top)
+	}
+	settledPrice := int64(-1)
+
+	for i := 0; i < len(ladders); {
+		bandTop := ladders[i].top
+		if bandTop < highestStart {
+			break // Every remaining band lies below the opening price
+		}
+
+		// Add every bidder who can reach this band to the ladder constraint
+		for ; i < len(ladders) && ladders[i].top == bandTop; i++ {
+			if !grid.constrain(ladders[i]) {
+				break
+			}
+		}
+		if !grid.feasible() {
+			break // More bidders only add constraints, so lower bands are infeasible too
+		}
+
+		bandBottom := highestStart
+		if i < len(ladders) && ladders[i].top+1 > bandBottom {
+			bandBottom = ladders[i].top + 1
+		}
+
+		if price, ok := grid.smallestIn(bandBottom, bandTop); ok {
+			settledPrice = price
+		}
+	}
+
+	if settledPrice < 0 {
+		systemErr := models.NewSystemError("closed-form resolution found no settled price", "BiddingEngine", "critical")
+		systemErr.WithOperation("resolveClosedForm").WithCode(models.CodeEngineNoSettledPrice)
+		systemErr.AddContext("bidder_count", fmt.Sprintf("%d", len(bidders)))
+		systemErr.AddContext("highest_start_cents", fmt.Sprintf("%d", highestStart))
+		return 0, systemErr
+	}
+
+	startCents := make([]int64, len(bidders))
+	for _, ladder := range ladders {
+		startCents[ladder.index] = ladder.start
+	}
+
+	// Move every bidder to the settled price, or to its reachable top if that is lower
+	maxSteps := int64(0)
+	for _, ladder := range ladders {
+		target := ladder.top
+		if target > settledPrice {
+			target = settledPrice
+		}
+		if target == ladder.start {
+			continue
+		}
+
+		bidder := &bidders[ladder.index]
+		var steps int64
+		var moved bool
+		if spans != nil {
+			steps = ladderSteps(ladder.start, target, spans)
+			moved = bidder.AdvanceTo(target)
+		} else {
+			steps = (target - ladder.start) / ladder.step
+			moved = bidder.IncrementTimes(steps)
+		}
+		if !moved {
+			systemErr := models.NewSystemError("bidder increment failed during closed-form resolution", "BiddingEngine", "high")
+			systemErr.WithOperation("resolveClosedForm").WithCode(models.CodeEngineIncrementRejected)
+			systemErr.AddContext("bidder_id", bidder.ID)
+			systemErr.AddContext("steps", fmt.Sprintf("%d", steps))
+			systemErr.AddContext("settled_price_cents", fmt.Sprintf("%d", settledPrice))
+			return 0, systemErr
+		}
+		if steps > maxSteps {
+			maxSteps = steps
+		}
+	}
+
+	audit.recordSettlement(bidders, startCents)
+	return int(maxSteps), nil
+}
+
+// bidLadder describes the prices one bidder can reach during resolution
+type bidLadder struct {
+	index   int
+	start   int64   // Current bid in cents
+	step    int64   // Auto increment in cents (per-bidder increments only)
+	top     int64   // Highest bid reachable on the ladder
+	entries []int64 // First ladder price in each schedule tier, -1 if unreached or tierSkipped (schedules only)
+}
+
+// tierSkipped marks a schedule tier that a ladder jumps over without holding any price in it
+const tierSkipped int64 = -2
+
+// settlementGrid accumulates ladder constraints and finds prices that satisfy all of them
+type settlementGrid interface {
+	constrain(ladder bidLadder) bool
+	feasible() bool
+	smallestIn(low, high int64) (int64, bool)
+}
+
+// tieredSchedule is implemented by increment schedules that expose their tier table
+type tieredSchedule interface {
+	Tiers() []models.IncrementTier
+}
+
+// tierSpan is the price range [from, to) over which one schedule tier applies
+type tierSpan struct {
+	from int64
+	to   int64
+	step int64
+}
+
+// newTierSpans converts ascending schedule tiers into contiguous price ranges
+func newTierSpans(tiers []models.IncrementTier) []tierSpan {
+	spans := make([]tierSpan, len(tiers))
+	for i, tier := range tiers {
+		spans[i] = tierSpan{from: tier.FromCents, to: math.MaxInt64, step: tier.IncrementCents}
+		if i+1 < len(tiers) {
+			spans[i].to = tiers[i+1].FromCents
+		}
+	}
+	return spans
+}
+
+// newTieredLadder walks a bidder's ladder through the schedule tiers without visiting every price
+func newTieredLadder(index int, bidder *models.Bidder, spans []tierSpan) bidLadder {
+	start := bidder.GetCurrentBidCents()
+	limit := bidder.GetMaxBidCents()
+	if !bidder.IsActive || limit < start {
+		limit = start
+	}
+
+	ladder := bidLadder{index: index, start: start, top: start, entries: make([]int64, len(spans))}
+	for t := range ladder.entries {
+		ladder.entries[t] = -1
+	}
+
+	price := start
+	entered := false
+	for t, span := range spans {
+		if price >= span.to {
+			if entered {
+				ladder.entries[t] = tierSkipped // One increment from the tier below clears this tier
+			}
+			continue // Otherwise the ladder starts above this tier
+		}
+		if price > limit {
+			break
+		}
+
+		entered = true
+		ladder.entries[t] = price
+		last := limit
+		if span.to-1 < last {
+			last = span.to - 1
+		}
+		ladder.top = price + (last-price)/span.step*span.step
+
+		if span.to == math.MaxInt64 {
+			break
+		}
+		// First ladder price at or above the next tier
+		price += ceilDiv(span.to-price, span.step) * span.step
+	}
+
+	return ladder
+}
+
+// ladderSteps counts the increments needed to climb a tiered ladder from start to target
+func ladderSteps(start, target int64, spans []tierSpan) int64 {
+	var steps int64
+	price := start
+	for _, span := range spans {
+		if price >= span.to {
+			continue
+		}
+		if target < span.to {
+			return steps + (target-price)/span.step
+		}
+		k := ceilDiv(span.to-price, span.step)
+		steps += k
+		price += k * span.step
+	}
+	return steps
+}
+
+// ceilDiv divides two positive integers rounding up
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+// tierGrid tracks, per schedule tier, whether every merged ladder shares one residue and
+// the highest price at which a merged ladder enters the tier. A ladder entering from the tier
+// below can overshoot the tier start by up to one lower-tier increment.
+type tierGrid struct {
+	spans    []tierSpan
+	residue  []int64
+	entry    []int64
+	seen     []bool
+	conflict []bool
+}
+
+// newTierGrid creates an unconstrained grid over the given tiers
+func newTierGrid(spans []tierSpan) *tierGrid {
+	return &tierGrid{
+		spans:    spans,
+		residue:  make([]int64, len(spans)),
+		entry:    make([]int64, len(spans)),
+		seen:     make([]bool, len(spans)),
+		conflict: make([]bool, len(spans)),
+	}
+}
+
+// constrain merges a ladder's per-tier residues into the grid
+// A tier the ladder jumps over holds none of its prices, so no price in it can settle
+func (tg *tierGrid) constrain(ladder bidLadder) bool {
+	for t, entry := range ladder.entries {
+		if entry == tierSkipped {
+			tg.conflict[t] = true
+			continue
+		}
+		if entry < 0 {
+			continue
+		}
+		r := entry % tg.spans[t].step
+		if !tg.seen[t] {
+			tg.seen[t] = true
+			tg.residue[t] = r
+		} else if tg.residue[t] != r {
+			tg.conflict[t] = true
+		}
+		if entry > tg.entry[t] {
+			tg.entry[t] = entry
+		}
+	}
+	return true
+}
+
+// feasible always reports true; conflicts are tracked per tier
+func (tg *tierGrid) feasible() bool {
+	return true
+}
+
+// smallestIn returns the smallest price in [low, high] shared by every merged ladder
+func (tg *tierGrid) smallestIn(low, high int64) (int64, bool) {
+	for t, span := range tg.spans {
+		if span.to <= low {
+			continue
+		}
+		if span.from > high {
+			break
+		}
+		if tg.conflict[t] || !tg.seen[t] {
+			continue
+		}
+
+		lo := low
+		if tg.entry[t] > lo {
+			lo = tg.entry[t]
+		}
+		candidate := lo + ((tg.residue[t]-lo)%span.step+span.step)%span.step
+		if candidate < span.to && candidate <= high {
+			return candidate, true
+		}
+	}
+	return 0, false
+}
+
+// priceGrid tracks the set of prices satisfying price ≡ residue (mod modulus) for all merged
+// bidder grids. Once the modulus exceeds the largest reachable price, at most one candidate
+// remains and the grid is pinned to it, which keeps the arithmetic bounded.
+type priceGrid struct {
+	residue    *big.Int
+	modulus    *big.Int
+	limit      *big.Int
+	pinned     bool
+	infeasible bool
+}
+
+// newPriceGrid creates an unconstrained grid for prices up to limit
+func newPriceGrid(limit int64) *priceGrid {
+	return &priceGrid{
+		residue: big.NewInt(0),
+		modulus: big.NewInt(1),
+		limit:   big.NewInt(limit),
+	}
+}
+
+// feasible reports whether any price still satisfies every merged constraint
+func (pg *priceGrid) feasible() bool {
+	return !pg.infeasible
+}
+
+// constrain merges the ladder start + k*step into the constraint set
+// Returns false when the constraints can no longer be satisfied
+func (pg *priceGrid) constrain(ladder bidLadder) bool {
+	start, step := ladder.start, ladder.step
+	if pg.infeasible {
+		return false
+	}
+	if step <= 0 {
+		// A bidder that cannot increment is only bounded by its reachable top
+		return true
+	}
+
+	b := big.NewInt(start)
+	n := big.NewInt(step)
+
+	if pg.pinned {
+		if new(big.Int).Mod(new(big.Int).Sub(pg.residue, b), n).Sign() != 0 {
+			pg.infeasible = true
+		}
+		return !pg.infeasible
+	}
+
+	// Solve x ≡ residue (mod modulus), x ≡ b (mod n)
+	g := new(big.Int).GCD(nil, nil, pg.modulus, n)
+	diff := new(big.Int).Sub(b, pg.residue)
+	if new(big.Int).Mod(diff, g).Sign() != 0 {
+		pg.infeasible = true
+		return false
+	}
+
+	reducedModulus := new(big.Int).Quo(pg.modulus, g)
+	reducedN := new(big.Int).Quo(n, g)
+	k := new(big.Int).Quo(diff, g)
+	if reducedN.Cmp(big.NewInt(1)) != 0 {
+		inverse := new(big.Int).ModInverse(new(big.Int).Mod(reducedModulus, reducedN), reducedN)
+		k.Mul(k, inverse)
+		k.Mod(k, reducedN)
+	} else {
+		k.SetInt64(0)
+	}
+
+	newModulus := new(big.Int).Mul(reducedModulus, n)
+	pg.residue.Add(pg.residue, k.Mul(k, pg.modulus))
+	pg.residue.Mod(pg.residue, newModulus)
+	pg.modulus = newModulus
+
+	if pg.modulus.Cmp(pg.limit) > 0 {
+		// Only the smallest non-negative solution can still be a reachable price
+		pg.pinned = true
+		if pg.residue.Cmp(pg.limit) > 0 {
+			pg.infeasible = true
+		}
+	}
+
+	return !pg.infeasible
+}
+
+// smallestIn returns the smallest price in [low, high] satisfying every merged constraint
+func (pg *priceGrid) smallestIn(low, high int64) (int64, bool) {
+	if pg.infeasible || low > high {
+		return 0, false
+	}
+
+	lo := big.NewInt(low)
+	// candidate = low + ((residue - low) mod modulus)
+	candidate := new(big.Int).Sub(pg.residue, lo)
+	candidate.Mod(candidate, pg.modulus)
+	candidate.Add(candidate, lo)
+
+	if candidate.Cmp(big.NewInt(high)) > 0 {
+		return 0, false
+	}
+	return candidate.Int64(), true
+}
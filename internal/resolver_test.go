@@ -0,0 +1,182 @@
+package internal
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+func TestNewBiddingEngine_WithStrategy(t *testing.T) {
+	engine := NewBiddingEngine(WithStrategy(StrategyClosedForm))
+	if engine.Strategy() != StrategyClosedForm {
+		t.Errorf("Expected closed-form strategy, got %s", engine.Strategy())
+	}
+	if engine.maxRounds != 1000 {
+		t.Errorf("Expected maxRounds to be 1000, got %d", engine.maxRounds)
+	}
+
+	if NewBiddingEngine().Strategy() != StrategyIterative {
+		t.Error("Expected default engine to use the iterative strategy")
+	}
+}
+
+func TestResolutionStrategy_String(t *testing.T) {
+	tests := map[ResolutionStrategy]string{
+		StrategyIterative:      "iterative",
+		StrategyClosedForm:     "closed_form",
+		ResolutionStrategy(42): "unknown(42)",
+	}
+	for strategy, expected := range tests {
+		if strategy.String() != expected {
+			t.Errorf("Expected %q, got %q", expected, strategy.String())
+		}
+	}
+}
+
+// TestClosedForm_TinyIncrementDoesNotTimeout tests that the closed-form resolver settles
+// auctions the iterative path gives up on
+func TestClosedForm_TinyIncrementDoesNotTimeout(t *testing.T) {
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		*models.NewBidder("1", "Alice", models.Dollars(1.00), models.Dollars(5000.00), models.Dollars(0.02)),
+		*models.NewBidder("2", "Bob", models.Dollars(1.01), models.Dollars(4999.00), models.Dollars(0.02)),
+	}
+	bidders[0].EntryTime = baseTime
+	bidders[1].EntryTime = baseTime.Add(time.Second)
+
+	_, err := NewBiddingEngine().ProcessBids(bidders)
+	if _, ok := err.(*models.TimeoutError); !ok {
+		t.Fatalf("Expected iterative engine to time out, got %v", err)
+	}
+
+	result, err := NewBiddingEngine(WithStrategy(StrategyClosedForm)).ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner == nil || result.Winner.ID != "1" {
+		t.Fatalf("Expected Alice to win, got %+v", result.Winner)
+	}
+	// Bob's max plus Alice's increment
+	if result.GetWinningBidCents() != 499902 {
+		t.Errorf("Expected winning bid 499902 cents, got %d", result.GetWinningBidCents())
+	}
+}
+
+// TestClosedForm_TieAtSharedGridPoint tests that bidders settle on the first shared price
+func TestClosedForm_TieAtSharedGridPoint(t *testing.T) {
+	baseTime := time.Now()
+	bidders := []models.Bidder{
+		*models.NewBidder("1", "Alice", models.Dollars(100.0), models.Dollars(200.0), models.Dollars(20.0)),
+		*models.NewBidder("2", "Bob", models.Dollars(110.0), models.Dollars(180.0), models.Dollars(15.0)),
+	}
+	bidders[0].EntryTime = baseTime
+	bidders[1].EntryTime = baseTime.Add(time.Second)
+
+	result, err := NewBiddingEngine(WithStrategy(StrategyClosedForm)).ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	for _, bidder := range result.AllBidders {
+		if bidder.GetCurrentBidCents() != 14000 {
+			t.Errorf("Expected %s to settle at 14000 cents, got %d", bidder.Name, bidder.GetCurrentBidCents())
+		}
+	}
+	if result.Winner.ID != "1" {
+		t.Errorf("Expected earlier entry Alice to win the tie, got %s", result.Winner.Name)
+	}
+}
+
+// TestClosedForm_MatchesIterative is a differential test that runs both strategies over
+// randomized auctions and requires identical results apart from the round count, which for the
+// closed-form strategy only bounds the iterative one from below
+func TestClosedForm_MatchesIterative(t *testing.T) {
+	rng := rand.New(rand.NewSource(20240601))
+	iterative := &BiddingEngine{maxRounds: 1000000, strategy: StrategyIterative}
+	closedForm := &BiddingEngine{maxRounds: 1000000, strategy: StrategyClosedForm}
+	baseTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for trial := 0; trial < 2000; trial++ {
+		bidders := randomBidders(rng, baseTime)
+
+		expected, err := iterative.ProcessBids(bidders)
+		if err != nil {
+			t.Fatalf("trial %d: iterative strategy failed: %v", trial, err)
+		}
+		actual, err := closedForm.ProcessBids(bidders)
+		if err != nil {
+			t.Fatalf("trial %d: closed-form strategy failed: %v", trial, err)
+		}
+
+		if diff := compareResults(expected, actual); diff != "" {
+			t.Fatalf("trial %d: strategies disagree: %s\nbidders: %+v", trial, diff, bidders)
+		}
+		if actual.BiddingRounds > expected.BiddingRounds {
+			t.Fatalf("trial %d: closed-form rounds %d exceed iterative rounds %d", trial, actual.BiddingRounds, expected.BiddingRounds)
+		}
+	}
+}
+
+// randomBidders builds a small auction with amounts on a coarse grid so ties are common
+func randomBidders(rng *rand.Rand, baseTime time.Time) []models.Bidder {
+	count := 1 + rng.Intn(6)
+	bidders := make([]models.Bidder, count)
+	for i := range bidders {
+		startCents := int64(100 + rng.Intn(40)*25)
+		maxCents := startCents + int64(rng.Intn(80)*25)
+		incrementCents := int64(1 + rng.Intn(60))
+
+		bidder := models.NewBidder(
+			fmt.Sprintf("bidder%d", i),
+			fmt.Sprintf("Bidder %d", i),
+			models.NewMoney(startCents, models.USD),
+			models.NewMoney(maxCents, models.USD),
+			models.NewMoney(incrementCents, models.USD),
+		)
+		// Occasionally share an entry time to exercise tie-breaking
+		bidder.EntryTime = baseTime.Add(time.Duration(rng.Intn(count)) * time.Second)
+		bidders[i] = *bidder
+	}
+	return bidders
+}
+
+// compareResults returns a description of the first difference between two results
+// BiddingRounds and AuditTrail are left out: the closed-form strategy does not simulate rounds
+func compareResults(expected, actual *models.BidResult) string {
+	if (expected.Winner == nil) != (actual.Winner == nil) {
+		return fmt.Sprintf("winner presence: %v vs %v", expected.Winner != nil, actual.Winner != nil)
+	}
+	if expected.Winner != nil && expected.Winner.ID != actual.Winner.ID {
+		return fmt.Sprintf("winner: %s vs %s", expected.Winner.ID, actual.Winner.ID)
+	}
+	if expected.GetWinningBidCents() != actual.GetWinningBidCents() {
+		return fmt.Sprintf("winning bid: %d vs %d", expected.GetWinningBidCents(), actual.GetWinningBidCents())
+	}
+	if len(expected.AllBidders) != len(actual.AllBidders) {
+		return fmt.Sprintf("bidder count: %d vs %d", len(expected.AllBidders), len(actual.AllBidders))
+	}
+	for i := range expected.AllBidders {
+		e, a := expected.AllBidders[i], actual.AllBidders[i]
+		if e.ID != a.ID {
+			return fmt.Sprintf("bidder order at %d: %s vs %s", i, e.ID, a.ID)
+		}
+		if e.GetCurrentBidCents() != a.GetCurrentBidCents() {
+			return fmt.Sprintf("bidder %s current bid: %d vs %d", e.ID, e.GetCurrentBidCents(), a.GetCurrentBidCents())
+		}
+		if e.IsActive != a.IsActive {
+			return fmt.Sprintf("bidder %s active: %v vs %v", e.ID, e.IsActive, a.IsActive)
+		}
+	}
+
+	e, a := *expected, *actual
+	e.BiddingRounds, a.BiddingRounds = 0, 0
+	e.AuditTrail, a.AuditTrail = nil, nil
+	if !reflect.DeepEqual(e, a) {
+		return fmt.Sprintf("result: %+v vs %+v", e, a)
+	}
+	return ""
+}
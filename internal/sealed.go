@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"context"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// sealedPricing returns the price in cents the winner of a sealed-bid auction pays
+// It is only called once the winner's bid meets the reserve
+type sealedPricing func(be *BiddingEngine, bidders []models.Bidder, winner *models.Bidder) int64
+
+// sealedBidFormat settles one sealed bid per bidder: MaxBid is the bid, and nobody raises it
+type sealedBidFormat struct {
+	name  models.AuctionFormat
+	price sealedPricing
+}
+
+// NewFirstPriceSealedFormat creates the first-price sealed-bid format: the highest sealed bid wins and pays its own bid
+// Ties go to the engine's tie-breaker, and a winning bid below the reserve leaves the lot unsold
+func NewFirstPriceSealedFormat() Format {
+	return sealedBidFormat{name: models.FormatFirstPriceSealed, price: firstPrice}
+}
+
+// NewVickreyFormat creates the sealed second-price format: the highest sealed bid wins and pays the
+// second-highest bid (plus one tick under SecondPricePlusTick), or the reserve if that is higher
+// An empty rule means SecondPriceExact
+func NewVickreyFormat(rule models.SecondPriceRule) (Format, error) {
+	if !rule.IsValid() {
+		inputErr := models.NewInputError("unsupported second-price rule", "rule", string(rule))
+		inputErr.WithOperation("NewVickreyFormat").WithCode(models.CodeEnginePricingUnsupported)
+		return nil, inputErr
+	}
+	return newVickreyFormat(rule), nil
+}
+
+// newVickreyFormat creates the sealed second-price format for a known rule
+func newVickreyFormat(rule models.SecondPriceRule) Format {
+	return sealedBidFormat{name: models.FormatVickrey, price: secondPrice(rule)}
+}
+
+// Name returns the format's name
+func (sf sealedBidFormat) Name() models.AuctionFormat {
+	return sf.name
+}
+
+// Settle opens the sealed bids and awards the lot to the highest
+func (sf sealedBidFormat) Settle(ctx context.Context, be *BiddingEngine, bidders []models.Bidder) (*models.BidResult, error) {
+	for i := range bidders {
+		bidders[i].CurrentBid = bidders[i].MaxBid
+	}
+
+	winner, tieBreak, err := be.findWinnerWithRule(bidders)
+	if err != nil {
+		return nil, winnerError(err, len(bidders), sf.name)
+	}
+	if !be.config.ReserveMetBy(winner.GetMaxBidCents()) {
+		result := models.NewBidResultFromCents(nil, 0, len(bidders), 0, bidders)
+		result.TieBreak = tieBreak
+		return result.WithReserve(be.config.ReservePriceCents, false), nil
+	}
+
+	result := models.NewBidResultFromCents(winner, sf.price(be, bidders, winner), len(bidders), 0, bidders)
+	result.TieBreak = tieBreak
+	return result.WithReserve(be.config.ReservePriceCents, true), nil
+}
+
+// firstPrice charges the winner their own bid
+func firstPrice(be *BiddingEngine, bidders []models.Bidder, winner *models.Bidder) int64 {
+	return winner.GetMaxBidCents()
+}
+
+// secondPrice charges the winner the highest rival bid under the given rule, raised to the reserve and capped at
+// their own bid. A winner without rivals pays their starting bid or the reserve, as in English auctions
+func secondPrice(rule models.SecondPriceRule) sealedPricing {
+	return func(be *BiddingEngine, bidders []models.Bidder, winner *models.Bidder) int64 {
+		secondCents, rivals := int64(0), false
+		for _, bidder := range bidders {
+			if bidder.ID == winner.ID {
+				continue
+			}
+			if !rivals || bidder.GetMaxBidCents() > secondCents {
+				secondCents = bidder.GetMaxBidCents()
+				rivals = true
+			}
+		}
+		if !rivals {
+			return be.applyReserveCents(winner.GetStartingBidCents(), winner)
+		}
+
+		priceCents := secondCents
+		if rule == models.SecondPricePlusTick {
+			priceCents += be.tickCents(secondCents)
+		}
+		priceCents = be.applyReserveCents(priceCents, winner)
+		if priceCents > winner.GetMaxBidCents() {
+			priceCents = winner.GetMaxBidCents()
+		}
+		return priceCents
+	}
+}
+
+// tickCents returns the smallest price step at a price: the increment schedule's tier when one is
+// configured, otherwise one minor unit of the settlement currency
+func (be *BiddingEngine) tickCents(priceCents int64) int64 {
+	if be.config.HasIncrementSchedule() {
+		return be.config.IncrementSchedule.IncrementAt(priceCents)
+	}
+	return 1
+}
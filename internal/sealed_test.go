@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// sealedBid returns a sealed bid of amount dollars, entered seconds after a fixed base time
+func sealedBid(id string, amount float64, seconds int) models.Bidder {
+	bid := models.Dollars(amount)
+	return models.Bidder{
+		ID:          id,
+		Name:        id,
+		StartingBid: bid,
+		MaxBid:      bid,
+		EntryTime:   time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC).Add(time.Duration(seconds) * time.Second),
+	}
+}
+
+func TestFirstPriceSealed_ProcessBids(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        models.AuctionConfig
+		bidders       []models.Bidder
+		breaker       models.TieBreaker
+		expectedID    string // Empty when the lot goes unsold
+		expectedCents int64
+		expectedRule  models.TieBreakRule
+	}{
+		{
+			name:          "highest bid pays its own amount",
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1), sealedBid("carol", 90, 2)},
+			expectedID:    "bob",
+			expectedCents: 15000,
+		},
+		{
+			name:          "tie goes to the earliest entry",
+			bidders:       []models.Bidder{sealedBid("alice", 150, 1), sealedBid("bob", 150, 0)},
+			expectedID:    "bob",
+			expectedCents: 15000,
+			expectedRule:  models.TieBreakEarliestEntry,
+		},
+		{
+			name:          "tie goes to the configured tie-breaker",
+			bidders:       []models.Bidder{sealedBid("alice", 150, 1), sealedBid("bob", 150, 0)},
+			breaker:       models.NewSeededRandomTieBreaker(7),
+			expectedCents: 15000,
+			expectedRule:  models.TieBreakRandom,
+		},
+		{
+			name:          "reserve met",
+			config:        models.AuctionConfig{ReservePriceCents: 14000},
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1)},
+			expectedID:    "bob",
+			expectedCents: 15000,
+		},
+		{
+			name:    "reserve not met",
+			config:  models.AuctionConfig{ReservePriceCents: 20000},
+			bidders: []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := NewBiddingEngine(WithConfig(tt.config), WithFormat(NewFirstPriceSealedFormat()), WithTieBreaker(tt.breaker))
+			result, err := engine.ProcessBids(tt.bidders)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result.Format != models.FormatFirstPriceSealed {
+				t.Errorf("Expected format %s, got %q", models.FormatFirstPriceSealed, result.Format)
+			}
+			if result.BiddingRounds != 0 {
+				t.Errorf("Expected no bidding rounds, got %d", result.BiddingRounds)
+			}
+			if result.TieBreak != tt.expectedRule {
+				t.Errorf("Expected tie-break rule %q, got %q", tt.expectedRule, result.TieBreak)
+			}
+
+			if tt.expectedCents == 0 {
+				if result.Winner != nil || result.ReserveMet {
+					t.Errorf("Expected the lot to go unsold, got %+v", result.Winner)
+				}
+				return
+			}
+			if result.Winner == nil || (tt.expectedID != "" && result.Winner.ID != tt.expectedID) {
+				t.Fatalf("Expected %q to win, got %+v", tt.expectedID, result.Winner)
+			}
+			if result.GetWinningBidCents() != tt.expectedCents {
+				t.Errorf("Expected winning bid %d cents, got %d", tt.expectedCents, result.GetWinningBidCents())
+			}
+		})
+	}
+}
+
+func TestFirstPriceSealed_MultiCurrency(t *testing.T) {
+	config := models.NewAuctionConfigWithSettlement(models.USD, currencyTestRates())
+	config.Format = models.FormatFirstPriceSealed
+	engine := NewBiddingEngine(WithConfig(config))
+
+	// Alice's 200.00 EUR is 220.00 USD, above Bob's 150.00 GBP (187.50 USD)
+	bidders := []models.Bidder{
+		{ID: "1", Name: "Alice", StartingBid: models.NewMoney(20000, models.EUR), MaxBid: models.NewMoney(20000, models.EUR)},
+		{ID: "2", Name: "Bob", StartingBid: models.NewMoney(15000, models.GBP), MaxBid: models.NewMoney(15000, models.GBP)},
+	}
+	result, err := engine.ProcessBids(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner == nil || result.Winner.ID != "1" {
+		t.Fatalf("Expected Alice to win, got %+v", result.Winner)
+	}
+	if !result.WinningBid.Equal(models.NewMoney(22000, models.USD)) {
+		t.Errorf("Expected winning bid 220.00 USD, got %s", result.WinningBid)
+	}
+	if !result.WinningBidLocal.Equal(models.NewMoney(20000, models.EUR)) {
+		t.Errorf("Expected local winning bid 200.00 EUR, got %s", result.WinningBidLocal)
+	}
+}
+
+func TestVickrey_ProcessBids(t *testing.T) {
+	tests := []struct {
+		name          string
+		rule          models.SecondPriceRule
+		config        models.AuctionConfig
+		bidders       []models.Bidder
+		expectedID    string // Empty when the lot goes unsold
+		expectedCents int64
+	}{
+		{
+			name:          "winner pays the second-highest bid",
+			rule:          models.SecondPriceExact,
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1), sealedBid("carol", 90, 2)},
+			expectedID:    "bob",
+			expectedCents: 12000,
+		},
+		{
+			name:          "empty rule is exact",
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1)},
+			expectedID:    "bob",
+			expectedCents: 12000,
+		},
+		{
+			name:          "plus one minor unit",
+			rule:          models.SecondPricePlusTick,
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1)},
+			expectedID:    "bob",
+			expectedCents: 12001,
+		},
+		{
+			name:          "plus one schedule tier",
+			rule:          models.SecondPricePlusTick,
+			config:        models.NewAuctionConfigWithSchedule(models.DefaultIncrementSchedule()),
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1)},
+			expectedID:    "bob",
+			expectedCents: 12250,
+		},
+		{
+			name:          "tick never exceeds the winning bid",
+			rule:          models.SecondPricePlusTick,
+			config:        models.NewAuctionConfigWithSchedule(models.DefaultIncrementSchedule()),
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 121, 1)},
+			expectedID:    "bob",
+			expectedCents: 12100,
+		},
+		{
+			name:          "tie pays the tied bid",
+			rule:          models.SecondPricePlusTick,
+			bidders:       []models.Bidder{sealedBid("alice", 150, 1), sealedBid("bob", 150, 0)},
+			expectedID:    "bob",
+			expectedCents: 15000,
+		},
+		{
+			name:          "reserve above the second price",
+			rule:          models.SecondPriceExact,
+			config:        models.NewAuctionConfigWithReserve(14000),
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1)},
+			expectedID:    "bob",
+			expectedCents: 14000,
+		},
+		{
+			name:          "reserve below the second price",
+			rule:          models.SecondPriceExact,
+			config:        models.NewAuctionConfigWithReserve(10000),
+			bidders:       []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1)},
+			expectedID:    "bob",
+			expectedCents: 12000,
+		},
+		{
+			name:    "reserve not met",
+			rule:    models.SecondPriceExact,
+			config:  models.NewAuctionConfigWithReserve(20000),
+			bidders: []models.Bidder{sealedBid("alice", 120, 0), sealedBid("bob", 150, 1)},
+		},
+		{
+			name:          "lone bidder pays the reserve",
+			rule:          models.SecondPriceExact,
+			config:        models.NewAuctionConfigWithReserve(5000),
+			bidders:       []models.Bidder{{ID: "bob", Name: "bob", StartingBid: models.Dollars(10), MaxBid: models.Dollars(150)}},
+			expectedID:    "bob",
+			expectedCents: 5000,
+		},
+		{
+			name:          "lone bidder without a reserve pays the starting bid",
+			rule:          models.SecondPriceExact,
+			bidders:       []models.Bidder{{ID: "bob", Name: "bob", StartingBid: models.Dollars(10), MaxBid: models.Dollars(150)}},
+			expectedID:    "bob",
+			expectedCents: 1000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := NewVickreyFormat(tt.rule)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			result, err := NewBiddingEngine(WithConfig(tt.config), WithFormat(format)).ProcessBids(tt.bidders)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result.Format != models.FormatVickrey {
+				t.Errorf("Expected format %s, got %q", models.FormatVickrey, result.Format)
+			}
+
+			if tt.expectedID == "" {
+				if result.Winner != nil || result.ReserveMet {
+					t.Errorf("Expected the lot to go unsold, got %+v", result.Winner)
+				}
+				return
+			}
+			if result.Winner == nil || result.Winner.ID != tt.expectedID {
+				t.Fatalf("Expected %q to win, got %+v", tt.expectedID, result.Winner)
+			}
+			if result.GetWinningBidCents() != tt.expectedCents {
+				t.Errorf("Expected winning bid %d cents, got %d", tt.expectedCents, result.GetWinningBidCents())
+			}
+		})
+	}
+}
+
+// TestVickrey_IncentiveCompatibility checks that no bid earns a bidder more than bidding their true value
+// A bidder's payoff is their value less the price when they win, and nothing when they lose
+func TestVickrey_IncentiveCompatibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   float64
+		rivals  []float64
+		reserve int64
+	}{
+		{"value above every rival", 150, []float64{120, 90}, 0},
+		{"value below the top rival", 100, []float64{120, 90}, 0},
+		{"value ties the top rival", 120, []float64{120, 90}, 0},
+		{"value just above the top rival", 120.01, []float64{120}, 0},
+		{"reserve between rival and value", 150, []float64{120}, 14000},
+		{"reserve above the value", 150, []float64{120}, 16000},
+	}
+	deviations := []float64{0.01, 50, 89.99, 100, 119.99, 120, 120.01, 130, 149.99, 150, 150.01, 200, 1000}
+
+	payoff := func(t *testing.T, bid, value float64, rivals []float64, reserve int64) int64 {
+		// The bidder enters last, so ties go to the rival and shading to a tie never pays
+		bidders := make([]models.Bidder, 0, len(rivals)+1)
+		for i, rival := range rivals {
+			bidders = append(bidders, sealedBid(string(rune('a'+i)), rival, i))
+		}
+		bidders = append(bidders, sealedBid("bidder", bid, len(rivals)))
+
+		result, err := NewBiddingEngine(WithConfig(models.NewAuctionConfigWithReserve(reserve)), WithFormat(newVickreyFormat(models.SecondPriceExact))).ProcessBids(bidders)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result.Winner == nil || result.Winner.ID != "bidder" {
+			return 0
+		}
+		return models.DollarsToCents(value) - result.GetWinningBidCents()
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			truthful := payoff(t, tt.value, tt.value, tt.rivals, tt.reserve)
+			if truthful < 0 {
+				t.Errorf("Expected bidding the true value never to lose money, got %d cents", truthful)
+			}
+			for _, bid := range deviations {
+				if deviated := payoff(t, bid, tt.value, tt.rivals, tt.reserve); deviated > truthful {
+					t.Errorf("Expected bidding %.2f to earn no more than the truthful %d cents, got %d", bid, truthful, deviated)
+				}
+			}
+		})
+	}
+}
+
+func TestNewVickreyFormat_UnsupportedRule(t *testing.T) {
+	format, err := NewVickreyFormat("third_price")
+	if format != nil || !errors.Is(err, models.ErrInput) {
+		t.Fatalf("Expected an input error, got %v and %v", format, err)
+	}
+	if !models.HasCode(err, models.CodeEnginePricingUnsupported) {
+		t.Errorf("Expected code %s, got %s", models.CodeEnginePricingUnsupported, models.CodeOf(err))
+	}
+}
@@ -0,0 +1,166 @@
+package validation
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// Built-in auction rule names, as used in policy files
+const (
+	RuleDuplicateName = "duplicate_name"
+	RuleMaxBidOutlier = "max_bid_outlier"
+	RuleEntryTime     = "entry_time"
+	RuleOpeningPrice  = "opening_price"
+)
+
+// minOutlierSample is the fewest maximum bids in one currency that the outlier rule compares
+// With fewer there is no distribution to be outside of
+const minOutlierSample = 3
+
+// AuctionRule is a named check of the bid set as a whole
+type AuctionRule interface {
+	// Name identifies the rule in policies and in the errors it reports
+	Name() string
+	// CheckAuction returns the violations found in the bid set, positioned by bidder, or nil if it passes
+	CheckAuction(bidders []models.Bidder) []*models.ValidationError
+}
+
+// funcAuctionRule adapts a function to the AuctionRule interface
+type funcAuctionRule struct {
+	name  string
+	check func(bidders []models.Bidder) []*models.ValidationError
+}
+
+func (fr funcAuctionRule) Name() string { return fr.name }
+
+func (fr funcAuctionRule) CheckAuction(bidders []models.Bidder) []*models.ValidationError {
+	return fr.check(bidders)
+}
+
+// NewAuctionRule creates an AuctionRule from a check function
+func NewAuctionRule(name string, check func(bidders []models.Bidder) []*models.ValidationError) AuctionRule {
+	return funcAuctionRule{name: name, check: check}
+}
+
+// NewDuplicateNameRule reports bidders whose name is already registered under another ID
+// Names are compared ignoring case and surrounding space; the first bidder to use a name keeps it
+func NewDuplicateNameRule() AuctionRule {
+	return NewAuctionRule(RuleDuplicateName, func(bidders []models.Bidder) []*models.ValidationError {
+		var violations []*models.ValidationError
+		firstID := make(map[string]string)
+		for i, bidder := range bidders {
+			name := strings.ToLower(strings.TrimSpace(bidder.Name))
+			if name == "" {
+				continue
+			}
+			owner, seen := firstID[name]
+			if !seen {
+				firstID[name] = bidder.ID
+				continue
+			}
+			if owner != bidder.ID {
+				violation := models.NewLocalizedValidationError(bidder.ID, "Name", models.MsgBidderDuplicateName, bidder.Name, owner)
+				violations = append(violations, violation.WithCode(models.CodeBidderDuplicateName).WithPosition(i+1))
+			}
+		}
+		return violations
+	})
+}
+
+// NewMaxBidOutlierRule reports maximum bids more than factor times above or below the median maximum bid
+// Bids are compared with others in the same currency, and only when there are at least three of them
+func NewMaxBidOutlierRule(factor float64) (AuctionRule, error) {
+	if factor <= 1 {
+		inputErr := models.NewInputError("outlier factor must be greater than 1", "factor", factor)
+		inputErr.WithOperation("NewMaxBidOutlierRule").WithCode(models.CodePolicyInvalidRule)
+		return nil, inputErr
+	}
+	return NewAuctionRule(RuleMaxBidOutlier, func(bidders []models.Bidder) []*models.ValidationError {
+		byCurrency := make(map[models.Currency][]int64)
+		for _, bidder := range bidders {
+			currency := bidder.MaxBid.Currency()
+			byCurrency[currency] = append(byCurrency[currency], bidder.MaxBid.Amount())
+		}
+		medians := make(map[models.Currency]int64, len(byCurrency))
+		for currency, amounts := range byCurrency {
+			if len(amounts) >= minOutlierSample {
+				medians[currency] = median(amounts)
+			}
+		}
+
+		var violations []*models.ValidationError
+		for i, bidder := range bidders {
+			middle, ok := medians[bidder.MaxBid.Currency()]
+			if !ok || middle <= 0 {
+				continue
+			}
+			amount := float64(bidder.MaxBid.Amount())
+			if amount > float64(middle)*factor || amount*factor < float64(middle) {
+				limit := models.NewMoney(middle, bidder.MaxBid.Currency()).Decimal()
+				violation := models.NewLocalizedValidationError(bidder.ID, "MaxBid", models.MsgBidMaxOutlier, bidder.MaxBid.Decimal(), limit)
+				violations = append(violations, violation.WithCode(models.CodeBidMaxOutlier).WithPosition(i+1))
+			}
+		}
+		return violations
+	}), nil
+}
+
+// median returns the middle amount, or the mean of the two middle amounts, without reordering amounts
+func median(amounts []int64) int64 {
+	sorted := append([]int64(nil), amounts...)
+	sort.Slice(sorted, func(a, b int) bool { return sorted[a] < sorted[b] })
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+	return (sorted[middle-1] + sorted[middle]) / 2
+}
+
+// NewEntryTimeRule rejects entry times later than the clock's current time or, when closeTime is set, the auction close
+// Bidders without an entry time are not checked; a nil clock reads the system clock
+func NewEntryTimeRule(clock models.Clock, closeTime time.Time) Rule {
+	if clock == nil {
+		clock = models.NewSystemClock()
+	}
+	return NewRule(RuleEntryTime, func(bidder models.Bidder) *models.ValidationError {
+		if bidder.EntryTime.IsZero() {
+			return nil
+		}
+		entry := bidder.EntryTime.Format(time.RFC3339Nano)
+		if !closeTime.IsZero() && bidder.EntryTime.After(closeTime) {
+			return models.NewLocalizedValidationError(bidder.ID, "EntryTime", models.MsgEntryAfterClose, entry, closeTime.Format(time.RFC3339Nano)).WithCode(models.CodeBidEntryAfterClose)
+		}
+		if now := clock.Now(); bidder.EntryTime.After(now) {
+			return models.NewLocalizedValidationError(bidder.ID, "EntryTime", models.MsgEntryInFuture, entry, now.Format(time.RFC3339Nano)).WithCode(models.CodeBidEntryInFuture)
+		}
+		return nil
+	})
+}
+
+// NewOpeningPriceRule reports a bid set in which no maximum bid reaches the lot's opening price
+// Only bids in the opening price's currency are considered; a set without any is not checked
+func NewOpeningPriceRule(opening models.Money) AuctionRule {
+	return NewAuctionRule(RuleOpeningPrice, func(bidders []models.Bidder) []*models.ValidationError {
+		var highest *models.Money
+		for i := range bidders {
+			maxBid := bidders[i].MaxBid
+			if !maxBid.SameCurrency(opening) {
+				continue
+			}
+			if maxBid.Amount() >= opening.Amount() {
+				return nil
+			}
+			if highest == nil || maxBid.Amount() > highest.Amount() {
+				highest = &maxBid
+			}
+		}
+		if highest == nil {
+			return nil
+		}
+		violation := models.NewLocalizedValidationError("", "MaxBid", models.MsgAuctionBelowOpening, highest.Decimal(), opening.Decimal())
+		return []*models.ValidationError{violation.WithCode(models.CodeAuctionBelowOpeningPrice)}
+	})
+}
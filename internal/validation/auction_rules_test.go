@@ -0,0 +1,181 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/clocktest"
+	"auction-bidding-algorithm/internal/models"
+)
+
+func TestDuplicateNameRule(t *testing.T) {
+	rule := NewDuplicateNameRule()
+	if rule.Name() != RuleDuplicateName {
+		t.Errorf("Expected rule name %q, got %q", RuleDuplicateName, rule.Name())
+	}
+
+	bidders := []models.Bidder{
+		ruleBidder("b1", "Alice", 50, 100, 5),
+		ruleBidder("b2", "Bob", 50, 100, 5),
+		ruleBidder("b3", " alice ", 50, 100, 5),
+		ruleBidder("b4", "ALICE", 50, 100, 5),
+	}
+	violations := rule.CheckAuction(bidders)
+	if len(violations) != 2 {
+		t.Fatalf("Expected 2 violations, got %d: %v", len(violations), violations)
+	}
+	for i, expected := range []int{3, 4} {
+		violation := violations[i]
+		if violation.Position != expected || violation.Code != models.CodeBidderDuplicateName || violation.Limit != "b1" {
+			t.Errorf("Expected a duplicate of b1's name at position %d, got %+v", expected, violation)
+		}
+	}
+
+	if violations := rule.CheckAuction(bidders[:2]); len(violations) != 0 {
+		t.Errorf("Expected distinct names to pass, got %v", violations)
+	}
+}
+
+func TestMaxBidOutlierRule(t *testing.T) {
+	rule, err := NewMaxBidOutlierRule(10)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		name              string
+		maxBids           []float64
+		expectedPositions []int
+	}{
+		{"typical spread", []float64{100, 150, 220, 400}, nil},
+		{"extra zeros", []float64{100, 150, 15000, 120}, []int{3}},
+		{"missing zeros", []float64{1.50, 150, 160, 120}, []int{1}},
+		{"too few bids to compare", []float64{100, 15000}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bidders []models.Bidder
+			for i, maxBid := range tt.maxBids {
+				bidders = append(bidders, ruleBidder(string(rune('a'+i)), "Bidder", 1, maxBid, 1))
+			}
+			violations := rule.CheckAuction(bidders)
+			if len(violations) != len(tt.expectedPositions) {
+				t.Fatalf("Expected %d violations, got %d: %v", len(tt.expectedPositions), len(violations), violations)
+			}
+			for i, position := range tt.expectedPositions {
+				if violations[i].Position != position || violations[i].Code != models.CodeBidMaxOutlier {
+					t.Errorf("Expected an outlier at position %d, got %+v", position, violations[i])
+				}
+			}
+		})
+	}
+
+	// Bids are only compared with bids in the same currency
+	euros := models.NewMoney(1500000, "EUR")
+	bidders := []models.Bidder{
+		ruleBidder("a", "Alice", 1, 100, 1),
+		ruleBidder("b", "Bob", 1, 120, 1),
+		ruleBidder("c", "Carol", 1, 150, 1),
+		{ID: "d", Name: "Dave", StartingBid: euros, MaxBid: euros, AutoIncrement: models.NewMoney(100, "EUR")},
+	}
+	if violations := rule.CheckAuction(bidders); len(violations) != 0 {
+		t.Errorf("Expected no outliers across currencies, got %v", violations)
+	}
+
+	if _, err := NewMaxBidOutlierRule(1); !models.HasCode(err, models.CodePolicyInvalidRule) {
+		t.Errorf("Expected a factor of 1 to be rejected, got: %v", err)
+	}
+}
+
+func TestEntryTimeRule(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := clocktest.NewFakeClock(now)
+	closeTime := now.Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		closeTime    time.Time
+		entryTime    time.Time
+		expectedCode models.ErrorCode
+	}{
+		{"before close", closeTime, closeTime.Add(-time.Minute), ""},
+		{"at close", closeTime, closeTime, ""},
+		{"after close", closeTime, closeTime.Add(time.Minute), models.CodeBidEntryAfterClose},
+		{"no close time", time.Time{}, now.Add(-time.Minute), ""},
+		{"in the future", time.Time{}, now.Add(time.Second), models.CodeBidEntryInFuture},
+		{"no entry time", closeTime, time.Time{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bidder := ruleBidder("b1", "Alice", 50, 100, 5)
+			bidder.EntryTime = tt.entryTime
+			violation := NewEntryTimeRule(clock, tt.closeTime).Check(bidder)
+			if tt.expectedCode == "" {
+				if violation != nil {
+					t.Errorf("Expected no violation, got: %v", violation)
+				}
+				return
+			}
+			if violation == nil || violation.Code != tt.expectedCode {
+				t.Errorf("Expected code %s, got %v", tt.expectedCode, violation)
+			}
+		})
+	}
+}
+
+func TestOpeningPriceRule(t *testing.T) {
+	rule := NewOpeningPriceRule(models.Dollars(200))
+
+	bidders := []models.Bidder{ruleBidder("b1", "Alice", 50, 150, 5), ruleBidder("b2", "Bob", 50, 180, 5)}
+	violations := rule.CheckAuction(bidders)
+	if len(violations) != 1 {
+		t.Fatalf("Expected 1 violation, got %d", len(violations))
+	}
+	violation := violations[0]
+	if violation.Code != models.CodeAuctionBelowOpeningPrice || violation.Value != "180.00" || violation.Limit != "200.00" || violation.Position != 0 {
+		t.Errorf("Expected the highest maximum bid against the opening price, got %+v", violation)
+	}
+
+	bidders = append(bidders, ruleBidder("b3", "Carol", 50, 200, 5))
+	if violations := rule.CheckAuction(bidders); len(violations) != 0 {
+		t.Errorf("Expected a bid at the opening price to pass, got %v", violations)
+	}
+}
+
+func TestRuleValidator_AuctionRules(t *testing.T) {
+	rules := NewRuleSet().
+		AddAuctionRule(NewDuplicateNameRule(), SeverityError).
+		AddAuctionRule(NewOpeningPriceRule(models.Dollars(500)), SeverityWarning)
+	bidders := []models.Bidder{
+		ruleBidder("b1", "Alice", 50, 100, 5),
+		ruleBidder("b2", "Bob", 50, 100, 5),
+		ruleBidder("b3", "Alice", 50, 100, 5),
+	}
+
+	warnings, err := NewRuleValidator(nil, rules).ValidateBiddersWithWarnings(bidders)
+	if !errors.Is(err, models.ErrValidation) || !models.HasCode(err, models.CodeBidderDuplicateName) {
+		t.Fatalf("Expected a duplicate name error, got: %v", err)
+	}
+	var auctionErr *models.AuctionError
+	if errors.As(err, &auctionErr) && auctionErr.Context["invalid_bidders"] != "1" {
+		t.Errorf("Expected 1 invalid bidder, got %v", auctionErr.Context)
+	}
+	if len(warnings) != 1 || warnings[0].Rule != RuleOpeningPrice {
+		t.Errorf("Expected an opening price warning, got %v", warnings)
+	}
+
+	// Switching the duplicate name check off for one auction leaves only the warning
+	warnings, err = NewRuleValidator(nil, rules.Without(RuleDuplicateName)).ValidateBiddersWithWarnings(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("Expected 1 warning, got %v", warnings)
+	}
+	if rules.Len() != 2 {
+		t.Errorf("Expected the original set to keep both rules, got %v", rules.Names())
+	}
+}
@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// Policy is the file form of a RuleSet
+// Field tags cover JSON and YAML, so a policy can be decoded by encoding/json or a YAML library
+type Policy struct {
+	Rules []RuleSpec `json:"rules" yaml:"rules"`
+}
+
+// RuleSpec configures one built-in rule of a Policy
+// Amounts are in minor units of Currency (DefaultCurrency if empty); the entry_time rule reads the system clock until the set is given a clock with WithClock
+type RuleSpec struct {
+	Rule        string          `json:"rule" yaml:"rule"`                                     // Built-in rule name, such as "min_opening_bid"
+	Severity    Severity        `json:"severity,omitempty" yaml:"severity,omitempty"`         // "error" (default) or "warning"
+	AmountCents int64           `json:"amount_cents,omitempty" yaml:"amount_cents,omitempty"` // Minimum, cap or price grid of the amount rules
+	Currency    models.Currency `json:"currency,omitempty" yaml:"currency,omitempty"`         // Currency of AmountCents
+	Min         int             `json:"min,omitempty" yaml:"min,omitempty"`                   // Minimum name length
+	Max         int             `json:"max,omitempty" yaml:"max,omitempty"`                   // Maximum name length (0 means no limit)
+	Pattern     string          `json:"pattern,omitempty" yaml:"pattern,omitempty"`           // Regular expression bidder IDs must match
+	Factor      float64         `json:"factor,omitempty" yaml:"factor,omitempty"`             // How far from the median a maximum bid may be
+	CloseTime   time.Time       `json:"close_time,omitempty" yaml:"close_time,omitempty"`     // Auction close entry times may not pass (zero means unchecked)
+}
+
+// ParsePolicy decodes a policy with unmarshal and builds its RuleSet
+// unmarshal is json.Unmarshal when nil; pass a YAML library's Unmarshal to read YAML policies
+func ParsePolicy(data []byte, unmarshal func(data []byte, v any) error) (*RuleSet, error) {
+	if unmarshal == nil {
+		unmarshal = json.Unmarshal
+	}
+	var policy Policy
+	if err := unmarshal(data, &policy); err != nil {
+		inputErr := models.NewInputError("malformed validation policy", "policy", len(data))
+		inputErr.Cause = err
+		inputErr.WithOperation("ParsePolicy").WithCode(models.CodePolicyMalformed)
+		return nil, inputErr
+	}
+	return policy.RuleSet()
+}
+
+// LoadPolicyFile reads a policy file and builds its RuleSet, decoding it as ParsePolicy does
+func LoadPolicyFile(path string, unmarshal func(data []byte, v any) error) (*RuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		inputErr := models.NewInputError("cannot read validation policy", "path", path)
+		inputErr.Cause = err
+		inputErr.WithOperation("LoadPolicyFile").WithCode(models.CodePolicyMalformed)
+		return nil, inputErr
+	}
+	return ParsePolicy(data, unmarshal)
+}
+
+// RuleSet builds the policy's rules in order
+func (p Policy) RuleSet() (*RuleSet, error) {
+	rules := NewRuleSet()
+	for i, spec := range p.Rules {
+		entry, err := spec.build()
+		if err != nil {
+			return nil, policyError(err, i, spec)
+		}
+		switch spec.Severity {
+		case "", SeverityError, SeverityWarning:
+		default:
+			inputErr := models.NewInputError("unknown rule severity", "severity", string(spec.Severity))
+			inputErr.WithOperation("Policy.RuleSet").WithCode(models.CodePolicyInvalidRule)
+			return nil, policyError(inputErr, i, spec)
+		}
+		entry.severity = spec.Severity
+		rules.add(entry)
+	}
+	return rules, nil
+}
+
+// build creates the built-in bidder or auction rule the spec names
+func (rs RuleSpec) build() (ruleEntry, error) {
+	currency := models.Currency(strings.ToUpper(string(rs.Currency)))
+	if currency == "" {
+		currency = models.DefaultCurrency
+	}
+	amount := models.NewMoney(rs.AmountCents, currency)
+
+	var rule Rule
+	var auctionRule AuctionRule
+	var clocked func(clock models.Clock) Rule
+	var err error
+	switch rs.Rule {
+	case RuleMinOpeningBid:
+		rule = NewMinOpeningBidRule(amount)
+	case RuleMaxBidCap:
+		rule = NewMaxBidCapRule(amount)
+	case RuleIncrementGrid:
+		rule, err = NewIncrementGridRule(amount)
+	case RuleNameLength:
+		rule, err = NewNameLengthRule(rs.Min, rs.Max)
+	case RuleIDFormat:
+		rule, err = NewIDFormatRule(rs.Pattern)
+	case RuleEntryTime:
+		closeTime := rs.CloseTime
+		clocked = func(clock models.Clock) Rule { return NewEntryTimeRule(clock, closeTime) }
+		rule = clocked(nil)
+	case RuleDuplicateName:
+		auctionRule = NewDuplicateNameRule()
+	case RuleMaxBidOutlier:
+		auctionRule, err = NewMaxBidOutlierRule(rs.Factor)
+	case RuleOpeningPrice:
+		auctionRule = NewOpeningPriceRule(amount)
+	default:
+		inputErr := models.NewInputError("unknown validation rule", "rule", rs.Rule)
+		inputErr.WithOperation("Policy.RuleSet").WithCode(models.CodePolicyUnknownRule)
+		return ruleEntry{}, inputErr
+	}
+	return ruleEntry{rule: rule, auctionRule: auctionRule, clocked: clocked}, err
+}
+
+// policyError records which policy entry err was found in
+func policyError(err error, index int, spec RuleSpec) error {
+	var auctionErr *models.AuctionError
+	if errors.As(err, &auctionErr) {
+		auctionErr.AddContext("rule_index", fmt.Sprintf("%d", index))
+		auctionErr.AddContext("rule", spec.Rule)
+	}
+	return err
+}
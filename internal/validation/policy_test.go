@@ -0,0 +1,172 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/clocktest"
+	"auction-bidding-algorithm/internal/models"
+)
+
+const testPolicy = `{
+	"rules": [
+		{"rule": "min_opening_bid", "amount_cents": 5000},
+		{"rule": "max_bid_cap", "severity": "warning", "amount_cents": 10000, "currency": "usd"},
+		{"rule": "increment_grid", "amount_cents": 500},
+		{"rule": "name_length", "min": 2, "max": 20},
+		{"rule": "id_format", "severity": "warning", "pattern": "^b[0-9]+$"}
+	]
+}`
+
+func TestParsePolicy(t *testing.T) {
+	rules, err := ParsePolicy([]byte(testPolicy), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if rules.Len() != 5 {
+		t.Fatalf("Expected 5 rules, got %d", rules.Len())
+	}
+
+	violations, warnings := rules.Check(ruleBidder("alice", "Alice", 40, 150, 7))
+	var violationRules, warningRules []string
+	for _, v := range violations {
+		violationRules = append(violationRules, v.Rule)
+	}
+	for _, w := range warnings {
+		warningRules = append(warningRules, w.Rule)
+	}
+	if strings.Join(violationRules, ",") != "min_opening_bid,increment_grid" {
+		t.Errorf("Expected min_opening_bid and increment_grid violations, got %v", violationRules)
+	}
+	if strings.Join(warningRules, ",") != "max_bid_cap,id_format" {
+		t.Errorf("Expected max_bid_cap and id_format warnings, got %v", warningRules)
+	}
+}
+
+func TestParsePolicy_AuctionRules(t *testing.T) {
+	rules, err := ParsePolicy([]byte(`{
+		"rules": [
+			{"rule": "duplicate_name"},
+			{"rule": "max_bid_outlier", "factor": 20, "severity": "warning"},
+			{"rule": "entry_time", "close_time": "2024-06-01T12:00:00Z"},
+			{"rule": "opening_price", "amount_cents": 50000, "severity": "warning"}
+		]
+	}`), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if strings.Join(rules.Names(), ",") != "duplicate_name,max_bid_outlier,entry_time,opening_price" {
+		t.Errorf("Expected the rules in policy order, got %v", rules.Names())
+	}
+
+	bidders := []models.Bidder{ruleBidder("b1", "Alice", 50, 100, 5), ruleBidder("b2", "Alice", 50, 100, 5)}
+	bidders[1].EntryTime = time.Date(2024, 6, 1, 12, 0, 1, 0, time.UTC)
+	warnings, err := NewRuleValidator(nil, rules).ValidateBiddersWithWarnings(bidders)
+	if !models.HasCode(err, models.CodeBidderDuplicateName) || !models.HasCode(err, models.CodeBidEntryAfterClose) {
+		t.Errorf("Expected duplicate name and late entry errors, got: %v", err)
+	}
+	if len(warnings) != 1 || warnings[0].Code != models.CodeAuctionBelowOpeningPrice {
+		t.Errorf("Expected an opening price warning, got %v", warnings)
+	}
+}
+
+func TestParsePolicy_CustomUnmarshal(t *testing.T) {
+	// A YAML library would be passed the same way; this stand-in records the call and decodes JSON
+	called := false
+	unmarshal := func(data []byte, v any) error {
+		called = true
+		return json.Unmarshal(data, v)
+	}
+
+	rules, err := ParsePolicy([]byte(`{"rules": [{"rule": "max_bid_cap", "amount_cents": 100}]}`), unmarshal)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !called {
+		t.Error("Expected the custom unmarshal function to be used")
+	}
+	if rules.Len() != 1 {
+		t.Errorf("Expected 1 rule, got %d", rules.Len())
+	}
+}
+
+func TestParsePolicy_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       string
+		expectedCode models.ErrorCode
+		expectedRule string
+	}{
+		{"malformed", `{"rules": [`, models.CodePolicyMalformed, ""},
+		{"unknown rule", `{"rules": [{"rule": "max_bid_cap", "amount_cents": 100}, {"rule": "no_such_rule"}]}`, models.CodePolicyUnknownRule, "no_such_rule"},
+		{"unknown severity", `{"rules": [{"rule": "max_bid_cap", "amount_cents": 100, "severity": "fatal"}]}`, models.CodePolicyInvalidRule, "max_bid_cap"},
+		{"invalid pattern", `{"rules": [{"rule": "id_format", "pattern": "["}]}`, models.CodePolicyInvalidRule, "id_format"},
+		{"missing grid", `{"rules": [{"rule": "increment_grid"}]}`, models.CodePolicyInvalidRule, "increment_grid"},
+		{"missing outlier factor", `{"rules": [{"rule": "max_bid_outlier"}]}`, models.CodePolicyInvalidRule, "max_bid_outlier"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePolicy([]byte(tt.policy), nil)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if !errors.Is(err, models.ErrInput) {
+				t.Errorf("Expected an input error, got: %v", err)
+			}
+			if models.CodeOf(err) != tt.expectedCode {
+				t.Errorf("Expected code %s, got %s", tt.expectedCode, models.CodeOf(err))
+			}
+			var auctionErr *models.AuctionError
+			if tt.expectedRule != "" && errors.As(err, &auctionErr) && auctionErr.Context["rule"] != tt.expectedRule {
+				t.Errorf("Expected the error to name rule %q, got %v", tt.expectedRule, auctionErr.Context)
+			}
+		})
+	}
+}
+
+func TestLoadPolicyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(path, []byte(testPolicy), 0o600); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	rules, err := LoadPolicyFile(path, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if rules.Len() != 5 {
+		t.Errorf("Expected 5 rules, got %d", rules.Len())
+	}
+
+	_, err = LoadPolicyFile(filepath.Join(t.TempDir(), "missing.json"), nil)
+	if !models.HasCode(err, models.CodePolicyMalformed) {
+		t.Errorf("Expected %s for a missing file, got: %v", models.CodePolicyMalformed, err)
+	}
+}
+
+func TestRuleSet_WithClock(t *testing.T) {
+	rules, err := ParsePolicy([]byte(`{"rules": [{"rule": "entry_time"}]}`), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	bidder := ruleBidder("b1", "Alice", 50, 100, 5)
+	bidder.EntryTime = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// The policy's clock is an hour before the bid was entered
+	clock := clocktest.NewFakeClock(bidder.EntryTime.Add(-time.Hour))
+	violations, _ := rules.WithClock(clock).Check(bidder)
+	if len(violations) != 1 || violations[0].Code != models.CodeBidEntryInFuture {
+		t.Errorf("Expected %s from the given clock, got %v", models.CodeBidEntryInFuture, violations)
+	}
+
+	// The original set still reads the system clock
+	if violations, _ := rules.Check(bidder); len(violations) != 0 {
+		t.Errorf("Expected the original set to be unchanged, got %v", violations)
+	}
+}
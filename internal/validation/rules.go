@@ -0,0 +1,367 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// Severity decides whether a rule violation fails the auction or is only reported
+type Severity string
+
+const (
+	// SeverityError fails validation (the default)
+	SeverityError Severity = "error"
+	// SeverityWarning is returned alongside a successful result
+	SeverityWarning Severity = "warning"
+)
+
+// Built-in rule names, as used in policy files
+const (
+	RuleMinOpeningBid = "min_opening_bid"
+	RuleMaxBidCap     = "max_bid_cap"
+	RuleIncrementGrid = "increment_grid"
+	RuleNameLength    = "name_length"
+	RuleIDFormat      = "id_format"
+)
+
+// Rule is a named check of a single bidder
+type Rule interface {
+	// Name identifies the rule in policies and in the errors it reports
+	Name() string
+	// Check returns the violation found in the bidder, or nil if the bidder passes
+	Check(bidder models.Bidder) *models.ValidationError
+}
+
+// funcRule adapts a function to the Rule interface
+type funcRule struct {
+	name  string
+	check func(bidder models.Bidder) *models.ValidationError
+}
+
+func (fr funcRule) Name() string { return fr.name }
+
+func (fr funcRule) Check(bidder models.Bidder) *models.ValidationError { return fr.check(bidder) }
+
+// NewRule creates a Rule from a check function
+func NewRule(name string, check func(bidder models.Bidder) *models.ValidationError) Rule {
+	return funcRule{name: name, check: check}
+}
+
+// NewMinOpeningBidRule requires starting bids of at least minimum
+// Bids in another currency than minimum are not checked
+func NewMinOpeningBidRule(minimum models.Money) Rule {
+	return NewRule(RuleMinOpeningBid, func(bidder models.Bidder) *models.ValidationError {
+		if !bidder.StartingBid.SameCurrency(minimum) || bidder.StartingBid.Amount() >= minimum.Amount() {
+			return nil
+		}
+		return models.NewLocalizedValidationError(bidder.ID, "StartingBid", models.MsgBidBelowMinimum, bidder.StartingBid.Decimal(), minimum.Decimal()).WithCode(models.CodeBidStartBelowMinimum)
+	})
+}
+
+// NewMaxBidCapRule rejects maximum bids above limit
+// Bids in another currency than limit are not checked
+func NewMaxBidCapRule(limit models.Money) Rule {
+	return NewRule(RuleMaxBidCap, func(bidder models.Bidder) *models.ValidationError {
+		if !bidder.MaxBid.SameCurrency(limit) || bidder.MaxBid.Amount() <= limit.Amount() {
+			return nil
+		}
+		return models.NewLocalizedValidationError(bidder.ID, "MaxBid", models.MsgBidAboveCap, bidder.MaxBid.Decimal(), limit.Decimal()).WithCode(models.CodeBidMaxAboveCap)
+	})
+}
+
+// NewIncrementGridRule requires auto-increments to be whole multiples of the price grid, so bids stay on it
+// Zero increments (a site-wide schedule supplies them) and increments in another currency are not checked
+func NewIncrementGridRule(grid models.Money) (Rule, error) {
+	if !grid.IsPositive() {
+		inputErr := models.NewInputError("price grid must be positive", "grid", grid.String())
+		inputErr.WithOperation("NewIncrementGridRule").WithCode(models.CodePolicyInvalidRule)
+		return nil, inputErr
+	}
+	return NewRule(RuleIncrementGrid, func(bidder models.Bidder) *models.ValidationError {
+		increment := bidder.AutoIncrement
+		if increment.IsZero() || !increment.SameCurrency(grid) || increment.Amount()%grid.Amount() == 0 {
+			return nil
+		}
+		return models.NewLocalizedValidationError(bidder.ID, "AutoIncrement", models.MsgIncrementOffGrid, increment.Decimal(), grid.Decimal()).WithCode(models.CodeBidIncrementOffGrid)
+	}), nil
+}
+
+// NewNameLengthRule requires bidder names of minimum to maximum characters (maximum 0 means no limit)
+// Length is counted in characters, not bytes
+func NewNameLengthRule(minimum, maximum int) (Rule, error) {
+	if minimum < 0 || maximum < 0 || (maximum > 0 && maximum < minimum) {
+		inputErr := models.NewInputError("name length bounds must be non-negative with maximum at least minimum", "max", maximum)
+		inputErr.WithOperation("NewNameLengthRule").WithCode(models.CodePolicyInvalidRule)
+		inputErr.AddContext("min", strconv.Itoa(minimum))
+		return nil, inputErr
+	}
+	return NewRule(RuleNameLength, func(bidder models.Bidder) *models.ValidationError {
+		length := utf8.RuneCountInString(bidder.Name)
+		if length < minimum {
+			return models.NewLocalizedValidationError(bidder.ID, "Name", models.MsgBidderNameTooShort, bidder.Name, strconv.Itoa(minimum)).WithCode(models.CodeBidderNameTooShort)
+		}
+		if maximum > 0 && length > maximum {
+			return models.NewLocalizedValidationError(bidder.ID, "Name", models.MsgBidderNameTooLong, bidder.Name, strconv.Itoa(maximum)).WithCode(models.CodeBidderNameTooLong)
+		}
+		return nil
+	}), nil
+}
+
+// NewIDFormatRule requires bidder IDs to match a regular expression
+func NewIDFormatRule(pattern string) (Rule, error) {
+	format, err := regexp.Compile(pattern)
+	if err != nil {
+		inputErr := models.NewInputError("invalid bidder ID pattern", "pattern", pattern)
+		inputErr.Cause = err
+		inputErr.WithOperation("NewIDFormatRule").WithCode(models.CodePolicyInvalidRule)
+		return nil, inputErr
+	}
+	return NewRule(RuleIDFormat, func(bidder models.Bidder) *models.ValidationError {
+		if format.MatchString(bidder.ID) {
+			return nil
+		}
+		return models.NewLocalizedValidationError(bidder.ID, "ID", models.MsgBidderIDFormat, bidder.ID, pattern).WithCode(models.CodeBidderIDFormat)
+	}), nil
+}
+
+// ruleEntry is a bidder or auction rule with the severity it was added under
+type ruleEntry struct {
+	rule        Rule        // Set for bidder rules
+	auctionRule AuctionRule // Set for auction rules
+	severity    Severity
+	clocked     func(clock models.Clock) Rule // Rebuilds a policy rule that reads the current time (optional)
+}
+
+// name returns the name of the entry's rule
+func (re ruleEntry) name() string {
+	if re.auctionRule != nil {
+		return re.auctionRule.Name()
+	}
+	return re.rule.Name()
+}
+
+// RuleSet is an ordered collection of rules, each with its own severity
+type RuleSet struct {
+	entries []ruleEntry
+}
+
+// NewRuleSet creates a new empty RuleSet
+func NewRuleSet() *RuleSet {
+	return &RuleSet{}
+}
+
+// Add appends a rule; an empty severity means SeverityError
+func (rs *RuleSet) Add(rule Rule, severity Severity) *RuleSet {
+	return rs.add(ruleEntry{rule: rule, severity: severity})
+}
+
+// AddAuctionRule appends a rule that checks the bid set as a whole; an empty severity means SeverityError
+func (rs *RuleSet) AddAuctionRule(rule AuctionRule, severity Severity) *RuleSet {
+	return rs.add(ruleEntry{auctionRule: rule, severity: severity})
+}
+
+// add appends an entry, defaulting its severity
+func (rs *RuleSet) add(entry ruleEntry) *RuleSet {
+	if entry.severity == "" {
+		entry.severity = SeverityError
+	}
+	rs.entries = append(rs.entries, entry)
+	return rs
+}
+
+// Len returns the number of rules in the set
+func (rs *RuleSet) Len() int {
+	return len(rs.entries)
+}
+
+// Names returns the names of the rules in the set, in order
+func (rs *RuleSet) Names() []string {
+	names := make([]string, len(rs.entries))
+	for i, entry := range rs.entries {
+		names[i] = entry.name()
+	}
+	return names
+}
+
+// Without returns a copy of the set without the named rules, so a shared policy can be switched off for one auction
+func (rs *RuleSet) Without(names ...string) *RuleSet {
+	excluded := make(map[string]bool, len(names))
+	for _, name := range names {
+		excluded[name] = true
+	}
+	filtered := NewRuleSet()
+	for _, entry := range rs.entries {
+		if !excluded[entry.name()] {
+			filtered.entries = append(filtered.entries, entry)
+		}
+	}
+	return filtered
+}
+
+// WithClock returns a copy of the set whose policy-loaded time rules read the given clock
+func (rs *RuleSet) WithClock(clock models.Clock) *RuleSet {
+	clocked := NewRuleSet()
+	for _, entry := range rs.entries {
+		if entry.clocked != nil {
+			entry.rule = entry.clocked(clock)
+		}
+		clocked.entries = append(clocked.entries, entry)
+	}
+	return clocked
+}
+
+// Check runs every bidder rule against the bidder and splits the violations by severity
+// Each violation records the name of the rule that reported it
+func (rs *RuleSet) Check(bidder models.Bidder) (violations, warnings []*models.ValidationError) {
+	for _, entry := range rs.entries {
+		if entry.rule == nil {
+			continue
+		}
+		if violation := entry.rule.Check(bidder); violation != nil {
+			violations, warnings = entry.sort(violation, violations, warnings)
+		}
+	}
+	return violations, warnings
+}
+
+// CheckAuction runs every auction rule against the bid set and splits the violations by severity
+func (rs *RuleSet) CheckAuction(bidders []models.Bidder) (violations, warnings []*models.ValidationError) {
+	for _, entry := range rs.entries {
+		if entry.auctionRule == nil {
+			continue
+		}
+		for _, violation := range entry.auctionRule.CheckAuction(bidders) {
+			violations, warnings = entry.sort(violation, violations, warnings)
+		}
+	}
+	return violations, warnings
+}
+
+// sort records the entry's rule name on the violation and appends it to the list for the entry's severity
+func (re ruleEntry) sort(violation *models.ValidationError, violations, warnings []*models.ValidationError) ([]*models.ValidationError, []*models.ValidationError) {
+	violation.Rule = re.name()
+	if re.severity == SeverityWarning {
+		return violations, append(warnings, violation)
+	}
+	return append(violations, violation), warnings
+}
+
+// WarningValidator is a BidValidator that also reports findings that should not fail the auction
+type WarningValidator interface {
+	BidValidator
+	// ValidateBiddersWithWarnings validates like ValidateBidders and also returns the warnings found
+	ValidateBiddersWithWarnings(bidders []models.Bidder) ([]*models.ValidationError, error)
+}
+
+// RuleValidator runs a RuleSet on top of another validator's checks
+type RuleValidator struct {
+	base  BidValidator
+	rules *RuleSet
+}
+
+// NewRuleValidator creates a new RuleValidator; a nil base uses NewBidValidator
+func NewRuleValidator(base BidValidator, rules *RuleSet) *RuleValidator {
+	if base == nil {
+		base = NewBidValidator()
+	}
+	if rules == nil {
+		rules = NewRuleSet()
+	}
+	return &RuleValidator{base: base, rules: rules}
+}
+
+// ValidateBidder runs the base checks and the bidder rules on a single bidder; warnings are discarded
+func (rv *RuleValidator) ValidateBidder(bidder models.Bidder) error {
+	baseErr := rv.base.ValidateBidder(bidder)
+	violations, _ := rv.rules.Check(bidder)
+	if len(violations) == 0 {
+		return baseErr
+	}
+
+	if baseErr == nil {
+		auctionErr := models.NewAuctionError(models.ErrorTypeValidation, fmt.Sprintf("validation failed for bidder %s", bidder.ID), violations)
+		auctionErr.WithOperation("ValidateBidder").WithCode(models.CodeBidderInvalid)
+		auctionErr.AddContext("bidder_id", bidder.ID)
+		auctionErr.AddContext("bidder_name", bidder.Name)
+		return auctionErr
+	}
+	var auctionErr *models.AuctionError
+	if errors.As(baseErr, &auctionErr) {
+		auctionErr.Details = append(auctionErr.Details, violations...)
+	}
+	return baseErr
+}
+
+// ValidateBidders validates the bid set; warnings are discarded
+func (rv *RuleValidator) ValidateBidders(bidders []models.Bidder) error {
+	_, err := rv.ValidateBiddersWithWarnings(bidders)
+	return err
+}
+
+// ValidateBiddersWithWarnings runs the base checks and the bidder rules on every bidder, then the auction rules
+// Violations of error rules are merged into the base validator's error; warnings are returned either way
+func (rv *RuleValidator) ValidateBiddersWithWarnings(bidders []models.Bidder) ([]*models.ValidationError, error) {
+	baseErr := rv.base.ValidateBidders(bidders)
+	var auctionErr *models.AuctionError
+	if baseErr != nil && (!errors.As(baseErr, &auctionErr) || len(auctionErr.Details) == 0) {
+		// Failures without details (such as an empty bid set) leave nothing for the rules to add to
+		return nil, baseErr
+	}
+
+	var violations, warnings []*models.ValidationError
+	for i, bidder := range bidders {
+		bidderViolations, bidderWarnings := rv.rules.Check(bidder)
+		for _, violation := range bidderViolations {
+			violations = append(violations, violation.WithPosition(i+1))
+		}
+		for _, warning := range bidderWarnings {
+			warnings = append(warnings, warning.WithPosition(i+1))
+		}
+	}
+	auctionViolations, auctionWarnings := rv.rules.CheckAuction(bidders)
+	violations = append(violations, auctionViolations...)
+	warnings = append(warnings, auctionWarnings...)
+	if len(violations) == 0 {
+		return warnings, baseErr
+	}
+
+	var details []*models.ValidationError
+	if auctionErr != nil {
+		details = auctionErr.Details
+	}
+	details = append(details, violations...)
+	sort.SliceStable(details, func(a, b int) bool {
+		return details[a].Position < details[b].Position
+	})
+	return warnings, newBiddersError(bidders, details)
+}
+
+// newBiddersError builds the error ValidateBidders returns for the given details
+// A bidder counts as invalid when a detail refers to its position (or, without positions, its ID);
+// details about the bid set as a whole refer to neither
+func newBiddersError(bidders []models.Bidder, details []*models.ValidationError) *models.AuctionError {
+	invalid := make(map[string]bool)
+	for _, detail := range details {
+		key := detail.BidderID
+		if detail.Position > 0 {
+			key = strconv.Itoa(detail.Position)
+		}
+		if key != "" {
+			invalid[key] = true
+		}
+	}
+
+	auctionErr := models.NewAuctionError(models.ErrorTypeValidation, fmt.Sprintf("validation failed for %d out of %d bidders", len(invalid), len(bidders)), details)
+	auctionErr.WithOperation("ValidateBidders").WithCode(models.CodeAuctionInvalid)
+	auctionErr.AddContext("total_bidders", fmt.Sprintf("%d", len(bidders)))
+	auctionErr.AddContext("valid_bidders", fmt.Sprintf("%d", len(bidders)-len(invalid)))
+	auctionErr.AddContext("invalid_bidders", fmt.Sprintf("%d", len(invalid)))
+	auctionErr.AddContext("total_validation_errors", fmt.Sprintf("%d", len(details)))
+	return auctionErr
+}
@@ -0,0 +1,209 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// ruleBidder returns a valid bidder for rule tests
+func ruleBidder(id, name string, start, max, increment float64) models.Bidder {
+	return models.Bidder{
+		ID:            id,
+		Name:          name,
+		StartingBid:   models.Dollars(start),
+		MaxBid:        models.Dollars(max),
+		AutoIncrement: models.Dollars(increment),
+		EntryTime:     time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestBuiltInRules(t *testing.T) {
+	grid, err := NewIncrementGridRule(models.Dollars(5))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	nameLength, err := NewNameLengthRule(3, 8)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	idFormat, err := NewIDFormatRule(`^b[0-9]+$`)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		rule         Rule
+		bidder       models.Bidder
+		expectedCode models.ErrorCode // Empty when the bidder passes
+		expectedName string
+	}{
+		{"opening bid at minimum", NewMinOpeningBidRule(models.Dollars(50)), ruleBidder("b1", "Alice", 50, 100, 5), "", RuleMinOpeningBid},
+		{"opening bid below minimum", NewMinOpeningBidRule(models.Dollars(50)), ruleBidder("b1", "Alice", 49.99, 100, 5), models.CodeBidStartBelowMinimum, RuleMinOpeningBid},
+		{"opening bid in other currency", NewMinOpeningBidRule(models.NewMoney(5000, "EUR")), ruleBidder("b1", "Alice", 10, 100, 5), "", RuleMinOpeningBid},
+		{"max bid at cap", NewMaxBidCapRule(models.Dollars(100)), ruleBidder("b1", "Alice", 50, 100, 5), "", RuleMaxBidCap},
+		{"max bid above cap", NewMaxBidCapRule(models.Dollars(100)), ruleBidder("b1", "Alice", 50, 100.01, 5), models.CodeBidMaxAboveCap, RuleMaxBidCap},
+		{"increment on grid", grid, ruleBidder("b1", "Alice", 50, 100, 15), "", RuleIncrementGrid},
+		{"increment off grid", grid, ruleBidder("b1", "Alice", 50, 100, 7.50), models.CodeBidIncrementOffGrid, RuleIncrementGrid},
+		{"zero increment", grid, ruleBidder("b1", "Alice", 50, 100, 0), "", RuleIncrementGrid},
+		{"name within bounds", nameLength, ruleBidder("b1", "Zoë", 50, 100, 5), "", RuleNameLength},
+		{"name too short", nameLength, ruleBidder("b1", "Al", 50, 100, 5), models.CodeBidderNameTooShort, RuleNameLength},
+		{"name too long", nameLength, ruleBidder("b1", "Alexandria", 50, 100, 5), models.CodeBidderNameTooLong, RuleNameLength},
+		{"ID matches format", idFormat, ruleBidder("b42", "Alice", 50, 100, 5), "", RuleIDFormat},
+		{"ID does not match format", idFormat, ruleBidder("alice", "Alice", 50, 100, 5), models.CodeBidderIDFormat, RuleIDFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.rule.Name() != tt.expectedName {
+				t.Errorf("Expected rule name %q, got %q", tt.expectedName, tt.rule.Name())
+			}
+			violation := tt.rule.Check(tt.bidder)
+			if tt.expectedCode == "" {
+				if violation != nil {
+					t.Errorf("Expected no violation, got: %v", violation)
+				}
+				return
+			}
+			if violation == nil {
+				t.Fatal("Expected a violation")
+			}
+			if violation.Code != tt.expectedCode {
+				t.Errorf("Expected code %s, got %s", tt.expectedCode, violation.Code)
+			}
+			if violation.Key == "" || violation.Limit == "" {
+				t.Errorf("Expected a message key and limit, got %+v", violation)
+			}
+		})
+	}
+}
+
+func TestBuiltInRules_InvalidConfiguration(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() (Rule, error)
+	}{
+		{"zero price grid", func() (Rule, error) { return NewIncrementGridRule(models.Dollars(0)) }},
+		{"negative name minimum", func() (Rule, error) { return NewNameLengthRule(-1, 5) }},
+		{"name maximum below minimum", func() (Rule, error) { return NewNameLengthRule(5, 3) }},
+		{"invalid ID pattern", func() (Rule, error) { return NewIDFormatRule(`[`) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.build()
+			if err == nil {
+				t.Fatalf("Expected an error, got rule %v", rule)
+			}
+			if !errors.Is(err, models.ErrInput) {
+				t.Errorf("Expected an input error, got: %v", err)
+			}
+			if !models.HasCode(err, models.CodePolicyInvalidRule) {
+				t.Errorf("Expected code %s, got %s", models.CodePolicyInvalidRule, models.CodeOf(err))
+			}
+		})
+	}
+}
+
+func TestRuleSet_Check(t *testing.T) {
+	rules := NewRuleSet().
+		Add(NewMinOpeningBidRule(models.Dollars(50)), SeverityError).
+		Add(NewMaxBidCapRule(models.Dollars(100)), SeverityWarning).
+		Add(NewRule("custom", func(bidder models.Bidder) *models.ValidationError { return nil }), "")
+	if rules.Len() != 3 {
+		t.Fatalf("Expected 3 rules, got %d", rules.Len())
+	}
+
+	violations, warnings := rules.Check(ruleBidder("b1", "Alice", 10, 150, 5))
+	if len(violations) != 1 || violations[0].Rule != RuleMinOpeningBid {
+		t.Errorf("Expected one min_opening_bid violation, got %v", violations)
+	}
+	if len(warnings) != 1 || warnings[0].Rule != RuleMaxBidCap {
+		t.Errorf("Expected one max_bid_cap warning, got %v", warnings)
+	}
+
+	violations, warnings = rules.Check(ruleBidder("b1", "Alice", 60, 90, 5))
+	if len(violations) != 0 || len(warnings) != 0 {
+		t.Errorf("Expected a passing bidder, got %v and %v", violations, warnings)
+	}
+}
+
+func TestRuleValidator_ValidateBiddersWithWarnings(t *testing.T) {
+	rules := NewRuleSet().
+		Add(NewMinOpeningBidRule(models.Dollars(50)), SeverityError).
+		Add(NewMaxBidCapRule(models.Dollars(100)), SeverityWarning)
+	validator := NewRuleValidator(nil, rules)
+
+	t.Run("warnings only", func(t *testing.T) {
+		bidders := []models.Bidder{ruleBidder("b1", "Alice", 60, 90, 5), ruleBidder("b2", "Bob", 60, 150, 5)}
+		warnings, err := validator.ValidateBiddersWithWarnings(bidders)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(warnings) != 1 || warnings[0].Position != 2 || warnings[0].Code != models.CodeBidMaxAboveCap {
+			t.Errorf("Expected one max bid warning at position 2, got %v", warnings)
+		}
+		if err := validator.ValidateBidders(bidders); err != nil {
+			t.Errorf("Expected warnings not to fail ValidateBidders, got: %v", err)
+		}
+	})
+
+	t.Run("rule violations merge with base errors", func(t *testing.T) {
+		bidders := []models.Bidder{
+			ruleBidder("b1", "Alice", 10, 90, 5),
+			ruleBidder("b2", "", 60, 90, 5),
+			ruleBidder("b3", "Carol", 60, 90, 5),
+		}
+		warnings, err := validator.ValidateBiddersWithWarnings(bidders)
+		if len(warnings) != 0 {
+			t.Errorf("Expected no warnings, got %v", warnings)
+		}
+		if !errors.Is(err, models.ErrValidation) {
+			t.Fatalf("Expected a validation error, got: %v", err)
+		}
+		var auctionErr *models.AuctionError
+		if !errors.As(err, &auctionErr) {
+			t.Fatalf("Expected an AuctionError, got %T", err)
+		}
+		if len(auctionErr.Details) != 2 {
+			t.Fatalf("Expected 2 details, got %d: %v", len(auctionErr.Details), auctionErr.Details)
+		}
+		if auctionErr.Details[0].Position != 1 || auctionErr.Details[0].Code != models.CodeBidStartBelowMinimum {
+			t.Errorf("Expected the rule violation first at position 1, got %+v", auctionErr.Details[0])
+		}
+		if auctionErr.Details[1].Position != 2 || auctionErr.Details[1].Code != models.CodeBidderNameRequired {
+			t.Errorf("Expected the base error at position 2, got %+v", auctionErr.Details[1])
+		}
+		if auctionErr.Code != models.CodeAuctionInvalid || auctionErr.Context["invalid_bidders"] != "2" {
+			t.Errorf("Expected 2 invalid bidders under %s, got %s with %v", models.CodeAuctionInvalid, auctionErr.Code, auctionErr.Context)
+		}
+	})
+
+	t.Run("empty bid set", func(t *testing.T) {
+		_, err := validator.ValidateBiddersWithWarnings(nil)
+		if !models.HasCode(err, models.CodeAuctionNoBidders) {
+			t.Errorf("Expected %s, got: %v", models.CodeAuctionNoBidders, err)
+		}
+	})
+}
+
+func TestRuleValidator_ValidateBidder(t *testing.T) {
+	validator := NewRuleValidator(nil, NewRuleSet().Add(NewMinOpeningBidRule(models.Dollars(50)), SeverityError))
+
+	if err := validator.ValidateBidder(ruleBidder("b1", "Alice", 60, 90, 5)); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+
+	err := validator.ValidateBidder(ruleBidder("b1", "Alice", 10, 90, 5))
+	if !models.HasCode(err, models.CodeBidStartBelowMinimum) || !models.HasCode(err, models.CodeBidderInvalid) {
+		t.Errorf("Expected a rule violation under %s, got: %v", models.CodeBidderInvalid, err)
+	}
+
+	err = validator.ValidateBidder(ruleBidder("b1", "", 10, 90, 5))
+	if !models.HasCode(err, models.CodeBidStartBelowMinimum) || !models.HasCode(err, models.CodeBidderNameRequired) {
+		t.Errorf("Expected the rule violation merged with the base error, got: %v", err)
+	}
+}
@@ -1,7 +1,9 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"auction-bidding-algorithm/internal/models"
@@ -14,49 +16,75 @@ type BidValidator interface {
 }
 
 // DefaultBidValidator implements the BidValidator interface with standard validation rules
-type DefaultBidValidator struct{}
+type DefaultBidValidator struct {
+	config models.AuctionConfig // Auction settings that relax or tighten individual rules
+}
 
 // NewBidValidator creates a new instance of DefaultBidValidator
 func NewBidValidator() BidValidator {
 	return &DefaultBidValidator{}
 }
 
+// NewBidValidatorWithConfig creates a new DefaultBidValidator for an auction with the given configuration
+func NewBidValidatorWithConfig(config models.AuctionConfig) BidValidator {
+	return &DefaultBidValidator{config: config}
+}
+
 // ValidateBidder validates a single bidder's parameters according to auction rules
 func (v *DefaultBidValidator) ValidateBidder(bidder models.Bidder) error {
 	var validationErrors []*models.ValidationError
 
 	// Validate required fields
 	if strings.TrimSpace(bidder.ID) == "" {
-		validationErrors = append(validationErrors, models.NewValidationErrorWithValue("", "ID", "bidder ID is required", bidder.ID))
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError("", "ID", models.MsgBidderIDRequired, bidder.ID, "").WithCode(models.CodeBidderIDRequired))
 	}
 
 	if strings.TrimSpace(bidder.Name) == "" {
-		validationErrors = append(validationErrors, models.NewValidationErrorWithValue(bidder.ID, "Name", "bidder name is required", bidder.Name))
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "Name", models.MsgBidderNameRequired, bidder.Name, "").WithCode(models.CodeBidderNameRequired))
+	}
+
+	// Validate all amounts use the starting bid's currency
+	if !bidder.MaxBid.SameCurrency(bidder.StartingBid) {
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "MaxBid", models.MsgAmountCurrencyMismatch, string(bidder.MaxBid.Currency()), string(bidder.StartingBid.Currency())).WithCode(models.CodeBidMaxCurrencyMismatch))
+	}
+
+	if !bidder.AutoIncrement.IsZero() && !bidder.AutoIncrement.SameCurrency(bidder.StartingBid) {
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "AutoIncrement", models.MsgAmountCurrencyMismatch, string(bidder.AutoIncrement.Currency()), string(bidder.StartingBid.Currency())).WithCode(models.CodeBidIncrementCurrencyMismatch))
 	}
 
 	// Validate bid amounts are non-negative (Requirement 6.3)
-	if bidder.StartingBid < 0 {
-		validationErrors = append(validationErrors, models.NewValidationErrorWithValue(bidder.ID, "StartingBid", "starting bid cannot be negative", fmt.Sprintf("%.2f", bidder.StartingBid)))
+	if bidder.StartingBid.IsNegative() {
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "StartingBid", models.MsgAmountNegative, bidder.StartingBid.Decimal(), "0").WithCode(models.CodeBidStartNegative))
+	}
+
+	if bidder.MaxBid.IsNegative() {
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "MaxBid", models.MsgAmountNegative, bidder.MaxBid.Decimal(), "0").WithCode(models.CodeBidMaxNegative))
 	}
 
-	if bidder.MaxBid < 0 {
-		validationErrors = append(validationErrors, models.NewValidationErrorWithValue(bidder.ID, "MaxBid", "maximum bid cannot be negative", fmt.Sprintf("%.2f", bidder.MaxBid)))
+	// Validate auto-increment is positive (Requirement 6.2), unless a site-wide schedule supplies
+	// increments or the auction format does not raise bids
+	if v.config.HasIncrementSchedule() || !v.config.AuctionFormat().UsesAutoIncrement() {
+		if bidder.AutoIncrement.IsNegative() {
+			validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "AutoIncrement", models.MsgAmountNegative, bidder.AutoIncrement.Decimal(), "0").WithCode(models.CodeBidIncrementNegative))
+		}
+	} else if !bidder.AutoIncrement.IsPositive() {
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "AutoIncrement", models.MsgAmountNotPositive, bidder.AutoIncrement.Decimal(), "0").WithCode(models.CodeBidIncrementNotPositive))
 	}
 
-	// Validate auto-increment is positive (Requirement 6.2)
-	if bidder.AutoIncrement <= 0 {
-		validationErrors = append(validationErrors, models.NewValidationErrorWithValue(bidder.ID, "AutoIncrement", "auto-increment amount must be greater than zero", fmt.Sprintf("%.2f", bidder.AutoIncrement)))
+	// Validate the quantity; zero asks for a single unit
+	if bidder.Quantity < 0 {
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "Quantity", models.MsgAmountNegative, strconv.Itoa(bidder.Quantity), "0").WithCode(models.CodeBidQuantityNegative))
 	}
 
 	// Validate starting bid does not exceed maximum bid (Requirement 6.1)
-	if bidder.StartingBid > bidder.MaxBid {
-		validationErrors = append(validationErrors, models.NewValidationErrorWithValue(bidder.ID, "StartingBid", "starting bid cannot be greater than maximum bid", fmt.Sprintf("starting: %.2f, max: %.2f", bidder.StartingBid, bidder.MaxBid)))
+	if bidder.StartingBid.SameCurrency(bidder.MaxBid) && bidder.StartingBid.Amount() > bidder.MaxBid.Amount() {
+		validationErrors = append(validationErrors, models.NewLocalizedValidationError(bidder.ID, "StartingBid", models.MsgBidStartAboveMax, bidder.StartingBid.Decimal(), bidder.MaxBid.Decimal()).WithCode(models.CodeBidStartGreaterThanMax))
 	}
 
 	// If there are validation errors, return them as an AuctionError
 	if len(validationErrors) > 0 {
 		auctionErr := models.NewAuctionError(models.ErrorTypeValidation, fmt.Sprintf("validation failed for bidder %s", bidder.ID), validationErrors)
-		auctionErr.WithOperation("ValidateBidder")
+		auctionErr.WithOperation("ValidateBidder").WithCode(models.CodeBidderInvalid)
 		auctionErr.AddContext("bidder_id", bidder.ID)
 		auctionErr.AddContext("bidder_name", bidder.Name)
 		return auctionErr
@@ -69,7 +97,7 @@ func (v *DefaultBidValidator) ValidateBidder(bidder models.Bidder) error {
 func (v *DefaultBidValidator) ValidateBidders(bidders []models.Bidder) error {
 	if len(bidders) == 0 {
 		auctionErr := models.NewAuctionError(models.ErrorTypeValidation, "no bidders provided", nil)
-		auctionErr.WithOperation("ValidateBidders")
+		auctionErr.WithOperation("ValidateBidders").WithCode(models.CodeAuctionNoBidders)
 		auctionErr.AddContext("bidder_count", "0")
 		return auctionErr
 	}
@@ -78,25 +106,43 @@ func (v *DefaultBidValidator) ValidateBidders(bidders []models.Bidder) error {
 	bidderIDs := make(map[string]bool)
 	validBidderCount := 0
 
+	auctionCurrency := bidders[0].Currency()
+	if v.config.IsMultiCurrency() {
+		auctionCurrency = v.config.Settlement()
+	}
+
 	for i, bidder := range bidders {
 		// Check for duplicate bidder IDs
 		if bidderIDs[bidder.ID] {
-			allValidationErrors = append(allValidationErrors, models.NewValidationErrorWithValue(bidder.ID, "ID", "duplicate bidder ID", bidder.ID))
+			allValidationErrors = append(allValidationErrors, models.NewLocalizedValidationError(bidder.ID, "ID", models.MsgBidderDuplicateID, bidder.ID, "").WithPosition(i+1).WithCode(models.CodeBidderDuplicateID))
 			continue
 		}
 		bidderIDs[bidder.ID] = true
 
+		if v.config.IsMultiCurrency() {
+			// Bids are normalized into the settlement currency, so a rate must exist for each bidder
+			if _, err := v.config.RateProvider.Rate(bidder.Currency(), auctionCurrency); err != nil {
+				allValidationErrors = append(allValidationErrors, models.NewLocalizedValidationError(bidder.ID, "Currency", models.MsgBidderNoExchangeRate, string(bidder.Currency()), string(auctionCurrency)).WithPosition(i+1).WithCode(models.CodeBidderNoExchangeRate))
+				continue
+			}
+		} else if bidder.Currency() != auctionCurrency {
+			// All bidders are compared directly, so they must bid in one currency
+			allValidationErrors = append(allValidationErrors, models.NewLocalizedValidationError(bidder.ID, "Currency", models.MsgBidderCurrencyMismatch, string(bidder.Currency()), string(auctionCurrency)).WithPosition(i+1).WithCode(models.CodeBidderCurrencyMismatch))
+			continue
+		}
+
 		// Validate individual bidder
 		if err := v.ValidateBidder(bidder); err != nil {
-			if auctionErr, ok := err.(*models.AuctionError); ok {
-				// Add position context to each validation error
+			var auctionErr *models.AuctionError
+			if errors.As(err, &auctionErr) {
+				// Record the bidder's position in each validation error
 				for _, detail := range auctionErr.Details {
-					detail.Value = fmt.Sprintf("position %d: %s", i+1, detail.Value)
+					detail.WithPosition(i + 1)
 				}
 				allValidationErrors = append(allValidationErrors, auctionErr.Details...)
 			} else {
 				// Handle unexpected error types
-				allValidationErrors = append(allValidationErrors, models.NewValidationErrorWithValue(bidder.ID, "unknown", "unexpected validation error", err.Error()))
+				allValidationErrors = append(allValidationErrors, models.NewLocalizedValidationError(bidder.ID, "unknown", models.MsgValidationUnexpected, err.Error(), "").WithPosition(i+1).WithCode(models.CodeBidderValidationUnexpected))
 			}
 		} else {
 			validBidderCount++
@@ -111,7 +157,7 @@ func (v *DefaultBidValidator) ValidateBidders(bidders []models.Bidder) error {
 		}
 
 		auctionErr := models.NewAuctionError(models.ErrorTypeValidation, fmt.Sprintf("validation failed for %d out of %d bidders", len(errorsByBidder), len(bidders)), allValidationErrors)
-		auctionErr.WithOperation("ValidateBidders")
+		auctionErr.WithOperation("ValidateBidders").WithCode(models.CodeAuctionInvalid)
 		auctionErr.AddContext("total_bidders", fmt.Sprintf("%d", len(bidders)))
 		auctionErr.AddContext("valid_bidders", fmt.Sprintf("%d", validBidderCount))
 		auctionErr.AddContext("invalid_bidders", fmt.Sprintf("%d", len(errorsByBidder)))
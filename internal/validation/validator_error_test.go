@@ -25,9 +25,9 @@ func TestEnhancedValidationErrors_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   100.0,
-				MaxBid:        500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(25.0),
 				EntryTime:     time.Now(),
 			},
 			expectError: false,
@@ -37,9 +37,9 @@ func TestEnhancedValidationErrors_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   -100.0,
-				MaxBid:        500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(-100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(25.0),
 				EntryTime:     time.Now(),
 			},
 			expectError:         true,
@@ -53,9 +53,9 @@ func TestEnhancedValidationErrors_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "",
 				Name:          "",
-				StartingBid:   -100.0,
-				MaxBid:        -500.0,
-				AutoIncrement: 0.0,
+				StartingBid:   models.Dollars(-100.0),
+				MaxBid:        models.Dollars(-500.0),
+				AutoIncrement: models.Dollars(0.0),
 			},
 			expectError:         true,
 			expectedErrorType:   models.ErrorTypeValidation,
@@ -139,23 +139,23 @@ func TestEnhancedValidationErrors_ValidateBidders(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "John Doe",
-					StartingBid:   100.0,
-					MaxBid:        500.0,
-					AutoIncrement: 25.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(500.0),
+					AutoIncrement: models.Dollars(25.0),
 				},
 				{
 					ID:            "bidder2",
 					Name:          "Jane Smith",
-					StartingBid:   -150.0, // Invalid
-					MaxBid:        600.0,
-					AutoIncrement: 50.0,
+					StartingBid:   models.Dollars(-150.0), // Invalid
+					MaxBid:        models.Dollars(600.0),
+					AutoIncrement: models.Dollars(50.0),
 				},
 				{
 					ID:            "bidder3",
 					Name:          "Bob Johnson",
-					StartingBid:   200.0,
-					MaxBid:        100.0, // Invalid: starting > max
-					AutoIncrement: 0.0,   // Invalid: zero increment
+					StartingBid:   models.Dollars(200.0),
+					MaxBid:        models.Dollars(100.0), // Invalid: starting > max
+					AutoIncrement: models.Dollars(0.0),   // Invalid: zero increment
 				},
 			},
 			expectError:         true,
@@ -170,16 +170,16 @@ func TestEnhancedValidationErrors_ValidateBidders(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "John Doe",
-					StartingBid:   100.0,
-					MaxBid:        500.0,
-					AutoIncrement: 25.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(500.0),
+					AutoIncrement: models.Dollars(25.0),
 				},
 				{
 					ID:            "bidder1", // Duplicate
 					Name:          "Jane Smith",
-					StartingBid:   150.0,
-					MaxBid:        600.0,
-					AutoIncrement: 50.0,
+					StartingBid:   models.Dollars(150.0),
+					MaxBid:        models.Dollars(600.0),
+					AutoIncrement: models.Dollars(50.0),
 				},
 			},
 			expectError:         true,
@@ -248,16 +248,16 @@ func TestValidationErrorGrouping(t *testing.T) {
 		{
 			ID:            "bidder1",
 			Name:          "",
-			StartingBid:   -100.0,
-			MaxBid:        500.0,
-			AutoIncrement: 0.0,
+			StartingBid:   models.Dollars(-100.0),
+			MaxBid:        models.Dollars(500.0),
+			AutoIncrement: models.Dollars(0.0),
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Jane",
-			StartingBid:   200.0,
-			MaxBid:        100.0, // Invalid: starting > max
-			AutoIncrement: -5.0,  // Invalid: negative increment
+			StartingBid:   models.Dollars(200.0),
+			MaxBid:        models.Dollars(100.0), // Invalid: starting > max
+			AutoIncrement: models.Dollars(-5.0),  // Invalid: negative increment
 		},
 	}
 
@@ -301,16 +301,16 @@ func TestErrorContextAccumulation(t *testing.T) {
 		{
 			ID:            "valid_bidder",
 			Name:          "Valid Bidder",
-			StartingBid:   100.0,
-			MaxBid:        500.0,
-			AutoIncrement: 25.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(500.0),
+			AutoIncrement: models.Dollars(25.0),
 		},
 		{
 			ID:            "invalid_bidder",
 			Name:          "Invalid Bidder",
-			StartingBid:   -100.0,
-			MaxBid:        500.0,
-			AutoIncrement: 25.0,
+			StartingBid:   models.Dollars(-100.0),
+			MaxBid:        models.Dollars(500.0),
+			AutoIncrement: models.Dollars(25.0),
 		},
 	}
 
@@ -347,9 +347,9 @@ func TestValidationErrorMessages(t *testing.T) {
 	bidder := models.Bidder{
 		ID:            "test_bidder",
 		Name:          "Test Bidder",
-		StartingBid:   -50.0,
-		MaxBid:        -100.0,
-		AutoIncrement: 0.0,
+		StartingBid:   models.Dollars(-50.0),
+		MaxBid:        models.Dollars(-100.0),
+		AutoIncrement: models.Dollars(0.0),
 	}
 
 	err := validator.ValidateBidder(bidder)
@@ -389,9 +389,9 @@ func TestErrorWrappingInValidator(t *testing.T) {
 	bidder := models.Bidder{
 		ID:            "test_bidder",
 		Name:          "Test Bidder",
-		StartingBid:   -50.0,
-		MaxBid:        500.0,
-		AutoIncrement: 25.0,
+		StartingBid:   models.Dollars(-50.0),
+		MaxBid:        models.Dollars(500.0),
+		AutoIncrement: models.Dollars(25.0),
 	}
 
 	err := validator.ValidateBidder(bidder)
@@ -436,9 +436,9 @@ func BenchmarkEnhancedValidationError(b *testing.B) {
 	bidder := models.Bidder{
 		ID:            "test_bidder",
 		Name:          "Test Bidder",
-		StartingBid:   -50.0,
-		MaxBid:        500.0,
-		AutoIncrement: 25.0,
+		StartingBid:   models.Dollars(-50.0),
+		MaxBid:        models.Dollars(500.0),
+		AutoIncrement: models.Dollars(25.0),
 	}
 
 	b.ResetTimer()
@@ -456,16 +456,16 @@ func BenchmarkEnhancedValidationErrors(b *testing.B) {
 		{
 			ID:            "bidder1",
 			Name:          "Valid Bidder",
-			StartingBid:   100.0,
-			MaxBid:        500.0,
-			AutoIncrement: 25.0,
+			StartingBid:   models.Dollars(100.0),
+			MaxBid:        models.Dollars(500.0),
+			AutoIncrement: models.Dollars(25.0),
 		},
 		{
 			ID:            "bidder2",
 			Name:          "Invalid Bidder",
-			StartingBid:   -100.0,
-			MaxBid:        500.0,
-			AutoIncrement: 0.0,
+			StartingBid:   models.Dollars(-100.0),
+			MaxBid:        models.Dollars(500.0),
+			AutoIncrement: models.Dollars(0.0),
 		},
 	}
 
@@ -477,3 +477,112 @@ func BenchmarkEnhancedValidationErrors(b *testing.B) {
 		}
 	}
 }
+
+// TestValidationErrorCodes tests that every validation failure carries its stable code
+func TestValidationErrorCodes(t *testing.T) {
+	valid := func() models.Bidder {
+		return models.Bidder{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10)}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(b *models.Bidder)
+		code   models.ErrorCode
+	}{
+		{"missing ID", func(b *models.Bidder) { b.ID = "" }, models.CodeBidderIDRequired},
+		{"missing name", func(b *models.Bidder) { b.Name = " " }, models.CodeBidderNameRequired},
+		{"max currency", func(b *models.Bidder) { b.MaxBid = models.NewMoney(20000, models.EUR) }, models.CodeBidMaxCurrencyMismatch},
+		{"increment currency", func(b *models.Bidder) { b.AutoIncrement = models.NewMoney(1000, models.EUR) }, models.CodeBidIncrementCurrencyMismatch},
+		{"negative start", func(b *models.Bidder) { b.StartingBid = models.Dollars(-1) }, models.CodeBidStartNegative},
+		{"negative max", func(b *models.Bidder) { b.MaxBid = models.Dollars(-1) }, models.CodeBidMaxNegative},
+		{"zero increment", func(b *models.Bidder) { b.AutoIncrement = models.Dollars(0) }, models.CodeBidIncrementNotPositive},
+		{"start above max", func(b *models.Bidder) { b.StartingBid = models.Dollars(300) }, models.CodeBidStartGreaterThanMax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bidder := valid()
+			tt.mutate(&bidder)
+
+			err := NewBidValidator().ValidateBidder(bidder)
+			auctionErr, ok := err.(*models.AuctionError)
+			if !ok {
+				t.Fatalf("Expected AuctionError, got %T: %v", err, err)
+			}
+			if auctionErr.Code != models.CodeBidderInvalid {
+				t.Errorf("Expected code %s, got %s", models.CodeBidderInvalid, auctionErr.Code)
+			}
+			if !models.HasCode(err, tt.code) {
+				t.Errorf("Expected a detail with code %s, got %+v", tt.code, auctionErr.Details)
+			}
+			for _, detail := range auctionErr.Details {
+				if detail.Code == "" {
+					t.Errorf("Expected every detail to carry a code, got %+v", detail)
+				}
+			}
+		})
+	}
+}
+
+// TestValidationErrorCodes_Bidders tests the codes of failures only ValidateBidders detects
+func TestValidationErrorCodes_Bidders(t *testing.T) {
+	validator := NewBidValidator()
+
+	err := validator.ValidateBidders(nil)
+	if models.CodeOf(err) != models.CodeAuctionNoBidders {
+		t.Errorf("Expected %s, got %s", models.CodeAuctionNoBidders, models.CodeOf(err))
+	}
+
+	alice := models.Bidder{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10)}
+	euro := alice
+	euro.ID = "bidder2"
+	euro.StartingBid, euro.MaxBid, euro.AutoIncrement = models.NewMoney(10000, models.EUR), models.NewMoney(20000, models.EUR), models.NewMoney(1000, models.EUR)
+
+	err = validator.ValidateBidders([]models.Bidder{alice, alice, euro})
+	if models.CodeOf(err) != models.CodeAuctionInvalid {
+		t.Errorf("Expected %s, got %s", models.CodeAuctionInvalid, models.CodeOf(err))
+	}
+	for _, code := range []models.ErrorCode{models.CodeBidderDuplicateID, models.CodeBidderCurrencyMismatch} {
+		if !models.HasCode(err, code) {
+			t.Errorf("Expected a detail with code %s", code)
+		}
+	}
+	if models.HasCode(err, models.CodeBidStartGreaterThanMax) {
+		t.Errorf("Expected no %s detail", models.CodeBidStartGreaterThanMax)
+	}
+}
+
+// TestValidationErrorMessageParams tests that validation errors carry a message key and structured parameters
+func TestValidationErrorMessageParams(t *testing.T) {
+	alice := models.Bidder{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10)}
+	bob := models.Bidder{ID: "bidder2", Name: "Bob", StartingBid: models.Dollars(300), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10)}
+
+	err := NewBidValidator().ValidateBidders([]models.Bidder{alice, bob, alice})
+	auctionErr, ok := err.(*models.AuctionError)
+	if !ok {
+		t.Fatalf("Expected AuctionError, got %T: %v", err, err)
+	}
+
+	want := []models.ValidationError{
+		{BidderID: "bidder2", Position: 2, Field: "StartingBid", Key: models.MsgBidStartAboveMax, Value: "300.00", Limit: "200.00"},
+		{BidderID: "bidder1", Position: 3, Field: "ID", Key: models.MsgBidderDuplicateID, Value: "bidder1"},
+	}
+	if len(auctionErr.Details) != len(want) {
+		t.Fatalf("Expected %d details, got %+v", len(want), auctionErr.Details)
+	}
+	for i, detail := range auctionErr.Details {
+		w := want[i]
+		if detail.BidderID != w.BidderID || detail.Position != w.Position || detail.Field != w.Field || detail.Key != w.Key || detail.Value != w.Value || detail.Limit != w.Limit {
+			t.Errorf("Detail %d: expected %+v, got %+v", i, w, *detail)
+		}
+		if detail.Message != detail.Localize(nil, models.LocaleEnglish) {
+			t.Errorf("Detail %d: expected Message to be the English rendering, got %q", i, detail.Message)
+		}
+	}
+
+	// A single bidder has no position in a bid set
+	err = NewBidValidator().ValidateBidder(bob)
+	if detail := err.(*models.AuctionError).Details[0]; detail.Position != 0 {
+		t.Errorf("Expected no position from ValidateBidder, got %d", detail.Position)
+	}
+}
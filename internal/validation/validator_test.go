@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -23,9 +24,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   100.0,
-				MaxBid:        500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(25.0),
 				EntryTime:     time.Now(),
 			},
 			expectError: false,
@@ -37,9 +38,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "",
 				Name:          "John Doe",
-				StartingBid:   100.0,
-				MaxBid:        500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(25.0),
 			},
 			expectError: true,
 			errorCount:  1,
@@ -50,9 +51,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "",
-				StartingBid:   100.0,
-				MaxBid:        500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(25.0),
 			},
 			expectError: true,
 			errorCount:  1,
@@ -63,9 +64,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   -100.0,
-				MaxBid:        500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(-100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(25.0),
 			},
 			expectError: true,
 			errorCount:  1,
@@ -76,9 +77,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   100.0,
-				MaxBid:        -500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(100.0),
+				MaxBid:        models.Dollars(-500.0),
+				AutoIncrement: models.Dollars(25.0),
 			},
 			expectError: true,
 			errorCount:  2, // MaxBid negative + StartingBid > MaxBid
@@ -89,9 +90,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   100.0,
-				MaxBid:        500.0,
-				AutoIncrement: 0.0,
+				StartingBid:   models.Dollars(100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(0.0),
 			},
 			expectError: true,
 			errorCount:  1,
@@ -102,9 +103,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   100.0,
-				MaxBid:        500.0,
-				AutoIncrement: -25.0,
+				StartingBid:   models.Dollars(100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(-25.0),
 			},
 			expectError: true,
 			errorCount:  1,
@@ -115,9 +116,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   600.0,
-				MaxBid:        500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(600.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(25.0),
 			},
 			expectError: true,
 			errorCount:  1,
@@ -128,9 +129,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "",
 				Name:          "",
-				StartingBid:   -100.0,
-				MaxBid:        -500.0,
-				AutoIncrement: 0.0,
+				StartingBid:   models.Dollars(-100.0),
+				MaxBid:        models.Dollars(-500.0),
+				AutoIncrement: models.Dollars(0.0),
 			},
 			expectError: true,
 			errorCount:  6, // ID, Name, StartingBid negative, MaxBid negative, AutoIncrement zero, StartingBid > MaxBid
@@ -141,9 +142,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   500.0,
-				MaxBid:        500.0,
-				AutoIncrement: 25.0,
+				StartingBid:   models.Dollars(500.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(25.0),
 			},
 			expectError: false,
 			errorCount:  0,
@@ -154,9 +155,9 @@ func TestDefaultBidValidator_ValidateBidder(t *testing.T) {
 			bidder: models.Bidder{
 				ID:            "bidder1",
 				Name:          "John Doe",
-				StartingBid:   100.0,
-				MaxBid:        500.0,
-				AutoIncrement: 0.01,
+				StartingBid:   models.Dollars(100.0),
+				MaxBid:        models.Dollars(500.0),
+				AutoIncrement: models.Dollars(0.01),
 			},
 			expectError: false,
 			errorCount:  0,
@@ -212,16 +213,16 @@ func TestDefaultBidValidator_ValidateBidders(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "John Doe",
-					StartingBid:   100.0,
-					MaxBid:        500.0,
-					AutoIncrement: 25.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(500.0),
+					AutoIncrement: models.Dollars(25.0),
 				},
 				{
 					ID:            "bidder2",
 					Name:          "Jane Smith",
-					StartingBid:   150.0,
-					MaxBid:        600.0,
-					AutoIncrement: 50.0,
+					StartingBid:   models.Dollars(150.0),
+					MaxBid:        models.Dollars(600.0),
+					AutoIncrement: models.Dollars(50.0),
 				},
 			},
 			expectError: false,
@@ -241,16 +242,16 @@ func TestDefaultBidValidator_ValidateBidders(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "John Doe",
-					StartingBid:   100.0,
-					MaxBid:        500.0,
-					AutoIncrement: 25.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(500.0),
+					AutoIncrement: models.Dollars(25.0),
 				},
 				{
 					ID:            "bidder1",
 					Name:          "Jane Smith",
-					StartingBid:   150.0,
-					MaxBid:        600.0,
-					AutoIncrement: 50.0,
+					StartingBid:   models.Dollars(150.0),
+					MaxBid:        models.Dollars(600.0),
+					AutoIncrement: models.Dollars(50.0),
 				},
 			},
 			expectError: true,
@@ -263,23 +264,23 @@ func TestDefaultBidValidator_ValidateBidders(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "John Doe",
-					StartingBid:   100.0,
-					MaxBid:        500.0,
-					AutoIncrement: 25.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(500.0),
+					AutoIncrement: models.Dollars(25.0),
 				},
 				{
 					ID:            "bidder2",
 					Name:          "Jane Smith",
-					StartingBid:   -150.0, // Invalid: negative starting bid
-					MaxBid:        600.0,
-					AutoIncrement: 50.0,
+					StartingBid:   models.Dollars(-150.0), // Invalid: negative starting bid
+					MaxBid:        models.Dollars(600.0),
+					AutoIncrement: models.Dollars(50.0),
 				},
 				{
 					ID:            "bidder3",
 					Name:          "Bob Johnson",
-					StartingBid:   200.0,
-					MaxBid:        100.0, // Invalid: starting > max
-					AutoIncrement: 0.0,   // Invalid: zero increment
+					StartingBid:   models.Dollars(200.0),
+					MaxBid:        models.Dollars(100.0), // Invalid: starting > max
+					AutoIncrement: models.Dollars(0.0),   // Invalid: zero increment
 				},
 			},
 			expectError: true,
@@ -292,9 +293,9 @@ func TestDefaultBidValidator_ValidateBidders(t *testing.T) {
 				{
 					ID:            "bidder1",
 					Name:          "John Doe",
-					StartingBid:   100.0,
-					MaxBid:        500.0,
-					AutoIncrement: 25.0,
+					StartingBid:   models.Dollars(100.0),
+					MaxBid:        models.Dollars(500.0),
+					AutoIncrement: models.Dollars(25.0),
 				},
 			},
 			expectError: false,
@@ -342,9 +343,9 @@ func TestValidationErrorDetails(t *testing.T) {
 	bidder := models.Bidder{
 		ID:            "",
 		Name:          "",
-		StartingBid:   -100.0,
-		MaxBid:        -500.0,
-		AutoIncrement: 0.0,
+		StartingBid:   models.Dollars(-100.0),
+		MaxBid:        models.Dollars(-500.0),
+		AutoIncrement: models.Dollars(0.0),
 	}
 
 	err := validator.ValidateBidder(bidder)
@@ -385,9 +386,9 @@ func BenchmarkValidateBidder(b *testing.B) {
 	bidder := models.Bidder{
 		ID:            "bidder1",
 		Name:          "John Doe",
-		StartingBid:   100.0,
-		MaxBid:        500.0,
-		AutoIncrement: 25.0,
+		StartingBid:   models.Dollars(100.0),
+		MaxBid:        models.Dollars(500.0),
+		AutoIncrement: models.Dollars(25.0),
 		EntryTime:     time.Now(),
 	}
 
@@ -406,9 +407,9 @@ func BenchmarkValidateBidders(b *testing.B) {
 		bidders[i] = models.Bidder{
 			ID:            fmt.Sprintf("bidder%d", i),
 			Name:          fmt.Sprintf("Bidder %d", i),
-			StartingBid:   float64(100 + i),
-			MaxBid:        float64(500 + i*10),
-			AutoIncrement: 25.0,
+			StartingBid:   models.Dollars(float64(100 + i)),
+			MaxBid:        models.Dollars(float64(500 + i*10)),
+			AutoIncrement: models.Dollars(25.0),
 			EntryTime:     time.Now(),
 		}
 	}
@@ -418,3 +419,163 @@ func BenchmarkValidateBidders(b *testing.B) {
 		_ = validator.ValidateBidders(bidders)
 	}
 }
+
+func TestDefaultBidValidator_IncrementSchedule(t *testing.T) {
+	validator := NewBidValidatorWithConfig(models.NewAuctionConfigWithSchedule(models.DefaultIncrementSchedule()))
+
+	withoutIncrement := models.Bidder{
+		ID:          "bidder1",
+		Name:        "John Doe",
+		StartingBid: models.Dollars(100.0),
+		MaxBid:      models.Dollars(500.0),
+		EntryTime:   time.Now(),
+	}
+	if err := validator.ValidateBidder(withoutIncrement); err != nil {
+		t.Errorf("Expected AutoIncrement to be optional with a schedule, got: %v", err)
+	}
+
+	negativeIncrement := withoutIncrement
+	negativeIncrement.AutoIncrement = models.Dollars(-1.0)
+	err := validator.ValidateBidder(negativeIncrement)
+	if err == nil {
+		t.Fatal("Expected error for negative AutoIncrement")
+	}
+	auctionErr, ok := err.(*models.AuctionError)
+	if !ok {
+		t.Fatalf("Expected AuctionError, got %T", err)
+	}
+	if len(auctionErr.Details) != 1 || auctionErr.Details[0].Field != "AutoIncrement" {
+		t.Errorf("Expected a single AutoIncrement error, got %+v", auctionErr.Details)
+	}
+
+	// Without a schedule the increment is still required
+	if err := NewBidValidator().ValidateBidder(withoutIncrement); err == nil {
+		t.Error("Expected AutoIncrement to be required without a schedule")
+	}
+}
+
+func TestDefaultBidValidator_SealedBidFormat(t *testing.T) {
+	validator := NewBidValidatorWithConfig(models.NewAuctionConfigWithFormat(models.FormatFirstPriceSealed))
+
+	sealed := models.Bidder{
+		ID:          "bidder1",
+		Name:        "John Doe",
+		StartingBid: models.Dollars(500.0),
+		MaxBid:      models.Dollars(500.0),
+		EntryTime:   time.Now(),
+	}
+	if err := validator.ValidateBidder(sealed); err != nil {
+		t.Errorf("Expected AutoIncrement to be optional for sealed bids, got: %v", err)
+	}
+
+	sealed.AutoIncrement = models.Dollars(-1.0)
+	if err := validator.ValidateBidder(sealed); !models.HasCode(err, models.CodeBidIncrementNegative) {
+		t.Errorf("Expected a negative AutoIncrement to be rejected, got: %v", err)
+	}
+}
+
+func TestDefaultBidValidator_Quantity(t *testing.T) {
+	validator := NewBidValidatorWithConfig(models.NewAuctionConfigWithUnits(models.MultiUnitConfig{Units: 10}))
+	bidder := models.Bidder{
+		ID:          "bidder1",
+		Name:        "John Doe",
+		StartingBid: models.Dollars(20.0),
+		MaxBid:      models.Dollars(20.0),
+		Quantity:    4,
+		EntryTime:   time.Now(),
+	}
+	if err := validator.ValidateBidder(bidder); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+
+	bidder.Quantity = -1
+	err := validator.ValidateBidder(bidder)
+	if !models.HasCode(err, models.CodeBidQuantityNegative) {
+		t.Fatalf("Expected code %s, got: %v", models.CodeBidQuantityNegative, err)
+	}
+	var auctionErr *models.AuctionError
+	if errors.As(err, &auctionErr) && auctionErr.Details[0].Localize(nil, models.LocaleEnglish) != "quantity cannot be negative" {
+		t.Errorf("Unexpected message: %s", auctionErr.Details[0].Localize(nil, models.LocaleEnglish))
+	}
+}
+
+func TestDefaultBidValidator_Currency(t *testing.T) {
+	validator := NewBidValidator()
+
+	mixed := models.Bidder{
+		ID:            "bidder1",
+		Name:          "John Doe",
+		StartingBid:   models.Dollars(100.0),
+		MaxBid:        models.NewMoney(50000, models.EUR),
+		AutoIncrement: models.NewMoney(500, models.GBP),
+		EntryTime:     time.Now(),
+	}
+	err := validator.ValidateBidder(mixed)
+	if err == nil {
+		t.Fatal("Expected error for mixed currencies")
+	}
+	auctionErr := err.(*models.AuctionError)
+	fields := map[string]bool{}
+	for _, detail := range auctionErr.Details {
+		fields[detail.Field] = true
+	}
+	if !fields["MaxBid"] || !fields["AutoIncrement"] {
+		t.Errorf("Expected MaxBid and AutoIncrement currency errors, got %+v", auctionErr.Details)
+	}
+
+	yen := models.Bidder{
+		ID:            "bidder2",
+		Name:          "Jane Smith",
+		StartingBid:   models.NewMoney(1500, models.JPY),
+		MaxBid:        models.NewMoney(3000, models.JPY),
+		AutoIncrement: models.NewMoney(100, models.JPY),
+		EntryTime:     time.Now(),
+	}
+	if err := validator.ValidateBidder(yen); err != nil {
+		t.Errorf("Expected JPY bidder to be valid, got: %v", err)
+	}
+
+	usd := models.Bidder{
+		ID:            "bidder3",
+		Name:          "Bob Johnson",
+		StartingBid:   models.Dollars(10.0),
+		MaxBid:        models.Dollars(30.0),
+		AutoIncrement: models.Dollars(1.0),
+		EntryTime:     time.Now(),
+	}
+	err = validator.ValidateBidders([]models.Bidder{yen, usd})
+	if err == nil {
+		t.Fatal("Expected error for bidders in different currencies")
+	}
+	auctionErr = err.(*models.AuctionError)
+	if len(auctionErr.Details) != 1 || auctionErr.Details[0].Field != "Currency" || auctionErr.Details[0].BidderID != "bidder3" {
+		t.Errorf("Expected a single Currency error for bidder3, got %+v", auctionErr.Details)
+	}
+	if auctionErr.Details[0].Position != 2 || auctionErr.Details[0].Value != "USD" || auctionErr.Details[0].Limit != "JPY" {
+		t.Errorf("Expected position 2 with value USD against JPY, got %+v", auctionErr.Details[0])
+	}
+}
+
+func TestDefaultBidValidator_MultiCurrency(t *testing.T) {
+	rates := models.NewStaticRateProvider()
+	_ = rates.SetRate(models.EUR, models.USD, "1.10")
+	validator := NewBidValidatorWithConfig(models.NewAuctionConfigWithSettlement(models.USD, rates))
+
+	bidders := []models.Bidder{
+		{ID: "bidder1", Name: "Alice", StartingBid: models.NewMoney(10000, models.EUR), MaxBid: models.NewMoney(20000, models.EUR), AutoIncrement: models.NewMoney(500, models.EUR), EntryTime: time.Now()},
+		{ID: "bidder2", Name: "Bob", StartingBid: models.Dollars(100.0), MaxBid: models.Dollars(250.0), AutoIncrement: models.Dollars(5.0), EntryTime: time.Now()},
+	}
+	if err := validator.ValidateBidders(bidders); err != nil {
+		t.Errorf("Expected mixed currencies with rates to be valid, got: %v", err)
+	}
+
+	bidders = append(bidders, models.Bidder{ID: "bidder3", Name: "Kenji", StartingBid: models.NewMoney(15000, models.JPY), MaxBid: models.NewMoney(30000, models.JPY), AutoIncrement: models.NewMoney(500, models.JPY), EntryTime: time.Now()})
+	err := validator.ValidateBidders(bidders)
+	if err == nil {
+		t.Fatal("Expected error for currency without an exchange rate")
+	}
+	auctionErr := err.(*models.AuctionError)
+	if len(auctionErr.Details) != 1 || auctionErr.Details[0].Field != "Currency" || auctionErr.Details[0].BidderID != "bidder3" {
+		t.Errorf("Expected a single Currency error for bidder3, got %+v", auctionErr.Details)
+	}
+}
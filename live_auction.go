@@ -0,0 +1,308 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// AuctionStatus is a snapshot of a live auction taken after each accepted mutation
+type AuctionStatus struct {
+	LotID        string         `json:"lot_id"`        // Lot the auction is selling
+	Leader       *models.Bidder `json:"leader"`        // Current leading bidder (nil when there is no winning bid yet)
+	CurrentPrice models.Money   `json:"current_price"` // Price the leader would pay if the auction closed now
+	BidderCount  int            `json:"bidder_count"`  // Number of bidders currently in the auction
+	Closed       bool           `json:"closed"`        // Whether the auction has been closed
+}
+
+// Auction is a stateful auction for a single lot that accepts bids one at a time until it closes
+// Every mutation re-runs the service's validator and bidding engine over the whole bid set, so the
+// result after Close is exactly what DetermineWinner returns for the final bidders; an auction
+// closed without bids goes unsold instead
+// Mutations settle the bid set without notifying the service's observers, which hear about the
+// auction only when Close settles it
+// When the service's configuration has a soft-close policy, a bid that takes the lead near the
+// end pushes the close time back; the extensions are recorded in the result
+// An Auction is not safe for concurrent use
+type Auction struct {
+	lotID         string
+	originalClose time.Time
+	closeTime     time.Time
+	service       *AuctionService
+	clock         models.Clock
+	bidders       []models.Bidder         // Bid set in arrival order
+	current       *models.BidResult       // Settled outcome of the current bid set (nil when there are no bids)
+	result        *models.BidResult       // Final outcome, set by Close
+	closeErr      error                   // Settlement failure, set by Close
+	extensions    []models.CloseExtension // Soft-close extensions, oldest first
+	lastSequence  uint64                  // Sequence number given to the most recent PlaceBid
+	closed        bool
+}
+
+// NewAuction creates a new open Auction for a lot using the default auction service
+func NewAuction(lotID string, closeTime time.Time) *Auction {
+	return NewAuctionWithService(lotID, closeTime, NewAuctionService())
+}
+
+// NewAuctionWithService creates a new open Auction that validates and settles bids with the given service
+// The auction reads the current time from the service's clock
+func NewAuctionWithService(lotID string, closeTime time.Time, service *AuctionService) *Auction {
+	return NewAuctionWithClock(lotID, closeTime, service, service.Clock())
+}
+
+// NewAuctionWithClock creates a new open Auction that reads the current time from the given clock
+func NewAuctionWithClock(lotID string, closeTime time.Time, service *AuctionService, clock models.Clock) *Auction {
+	return &Auction{
+		lotID:         lotID,
+		originalClose: closeTime,
+		closeTime:     closeTime,
+		service:       service,
+		clock:         clock,
+	}
+}
+
+// LotID returns the lot the auction is selling
+func (a *Auction) LotID() string {
+	return a.lotID
+}
+
+// CloseTime returns when the auction stops accepting bids, including any soft-close extensions
+func (a *Auction) CloseTime() time.Time {
+	return a.closeTime
+}
+
+// OriginalCloseTime returns the close time the auction was opened with
+func (a *Auction) OriginalCloseTime() time.Time {
+	return a.originalClose
+}
+
+// Extensions returns a copy of the soft-close extensions applied so far
+func (a *Auction) Extensions() []models.CloseExtension {
+	extensions := make([]models.CloseExtension, len(a.extensions))
+	copy(extensions, a.extensions)
+	return extensions
+}
+
+// IsClosed returns true once Close has been called
+func (a *Auction) IsClosed() bool {
+	return a.closed
+}
+
+// Bidders returns a copy of the current bid set in arrival order
+func (a *Auction) Bidders() []models.Bidder {
+	bidders := make([]models.Bidder, len(a.bidders))
+	copy(bidders, a.bidders)
+	return bidders
+}
+
+// Status returns the current leader and price without changing the auction
+func (a *Auction) Status() *AuctionStatus {
+	status := &AuctionStatus{
+		LotID:       a.lotID,
+		BidderCount: len(a.bidders),
+		Closed:      a.closed,
+	}
+	outcome := a.current
+	if a.closed {
+		outcome = a.result
+	}
+	if outcome != nil {
+		status.Leader = outcome.Winner
+		status.CurrentPrice = outcome.WinningBid
+	}
+	return status
+}
+
+// Result returns the final result, or nil if the auction has not been closed
+func (a *Auction) Result() *models.BidResult {
+	return a.result
+}
+
+// PlaceBid adds a new bidder to the auction and returns the resulting leader and price
+// A bidder without an entry time is stamped with the current time, and every accepted bid is
+// numbered in arrival order so that bids with identical entry times still tie-break reproducibly
+func (a *Auction) PlaceBid(bidder models.Bidder) (*AuctionStatus, error) {
+	if err := a.checkOpen("PlaceBid"); err != nil {
+		return nil, err
+	}
+	if bidder.EntryTime.IsZero() {
+		bidder.EntryTime = a.clock.Now()
+	}
+	bidder.Sequence = a.lastSequence + 1
+
+	bidders := append(a.Bidders(), bidder)
+	if err := a.applyBid(bidders, "PlaceBid"); err != nil {
+		return nil, err
+	}
+	a.lastSequence = bidder.Sequence
+	return a.Status(), nil
+}
+
+// RaiseMaxBid increases an existing bidder's maximum bid, keeping their original entry time
+func (a *Auction) RaiseMaxBid(bidderID string, maxBid models.Money) (*AuctionStatus, error) {
+	if err := a.checkOpen("RaiseMaxBid"); err != nil {
+		return nil, err
+	}
+
+	index, err := a.indexOf(bidderID, "RaiseMaxBid")
+	if err != nil {
+		return nil, err
+	}
+	bidders := a.Bidders()
+	existing := bidders[index]
+	if !maxBid.SameCurrency(existing.MaxBid) || maxBid.Amount() <= existing.MaxBid.Amount() {
+		validationErr := models.NewLocalizedValidationError(bidderID, "MaxBid", models.MsgBidMaxNotRaised, maxBid.String(), existing.MaxBid.String()).WithCode(models.CodeBidMaxNotRaised)
+		auctionErr := models.NewAuctionError(models.ErrorTypeValidation, fmt.Sprintf("cannot raise maximum bid for bidder %s", bidderID), []*models.ValidationError{validationErr})
+		auctionErr.WithOperation("RaiseMaxBid").WithCode(models.CodeBidMaxNotRaised)
+		auctionErr.AddContext("lot_id", a.lotID)
+		return nil, auctionErr
+	}
+
+	bidders[index].MaxBid = maxBid
+	if err := a.applyBid(bidders, "RaiseMaxBid"); err != nil {
+		return nil, err
+	}
+	return a.Status(), nil
+}
+
+// Retract removes a bidder from the auction
+func (a *Auction) Retract(bidderID string) (*AuctionStatus, error) {
+	if err := a.checkOpen("Retract"); err != nil {
+		return nil, err
+	}
+
+	index, err := a.indexOf(bidderID, "Retract")
+	if err != nil {
+		return nil, err
+	}
+	bidders := a.Bidders()
+	bidders = append(bidders[:index], bidders[index+1:]...)
+	if err := a.apply(bidders, "Retract"); err != nil {
+		return nil, err
+	}
+	return a.Status(), nil
+}
+
+// Close stops the auction and returns the final result
+// The result and any error are those DetermineWinner returns for the final bid set; the auction
+// is closed even if settlement fails, and later calls return the same result and error
+// Without bids the lot goes unsold: the result has no winner and ReserveMet is false
+func (a *Auction) Close() (*models.BidResult, error) {
+	if a.closed {
+		return a.result, a.closeErr
+	}
+	a.closed = true
+	if len(a.bidders) == 0 {
+		a.result = a.unsold()
+		a.service.announce(a.result)
+		return a.result, nil
+	}
+
+	result, err := a.service.settle(context.Background(), a.Bidders())
+	if err != nil {
+		var auctionErr *models.AuctionError
+		if errors.As(err, &auctionErr) {
+			auctionErr.AddContext("lot_id", a.lotID)
+		}
+		a.closeErr = err
+		return nil, err
+	}
+	if len(a.extensions) > 0 {
+		result.Extensions = a.Extensions()
+	}
+	a.result = result
+	a.service.announce(result)
+	return result, nil
+}
+
+// unsold returns the result of an auction that closed without bids
+func (a *Auction) unsold() *models.BidResult {
+	config := a.service.Config()
+	result := models.NewBidResultInCurrency(nil, 0, config.Settlement(), 0, 0, nil)
+	result.Format = config.AuctionFormat()
+	return result.WithReserve(config.ReservePriceCents, false)
+}
+
+// applyBid applies a bid set like apply and extends the close time if the bid changed the leader
+func (a *Auction) applyBid(bidders []models.Bidder, operation string) error {
+	previousLeader := a.leaderID()
+	if err := a.apply(bidders, operation); err != nil {
+		return err
+	}
+
+	leader := a.leaderID()
+	if leader == "" || leader == previousLeader {
+		return nil
+	}
+	bidTime := a.clock.Now()
+	policy := a.service.Config().SoftClose
+	if newClose, extended := policy.Extend(a.originalClose, a.closeTime, bidTime); extended {
+		a.extensions = append(a.extensions, models.CloseExtension{
+			BidderID:      leader,
+			BidTime:       bidTime,
+			PreviousClose: a.closeTime,
+			NewClose:      newClose,
+		})
+		a.closeTime = newClose
+	}
+	return nil
+}
+
+// leaderID returns the ID of the current leading bidder, or "" if there is none
+func (a *Auction) leaderID() string {
+	if a.current == nil || a.current.Winner == nil {
+		return ""
+	}
+	return a.current.Winner.ID
+}
+
+// apply settles a candidate bid set and adopts it only if it is valid
+func (a *Auction) apply(bidders []models.Bidder, operation string) error {
+	if len(bidders) == 0 {
+		a.bidders = nil
+		a.current = nil
+		return nil
+	}
+
+	result, err := a.service.quiet.settle(context.Background(), bidders)
+	if err != nil {
+		var auctionErr *models.AuctionError
+		if errors.As(err, &auctionErr) {
+			auctionErr.WithOperation(operation)
+			auctionErr.AddContext("lot_id", a.lotID)
+		}
+		return err
+	}
+	a.bidders = bidders
+	a.current = result
+	return nil
+}
+
+// checkOpen returns an error if the auction no longer accepts mutations
+func (a *Auction) checkOpen(operation string) error {
+	if !a.closed && a.clock.Now().Before(a.closeTime) {
+		return nil
+	}
+	auctionErr := models.NewAuctionError(models.ErrorTypeValidation, "auction is closed", nil)
+	auctionErr.WithOperation(operation).WithCode(models.CodeAuctionClosed)
+	auctionErr.AddContext("lot_id", a.lotID)
+	auctionErr.AddContext("close_time", a.closeTime.Format(time.RFC3339))
+	return auctionErr
+}
+
+// indexOf returns the position of a bidder in the bid set
+func (a *Auction) indexOf(bidderID, operation string) (int, error) {
+	for i, bidder := range a.bidders {
+		if bidder.ID == bidderID {
+			return i, nil
+		}
+	}
+	validationErr := models.NewLocalizedValidationError(bidderID, "ID", models.MsgBidderNotFound, bidderID, "").WithCode(models.CodeBidderNotFound)
+	auctionErr := models.NewAuctionError(models.ErrorTypeValidation, fmt.Sprintf("bidder %s has not bid on lot %s", bidderID, a.lotID), []*models.ValidationError{validationErr})
+	auctionErr.WithOperation(operation).WithCode(models.CodeBidderNotFound)
+	auctionErr.AddContext("lot_id", a.lotID)
+	return -1, auctionErr
+}
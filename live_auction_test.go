@@ -0,0 +1,407 @@
+package auction
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/clocktest"
+	"auction-bidding-algorithm/internal/models"
+)
+
+// liveBidder builds a bidder entering the auction offset seconds after baseTime
+func liveBidder(id string, start, max, increment float64, baseTime time.Time, offset int) models.Bidder {
+	return models.Bidder{
+		ID:            id,
+		Name:          "Bidder " + id,
+		StartingBid:   models.Dollars(start),
+		MaxBid:        models.Dollars(max),
+		AutoIncrement: models.Dollars(increment),
+		EntryTime:     baseTime.Add(time.Duration(offset) * time.Second),
+	}
+}
+
+func TestAuction_PlaceBid(t *testing.T) {
+	baseTime := time.Now()
+	auction := NewAuction("lot-1", baseTime.Add(time.Hour))
+
+	status, err := auction.PlaceBid(liveBidder("alice", 100, 200, 10, baseTime, 0))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Leader == nil || status.Leader.ID != "alice" {
+		t.Fatalf("Expected Alice to lead, got %+v", status.Leader)
+	}
+	if status.CurrentPrice.Float64() != 100 {
+		t.Errorf("Expected price 100.00, got %s", status.CurrentPrice)
+	}
+
+	status, err = auction.PlaceBid(liveBidder("bob", 110, 150, 10, baseTime, 1))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Leader.ID != "alice" {
+		t.Errorf("Expected Alice to keep the lead, got %s", status.Leader.ID)
+	}
+	if status.CurrentPrice.Float64() != 160 {
+		t.Errorf("Expected price 160.00, got %s", status.CurrentPrice)
+	}
+	if status.BidderCount != 2 || status.LotID != "lot-1" || status.Closed {
+		t.Errorf("Unexpected status: %+v", status)
+	}
+}
+
+func TestAuction_PlaceBidRejectsInvalidBid(t *testing.T) {
+	baseTime := time.Now()
+	auction := NewAuction("lot-1", baseTime.Add(time.Hour))
+	if _, err := auction.PlaceBid(liveBidder("alice", 100, 200, 10, baseTime, 0)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	_, err := auction.PlaceBid(liveBidder("bob", 300, 150, 10, baseTime, 1))
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+	auctionErr, ok := err.(*models.AuctionError)
+	if !ok || auctionErr.Type != models.ErrorTypeValidation {
+		t.Fatalf("Expected validation AuctionError, got %T: %v", err, err)
+	}
+	if auctionErr.Context["lot_id"] != "lot-1" {
+		t.Errorf("Expected lot_id context, got %v", auctionErr.Context)
+	}
+
+	if _, err := auction.PlaceBid(liveBidder("alice", 120, 220, 10, baseTime, 2)); err == nil {
+		t.Error("Expected duplicate bidder to be rejected")
+	}
+	if len(auction.Bidders()) != 1 {
+		t.Errorf("Expected rejected bids to leave the auction unchanged, got %d bidders", len(auction.Bidders()))
+	}
+}
+
+func TestAuction_RaiseMaxBid(t *testing.T) {
+	baseTime := time.Now()
+	auction := NewAuction("lot-1", baseTime.Add(time.Hour))
+	_, _ = auction.PlaceBid(liveBidder("alice", 100, 200, 10, baseTime, 0))
+	_, _ = auction.PlaceBid(liveBidder("bob", 115, 250, 10, baseTime, 1))
+
+	if auction.Status().Leader.ID != "bob" {
+		t.Fatalf("Expected Bob to lead, got %s", auction.Status().Leader.ID)
+	}
+
+	status, err := auction.RaiseMaxBid("alice", models.Dollars(300))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Leader.ID != "alice" {
+		t.Errorf("Expected Alice to retake the lead, got %s", status.Leader.ID)
+	}
+	if !auction.Bidders()[0].EntryTime.Equal(baseTime) {
+		t.Error("Expected raising the maximum bid to keep the original entry time")
+	}
+
+	if _, err := auction.RaiseMaxBid("alice", models.Dollars(250)); !models.HasCode(err, models.CodeBidMaxNotRaised) {
+		t.Errorf("Expected lowering the maximum bid to be rejected with %s, got: %v", models.CodeBidMaxNotRaised, err)
+	}
+	if _, err := auction.RaiseMaxBid("carol", models.Dollars(500)); !models.HasCode(err, models.CodeBidderNotFound) {
+		t.Errorf("Expected unknown bidder to be rejected with %s, got: %v", models.CodeBidderNotFound, err)
+	}
+}
+
+func TestAuction_Retract(t *testing.T) {
+	baseTime := time.Now()
+	auction := NewAuction("lot-1", baseTime.Add(time.Hour))
+	_, _ = auction.PlaceBid(liveBidder("alice", 100, 200, 10, baseTime, 0))
+	_, _ = auction.PlaceBid(liveBidder("bob", 110, 250, 10, baseTime, 1))
+
+	status, err := auction.Retract("bob")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Leader.ID != "alice" || status.CurrentPrice.Float64() != 100 {
+		t.Errorf("Expected Alice to lead at 100.00, got %s at %s", status.Leader.ID, status.CurrentPrice)
+	}
+
+	status, err = auction.Retract("alice")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Leader != nil || status.BidderCount != 0 {
+		t.Errorf("Expected empty auction, got %+v", status)
+	}
+
+	if _, err := auction.Retract("alice"); !models.HasCode(err, models.CodeBidderNotFound) {
+		t.Errorf("Expected retracting a missing bidder to fail with %s, got: %v", models.CodeBidderNotFound, err)
+	}
+}
+
+func TestAuction_Close(t *testing.T) {
+	baseTime := time.Now()
+	auction := NewAuction("lot-1", baseTime.Add(time.Hour))
+	_, _ = auction.PlaceBid(liveBidder("alice", 100, 200, 10, baseTime, 0))
+	_, _ = auction.PlaceBid(liveBidder("bob", 110, 150, 10, baseTime, 1))
+
+	result, err := auction.Close()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !auction.IsClosed() || auction.Result() != result || !auction.Status().Closed {
+		t.Error("Expected auction to report closed with its result")
+	}
+
+	again, err := auction.Close()
+	if err != nil || again != result {
+		t.Error("Expected closing twice to return the same result")
+	}
+
+	if _, err := auction.PlaceBid(liveBidder("carol", 100, 500, 10, baseTime, 2)); !models.HasCode(err, models.CodeAuctionClosed) {
+		t.Errorf("Expected bids after close to be rejected with %s, got: %v", models.CodeAuctionClosed, err)
+	}
+	if _, err := auction.RaiseMaxBid("bob", models.Dollars(500)); err == nil {
+		t.Error("Expected raises after close to be rejected")
+	}
+	if _, err := auction.Retract("bob"); err == nil {
+		t.Error("Expected retractions after close to be rejected")
+	}
+}
+
+func TestAuction_RejectsBidsAfterCloseTime(t *testing.T) {
+	baseTime := time.Now()
+	auction := NewAuction("lot-1", baseTime.Add(-time.Second))
+
+	_, err := auction.PlaceBid(liveBidder("alice", 100, 200, 10, baseTime, 0))
+	if err == nil {
+		t.Fatal("Expected bid after close time to be rejected")
+	}
+	if auctionErr, ok := err.(*models.AuctionError); !ok || auctionErr.Operation != "PlaceBid" {
+		t.Errorf("Expected PlaceBid AuctionError, got %v", err)
+	}
+}
+
+func TestAuction_CloseWithoutBids(t *testing.T) {
+	service := NewAuctionService(WithConfig(models.NewAuctionConfigWithReserve(5000)))
+	auction := NewAuctionWithService("lot-1", time.Now().Add(time.Hour), service)
+
+	result, err := auction.Close()
+	if err != nil {
+		t.Fatalf("Expected an unsold lot rather than an error, got: %v", err)
+	}
+	if result.Winner != nil || result.ReserveMet || result.TotalBidders != 0 {
+		t.Errorf("Expected no winner and an unmet reserve, got %+v", result)
+	}
+	if result.ReservePrice.Amount() != 5000 || result.Format != models.FormatEnglish {
+		t.Errorf("Expected the lot's reserve and format to be recorded, got %s under %q", result.ReservePrice, result.Format)
+	}
+	if !auction.IsClosed() {
+		t.Error("Expected auction to be closed")
+	}
+
+	again, againErr := auction.Close()
+	if again != result || againErr != nil {
+		t.Errorf("Expected closing twice to return the same result, got %v and %v", again, againErr)
+	}
+}
+
+func TestAuction_CloseRepeatsSettlementError(t *testing.T) {
+	engine := &MockBiddingEngine{result: &models.BidResult{}}
+	auction := NewAuctionWithService("lot-1", time.Now().Add(time.Hour), NewAuctionService(WithEngine(engine)))
+	if _, err := auction.PlaceBid(liveBidder("alice", 100, 200, 10, time.Now(), 0)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	engine.shouldError = true
+	engine.errorType = models.ErrorTypeSystem
+	engine.errorMsg = "engine down"
+	_, err := auction.Close()
+	if err == nil {
+		t.Fatal("Expected the settlement error")
+	}
+	again, againErr := auction.Close()
+	if again != nil || againErr != err {
+		t.Errorf("Expected closing twice to return the same error, got %v and %v", again, againErr)
+	}
+}
+
+// TestAuction_MatchesDetermineWinner replays random mutation sequences and requires the closed
+// auction to agree with a single DetermineWinner call over the final bid set
+func TestAuction_MatchesDetermineWinner(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	baseTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	service := NewAuctionService(WithStrategy(StrategyClosedForm))
+
+	for trial := 0; trial < 200; trial++ {
+		auction := NewAuctionWithService(fmt.Sprintf("lot-%d", trial), time.Now().Add(time.Hour), service)
+		next := 0
+		for step := 0; step < 12; step++ {
+			bidders := auction.Bidders()
+			switch op := rng.Intn(4); {
+			case op <= 1 || len(bidders) == 0:
+				start := float64(100 + rng.Intn(40)*5)
+				_, _ = auction.PlaceBid(liveBidder(fmt.Sprintf("b%d", next), start, start+float64(rng.Intn(60)*5), float64(1+rng.Intn(20)), baseTime, next))
+				next++
+			case op == 2:
+				target := bidders[rng.Intn(len(bidders))]
+				_, _ = auction.RaiseMaxBid(target.ID, models.NewMoney(target.GetMaxBidCents()+int64(rng.Intn(5000)), models.USD))
+			default:
+				_, _ = auction.Retract(bidders[rng.Intn(len(bidders))].ID)
+			}
+		}
+
+		final := auction.Bidders()
+		if len(final) == 0 {
+			continue
+		}
+		expected, err := service.DetermineWinner(final)
+		if err != nil {
+			t.Fatalf("trial %d: DetermineWinner failed: %v", trial, err)
+		}
+		actual, err := auction.Close()
+		if err != nil {
+			t.Fatalf("trial %d: Close failed: %v", trial, err)
+		}
+		if (expected.Winner == nil) != (actual.Winner == nil) || (expected.Winner != nil && expected.Winner.ID != actual.Winner.ID) {
+			t.Fatalf("trial %d: winners differ: %+v vs %+v", trial, expected.Winner, actual.Winner)
+		}
+		if !expected.WinningBid.Equal(actual.WinningBid) || expected.BiddingRounds != actual.BiddingRounds || expected.TotalBidders != actual.TotalBidders {
+			t.Fatalf("trial %d: results differ: %+v vs %+v", trial, expected, actual)
+		}
+	}
+}
+
+// softCloseAuction opens an auction closing at an hour past the clock's time with a
+// five-minute window, two-minute extensions and the given hard cap
+func softCloseAuction(clock *clocktest.FakeClock, hardCap time.Duration) *Auction {
+	config := models.NewAuctionConfigWithSoftClose(models.SoftClosePolicy{Window: 5 * time.Minute, Extension: 2 * time.Minute, HardCap: hardCap})
+	return NewAuctionWithClock("lot-1", clock.Now().Add(time.Hour), NewAuctionService(WithConfig(config)), clock)
+}
+
+func TestAuction_SoftCloseExtendsOnLeaderChange(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	auction := softCloseAuction(clock, 0)
+	originalClose := auction.CloseTime()
+
+	// Early bids do not extend the auction
+	if _, err := auction.PlaceBid(liveBidder("alice", 100, 200, 10, clock.Now(), 0)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !auction.CloseTime().Equal(originalClose) {
+		t.Errorf("Expected no extension for an early bid, got %v", auction.CloseTime())
+	}
+
+	// A late bid that does not take the lead does not extend the auction
+	clock.Advance(58 * time.Minute)
+	if _, err := auction.PlaceBid(liveBidder("bob", 105, 150, 10, clock.Now(), 0)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !auction.CloseTime().Equal(originalClose) {
+		t.Errorf("Expected no extension when the leader is unchanged, got %v", auction.CloseTime())
+	}
+
+	// A late bid that takes the lead extends the auction
+	status, err := auction.PlaceBid(liveBidder("carol", 111, 300, 10, clock.Now(), 0))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Leader.ID != "carol" {
+		t.Fatalf("Expected Carol to lead, got %s", status.Leader.ID)
+	}
+	if !auction.CloseTime().Equal(originalClose.Add(2 * time.Minute)) {
+		t.Errorf("Expected close time to move back two minutes, got %v", auction.CloseTime())
+	}
+
+	// The original close time has passed but the extended auction still accepts bids
+	clock.Advance(3 * time.Minute)
+	if _, err := auction.RaiseMaxBid("alice", models.Dollars(400)); err != nil {
+		t.Fatalf("Expected bid during extension to be accepted, got: %v", err)
+	}
+	if !auction.CloseTime().Equal(originalClose.Add(4 * time.Minute)) {
+		t.Errorf("Expected a second extension, got %v", auction.CloseTime())
+	}
+
+	clock.Advance(3 * time.Minute)
+	if _, err := auction.PlaceBid(liveBidder("dave", 100, 900, 10, clock.Now(), 0)); err == nil {
+		t.Error("Expected bid after the extended close time to be rejected")
+	}
+
+	result, err := auction.Close()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(result.Extensions) != 2 {
+		t.Fatalf("Expected 2 extensions in the result, got %d", len(result.Extensions))
+	}
+	first, second := result.Extensions[0], result.Extensions[1]
+	if first.BidderID != "carol" || !first.PreviousClose.Equal(originalClose) || !first.NewClose.Equal(originalClose.Add(2*time.Minute)) {
+		t.Errorf("Unexpected first extension: %+v", first)
+	}
+	if second.BidderID != "alice" || !second.PreviousClose.Equal(first.NewClose) || !second.BidTime.Equal(originalClose.Add(time.Minute)) {
+		t.Errorf("Unexpected second extension: %+v", second)
+	}
+}
+
+func TestAuction_SoftCloseHardCap(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	auction := softCloseAuction(clock, 3*time.Minute)
+	originalClose := auction.CloseTime()
+
+	clock.Advance(59 * time.Minute)
+	_, _ = auction.PlaceBid(liveBidder("alice", 100, 200, 10, clock.Now(), 0))
+	_, _ = auction.PlaceBid(liveBidder("bob", 105, 300, 10, clock.Now(), 0))
+	_, _ = auction.PlaceBid(liveBidder("carol", 110, 400, 10, clock.Now(), 0))
+
+	if !auction.CloseTime().Equal(originalClose.Add(3 * time.Minute)) {
+		t.Errorf("Expected close time capped at three minutes past the original, got %v", auction.CloseTime())
+	}
+	extensions := auction.Extensions()
+	if len(extensions) != 2 {
+		t.Fatalf("Expected 2 extensions before reaching the cap, got %d", len(extensions))
+	}
+	if !extensions[1].NewClose.Equal(originalClose.Add(3 * time.Minute)) {
+		t.Errorf("Expected the last extension to stop at the cap, got %v", extensions[1].NewClose)
+	}
+	if !auction.OriginalCloseTime().Equal(originalClose) {
+		t.Errorf("Expected original close time to be kept, got %v", auction.OriginalCloseTime())
+	}
+}
+
+func TestAuction_WithoutSoftCloseHasNoExtensions(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	auction := NewAuctionWithClock("lot-1", clock.Now().Add(time.Minute), NewAuctionService(), clock)
+
+	clock.Advance(59 * time.Second)
+	_, _ = auction.PlaceBid(liveBidder("alice", 100, 200, 10, clock.Now(), 0))
+	result, err := auction.Close()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Extensions != nil {
+		t.Errorf("Expected no extensions, got %+v", result.Extensions)
+	}
+}
+
+func TestAuction_SequenceBreaksSimultaneousBids(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	auction := NewAuctionWithClock("lot-1", clock.Now().Add(time.Hour), NewAuctionService(), clock)
+
+	// Both bids arrive at the same instant with identical terms
+	_, _ = auction.PlaceBid(liveBidder("alice", 100, 150, 10, clock.Now(), 0))
+	status, err := auction.PlaceBid(liveBidder("bob", 100, 150, 10, clock.Now(), 0))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Leader.ID != "alice" {
+		t.Errorf("Expected the first bid placed to lead, got %s", status.Leader.ID)
+	}
+
+	bidders := auction.Bidders()
+	if bidders[0].Sequence != 1 || bidders[1].Sequence != 2 {
+		t.Errorf("Expected sequences 1 and 2, got %d and %d", bidders[0].Sequence, bidders[1].Sequence)
+	}
+
+	// Rejected bids do not consume a sequence number
+	_, _ = auction.PlaceBid(liveBidder("carol", 300, 150, 10, clock.Now(), 0))
+	_, _ = auction.PlaceBid(liveBidder("dave", 100, 150, 10, clock.Now(), 0))
+	if last := auction.Bidders()[2]; last.ID != "dave" || last.Sequence != 3 {
+		t.Errorf("Expected dave with sequence 3, got %s with %d", last.ID, last.Sequence)
+	}
+}
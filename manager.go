@@ -0,0 +1,280 @@
+package auction
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// AuctionManager hosts many live auctions at once, keyed by lot ID
+// Mutations of one auction are serialized while different auctions proceed in parallel,
+// and each auction is closed automatically when the service's clock reaches its close time
+type AuctionManager struct {
+	service *AuctionService
+
+	mu       sync.RWMutex               // Guards the registries and the shutdown flag
+	open     map[string]*managedAuction // Auctions still accepting bids
+	closed   map[string]closedLot       // Outcomes of auctions that have closed
+	shutdown bool                       // Set once Shutdown has been called
+	pending  sync.WaitGroup             // In-flight operations, including automatic closes
+}
+
+// closedLot is the outcome of a closed auction
+type closedLot struct {
+	result *models.BidResult
+	err    error // Settlement error, nil if the auction settled
+}
+
+// managedAuction pairs an auction with the lock that serializes its mutations
+type managedAuction struct {
+	mu      sync.Mutex
+	auction *Auction
+	timer   models.Timer // Fires the automatic close
+}
+
+// NewAuctionManager creates a new AuctionManager whose auctions use the default auction service
+func NewAuctionManager() *AuctionManager {
+	return NewAuctionManagerWithService(NewAuctionService())
+}
+
+// NewAuctionManagerWithService creates a new AuctionManager whose auctions use the given service
+// Automatic closes are scheduled on the service's clock when it is a models.TimerClock, such as the
+// system clock or clocktest.FakeClock; other clocks are waited on with wall-clock timers
+func NewAuctionManagerWithService(service *AuctionService) *AuctionManager {
+	return &AuctionManager{
+		service: service,
+		open:    make(map[string]*managedAuction),
+		closed:  make(map[string]closedLot),
+	}
+}
+
+// Open starts a new auction for a lot and schedules it to close at closeTime
+func (m *AuctionManager) Open(lotID string, closeTime time.Time) (*AuctionStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.shutdown {
+		return nil, m.shutdownError("Open", lotID)
+	}
+	if _, exists := m.open[lotID]; exists {
+		return nil, m.lotError("auction already open for lot", "Open", lotID, models.CodeManagerLotExists)
+	}
+	if _, exists := m.closed[lotID]; exists {
+		return nil, m.lotError("auction already closed for lot", "Open", lotID, models.CodeManagerLotExists)
+	}
+
+	entry := &managedAuction{auction: NewAuctionWithService(lotID, closeTime, m.service)}
+	entry.timer = m.afterFunc(m.untilClock(closeTime), func() {
+		m.autoClose(lotID)
+	})
+	m.open[lotID] = entry
+	return entry.auction.Status(), nil
+}
+
+// PlaceBid adds a bidder to the lot's auction
+func (m *AuctionManager) PlaceBid(lotID string, bidder models.Bidder) (*AuctionStatus, error) {
+	var status *AuctionStatus
+	err := m.withAuction(lotID, "PlaceBid", func(auction *Auction) error {
+		var err error
+		status, err = auction.PlaceBid(bidder)
+		return err
+	})
+	return status, err
+}
+
+// RaiseMaxBid increases a bidder's maximum bid in the lot's auction
+func (m *AuctionManager) RaiseMaxBid(lotID, bidderID string, maxBid models.Money) (*AuctionStatus, error) {
+	var status *AuctionStatus
+	err := m.withAuction(lotID, "RaiseMaxBid", func(auction *Auction) error {
+		var err error
+		status, err = auction.RaiseMaxBid(bidderID, maxBid)
+		return err
+	})
+	return status, err
+}
+
+// Retract removes a bidder from the lot's auction
+func (m *AuctionManager) Retract(lotID, bidderID string) (*AuctionStatus, error) {
+	var status *AuctionStatus
+	err := m.withAuction(lotID, "Retract", func(auction *Auction) error {
+		var err error
+		status, err = auction.Retract(bidderID)
+		return err
+	})
+	return status, err
+}
+
+// Status returns a snapshot of the lot's open auction
+func (m *AuctionManager) Status(lotID string) (*AuctionStatus, error) {
+	var status *AuctionStatus
+	err := m.withAuction(lotID, "Status", func(auction *Auction) error {
+		status = auction.Status()
+		return nil
+	})
+	return status, err
+}
+
+// Close closes the lot's auction ahead of its close time and returns the final result
+// A lot whose auction fails to settle is still closed, and recorded with a nil result and its error
+func (m *AuctionManager) Close(lotID string) (*models.BidResult, error) {
+	entry, err := m.acquire(lotID, "Close")
+	if err != nil {
+		return nil, err
+	}
+	defer m.pending.Done()
+
+	entry.mu.Lock()
+	result, err := entry.auction.Close()
+	closed := entry.auction.IsClosed()
+	entry.mu.Unlock()
+
+	m.mu.Lock()
+	if current, ok := m.open[lotID]; ok && current == entry && closed {
+		entry.timer.Stop()
+		delete(m.open, lotID)
+		m.closed[lotID] = closedLot{result: result, err: err}
+	}
+	m.mu.Unlock()
+	return result, err
+}
+
+// Result returns the final result of a closed lot
+// The boolean is false if the lot is unknown or still open; a lot that failed to settle has a nil result (see Err)
+func (m *AuctionManager) Result(lotID string) (*models.BidResult, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	lot, ok := m.closed[lotID]
+	return lot.result, ok
+}
+
+// Err returns the settlement error of a closed lot, or nil if it settled, is still open or is unknown
+func (m *AuctionManager) Err(lotID string) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.closed[lotID].err
+}
+
+// OpenLots returns the IDs of all open auctions in sorted order
+func (m *AuctionManager) OpenLots() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	lots := make([]string, 0, len(m.open))
+	for lotID := range m.open {
+		lots = append(lots, lotID)
+	}
+	sort.Strings(lots)
+	return lots
+}
+
+// Shutdown stops accepting operations, cancels automatic closes and waits for in-flight
+// operations to finish. Open auctions are left open. It returns the context's error if the
+// context ends before the pending operations drain.
+func (m *AuctionManager) Shutdown(ctx context.Context) error {
+	m.mu.Lock()
+	m.shutdown = true
+	for _, entry := range m.open {
+		entry.timer.Stop()
+	}
+	m.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		m.pending.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// withAuction runs fn on the lot's auction while holding that auction's lock
+func (m *AuctionManager) withAuction(lotID, operation string, fn func(auction *Auction) error) error {
+	entry, err := m.acquire(lotID, operation)
+	if err != nil {
+		return err
+	}
+	defer m.pending.Done()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	return fn(entry.auction)
+}
+
+// acquire looks up the lot's open auction and registers a pending operation on it
+// The caller must call m.pending.Done once the operation, including any registry update, is finished
+func (m *AuctionManager) acquire(lotID, operation string) (*managedAuction, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.shutdown {
+		return nil, m.shutdownError(operation, lotID)
+	}
+	entry, ok := m.open[lotID]
+	if !ok {
+		return nil, m.lotError("no open auction for lot", operation, lotID, models.CodeManagerLotNotOpen)
+	}
+	// Registered under the read lock so Shutdown cannot start waiting before the count is raised
+	m.pending.Add(1)
+	return entry, nil
+}
+
+// autoClose closes the lot when its timer fires, rescheduling it if soft close has pushed
+// the close time back in the meantime
+func (m *AuctionManager) autoClose(lotID string) {
+	var closeTime time.Time
+	err := m.withAuction(lotID, "AutoClose", func(auction *Auction) error {
+		closeTime = auction.CloseTime()
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	if delay := m.untilClock(closeTime); delay > 0 {
+		m.mu.RLock()
+		if entry, ok := m.open[lotID]; ok && !m.shutdown {
+			entry.timer.Reset(delay)
+		}
+		m.mu.RUnlock()
+		return
+	}
+	if _, err := m.Close(lotID); err != nil && !models.HasCode(err, models.CodeManagerShutDown) {
+		m.service.warn("automatic close failed", "lot_id", lotID, "error", err)
+	}
+}
+
+// untilClock returns the time left on the service's clock until t
+func (m *AuctionManager) untilClock(t time.Time) time.Duration {
+	return t.Sub(m.service.Clock().Now())
+}
+
+// afterFunc schedules f on the service's clock, or on the wall clock if it cannot schedule calls
+func (m *AuctionManager) afterFunc(d time.Duration, f func()) models.Timer {
+	if clock, ok := m.service.Clock().(models.TimerClock); ok {
+		return clock.AfterFunc(d, f)
+	}
+	return time.AfterFunc(d, f)
+}
+
+// lotError builds the error returned for an operation on a missing or conflicting lot
+func (m *AuctionManager) lotError(message, operation, lotID string, code models.ErrorCode) error {
+	auctionErr := models.NewAuctionError(models.ErrorTypeValidation, fmt.Sprintf("%s %s", message, lotID), nil)
+	auctionErr.WithOperation("AuctionManager." + operation).WithCode(code)
+	auctionErr.AddContext("lot_id", lotID)
+	return auctionErr
+}
+
+// shutdownError builds the error returned for operations after Shutdown
+func (m *AuctionManager) shutdownError(operation, lotID string) error {
+	systemErr := models.NewSystemError("auction manager is shut down", "AuctionManager", "low")
+	systemErr.WithOperation("AuctionManager." + operation).WithCode(models.CodeManagerShutDown)
+	systemErr.AddContext("lot_id", lotID)
+	return systemErr
+}
@@ -0,0 +1,302 @@
+package auction
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/clocktest"
+	"auction-bidding-algorithm/internal/models"
+)
+
+func TestAuctionManager_OpenAndPlaceBid(t *testing.T) {
+	manager := NewAuctionManager()
+	defer manager.Shutdown(context.Background())
+	baseTime := time.Now()
+
+	if _, err := manager.Open("lot-1", baseTime.Add(time.Hour)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := manager.Open("lot-1", baseTime.Add(time.Hour)); !models.HasCode(err, models.CodeManagerLotExists) {
+		t.Errorf("Expected opening the same lot twice to fail with %s, got: %v", models.CodeManagerLotExists, err)
+	}
+
+	if _, err := manager.PlaceBid("lot-1", liveBidder("alice", 100, 200, 10, baseTime, 0)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	status, err := manager.PlaceBid("lot-1", liveBidder("bob", 110, 150, 10, baseTime, 1))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if status.Leader.ID != "alice" || status.CurrentPrice.Float64() != 160 {
+		t.Errorf("Expected Alice to lead at 160.00, got %s at %s", status.Leader.ID, status.CurrentPrice)
+	}
+
+	if _, err := manager.RaiseMaxBid("lot-1", "bob", models.Dollars(300)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := manager.Retract("lot-1", "alice"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	status, err = manager.Status("lot-1")
+	if err != nil || status.Leader.ID != "bob" {
+		t.Errorf("Expected Bob to lead, got %+v (%v)", status, err)
+	}
+
+	_, err = manager.PlaceBid("lot-unknown", liveBidder("carol", 100, 200, 10, baseTime, 2))
+	if auctionErr, ok := err.(*models.AuctionError); !ok || auctionErr.Context["lot_id"] != "lot-unknown" {
+		t.Errorf("Expected AuctionError for unknown lot, got %v", err)
+	}
+	if !models.HasCode(err, models.CodeManagerLotNotOpen) {
+		t.Errorf("Expected %s, got: %v", models.CodeManagerLotNotOpen, err)
+	}
+}
+
+func TestAuctionManager_Close(t *testing.T) {
+	manager := NewAuctionManager()
+	defer manager.Shutdown(context.Background())
+	baseTime := time.Now()
+
+	_, _ = manager.Open("lot-1", baseTime.Add(time.Hour))
+	_, _ = manager.PlaceBid("lot-1", liveBidder("alice", 100, 200, 10, baseTime, 0))
+
+	result, err := manager.Close("lot-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner == nil || result.Winner.ID != "alice" {
+		t.Errorf("Expected Alice to win, got %+v", result.Winner)
+	}
+
+	stored, ok := manager.Result("lot-1")
+	if !ok || stored != result {
+		t.Error("Expected closed result to be retained")
+	}
+	if len(manager.OpenLots()) != 0 {
+		t.Errorf("Expected no open lots, got %v", manager.OpenLots())
+	}
+	if _, err := manager.PlaceBid("lot-1", liveBidder("bob", 100, 300, 10, baseTime, 1)); err == nil {
+		t.Error("Expected bids on a closed lot to fail")
+	}
+	if _, err := manager.Open("lot-1", baseTime.Add(time.Hour)); !models.HasCode(err, models.CodeManagerLotExists) {
+		t.Errorf("Expected reopening a closed lot to fail with %s, got: %v", models.CodeManagerLotExists, err)
+	}
+	if err := manager.Err("lot-1"); err != nil {
+		t.Errorf("Expected no settlement error, got: %v", err)
+	}
+}
+
+func TestAuctionManager_AutoClose(t *testing.T) {
+	// The fake clock runs a year behind the wall clock, so only fake time can close the lot
+	baseTime := time.Now().AddDate(-1, 0, 0)
+	clock := clocktest.NewFakeClock(baseTime)
+	manager := NewAuctionManagerWithService(NewAuctionService(WithClock(clock)))
+	defer manager.Shutdown(context.Background())
+
+	_, _ = manager.Open("lot-1", baseTime.Add(time.Hour))
+	_, _ = manager.PlaceBid("lot-1", liveBidder("alice", 100, 200, 10, baseTime, 0))
+
+	clock.Advance(59 * time.Minute)
+	if _, ok := manager.Result("lot-1"); ok {
+		t.Fatal("Expected the lot to stay open before its close time")
+	}
+
+	clock.Advance(time.Minute)
+	result, ok := manager.Result("lot-1")
+	if !ok {
+		t.Fatal("Expected lot to close automatically")
+	}
+	if result == nil || result.Winner == nil || result.Winner.ID != "alice" {
+		t.Errorf("Expected Alice to win the automatically closed lot, got %+v", result)
+	}
+	if clock.Pending() != 0 {
+		t.Errorf("Expected no pending timers, got %d", clock.Pending())
+	}
+}
+
+// TestAuctionManager_ConcurrentBids places bids from many goroutines across many lots and checks
+// each lot against DetermineWinner; run with -race to verify the locking
+func TestAuctionManager_AutoCloseRecordsSettlementError(t *testing.T) {
+	var buffer bytes.Buffer
+	baseTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := clocktest.NewFakeClock(baseTime)
+	engine := &MockBiddingEngine{result: &models.BidResult{}}
+	service := NewAuctionService(WithClock(clock), WithEngine(engine), WithLogger(slog.New(slog.NewTextHandler(&buffer, nil))))
+	manager := NewAuctionManagerWithService(service)
+	defer manager.Shutdown(context.Background())
+
+	_, _ = manager.Open("lot-1", baseTime.Add(time.Hour))
+	if _, err := manager.PlaceBid("lot-1", liveBidder("alice", 100, 200, 10, baseTime, 0)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// The engine fails once the lot comes to settle
+	engine.shouldError = true
+	engine.errorType = models.ErrorTypeSystem
+	engine.errorMsg = "engine down"
+	clock.Advance(time.Hour)
+
+	result, ok := manager.Result("lot-1")
+	if !ok || result != nil {
+		t.Fatalf("Expected the lot to be closed without a result, got %+v (%v)", result, ok)
+	}
+	if err := manager.Err("lot-1"); !errors.Is(err, models.ErrSystem) {
+		t.Errorf("Expected the settlement error to be kept, got: %v", err)
+	}
+	if !strings.Contains(buffer.String(), "automatic close failed") {
+		t.Errorf("Expected the failed close to be logged, got %q", buffer.String())
+	}
+}
+
+func TestAuctionManager_ConcurrentBids(t *testing.T) {
+	const lots = 20
+	const biddersPerLot = 25
+
+	service := NewAuctionService(WithStrategy(StrategyClosedForm))
+	manager := NewAuctionManagerWithService(service)
+	defer manager.Shutdown(context.Background())
+	baseTime := time.Now()
+
+	for lot := 0; lot < lots; lot++ {
+		if _, err := manager.Open(fmt.Sprintf("lot-%d", lot), baseTime.Add(time.Hour)); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for lot := 0; lot < lots; lot++ {
+		for i := 0; i < biddersPerLot; i++ {
+			wg.Add(1)
+			go func(lot, i int) {
+				defer wg.Done()
+				lotID := fmt.Sprintf("lot-%d", lot)
+				bidder := liveBidder(fmt.Sprintf("b%d", i), float64(100+i), float64(200+(i*37)%150), float64(1+i%7), baseTime, i)
+				if _, err := manager.PlaceBid(lotID, bidder); err != nil {
+					t.Errorf("%s: unexpected error: %v", lotID, err)
+				}
+				_, _ = manager.Status(lotID)
+			}(lot, i)
+		}
+	}
+	wg.Wait()
+
+	for lot := 0; lot < lots; lot++ {
+		lotID := fmt.Sprintf("lot-%d", lot)
+		result, err := manager.Close(lotID)
+		if err != nil {
+			t.Fatalf("%s: close failed: %v", lotID, err)
+		}
+		if result.TotalBidders != biddersPerLot {
+			t.Errorf("%s: expected %d bidders, got %d", lotID, biddersPerLot, result.TotalBidders)
+		}
+
+		bidders := make([]models.Bidder, biddersPerLot)
+		for i := range bidders {
+			bidders[i] = liveBidder(fmt.Sprintf("b%d", i), float64(100+i), float64(200+(i*37)%150), float64(1+i%7), baseTime, i)
+		}
+		expected, err := service.DetermineWinner(bidders)
+		if err != nil {
+			t.Fatalf("%s: DetermineWinner failed: %v", lotID, err)
+		}
+		if expected.Winner.ID != result.Winner.ID || !expected.WinningBid.Equal(result.WinningBid) {
+			t.Errorf("%s: expected %s at %s, got %s at %s", lotID, expected.Winner.ID, expected.WinningBid, result.Winner.ID, result.WinningBid)
+		}
+	}
+}
+
+func TestAuctionManager_Shutdown(t *testing.T) {
+	baseTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := clocktest.NewFakeClock(baseTime)
+	manager := NewAuctionManagerWithService(NewAuctionService(WithClock(clock)))
+	_, _ = manager.Open("lot-1", baseTime.Add(time.Minute))
+
+	if err := manager.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	_, err := manager.PlaceBid("lot-1", liveBidder("alice", 100, 200, 10, baseTime, 0))
+	if _, ok := err.(*models.SystemError); !ok || !models.HasCode(err, models.CodeManagerShutDown) {
+		t.Errorf("Expected SystemError with %s after shutdown, got %T: %v", models.CodeManagerShutDown, err, err)
+	}
+	if _, err := manager.Open("lot-2", baseTime.Add(time.Hour)); err == nil {
+		t.Error("Expected Open to fail after shutdown")
+	}
+
+	// The automatic close was cancelled, so the lot stays open
+	clock.Advance(time.Hour)
+	if _, ok := manager.Result("lot-1"); ok {
+		t.Error("Expected automatic close to be cancelled by shutdown")
+	}
+	if len(manager.OpenLots()) != 1 {
+		t.Errorf("Expected lot to remain open, got %v", manager.OpenLots())
+	}
+}
+
+func TestAuctionManager_ShutdownWaitsForPendingOperations(t *testing.T) {
+	manager := NewAuctionManager()
+	_, _ = manager.Open("lot-1", time.Now().Add(time.Hour))
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	go func() {
+		_ = manager.withAuction("lot-1", "Test", func(*Auction) error {
+			close(entered)
+			<-release
+			return nil
+		})
+	}()
+	<-entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := manager.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected shutdown to time out while an operation is pending, got %v", err)
+	}
+
+	close(release)
+	if err := manager.Shutdown(context.Background()); err != nil {
+		t.Errorf("Expected shutdown to drain once the operation finished, got %v", err)
+	}
+}
+
+func TestAuctionManager_AutoCloseFollowsSoftCloseExtension(t *testing.T) {
+	baseTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := clocktest.NewFakeClock(baseTime)
+	config := models.NewAuctionConfigWithSoftClose(models.NewSoftClosePolicy(time.Minute, 5*time.Minute))
+	manager := NewAuctionManagerWithService(NewAuctionService(WithConfig(config), WithClock(clock)))
+	defer manager.Shutdown(context.Background())
+
+	_, _ = manager.Open("lot-1", baseTime.Add(10*time.Minute))
+	clock.Advance(9*time.Minute + 30*time.Second)
+	_, _ = manager.PlaceBid("lot-1", liveBidder("alice", 100, 200, 10, baseTime, 0))
+
+	// The original close time passes, but the extension keeps the lot open
+	clock.Advance(time.Minute)
+	if _, ok := manager.Result("lot-1"); ok {
+		t.Fatal("Expected the extended lot to still be open")
+	}
+
+	var closeTime time.Time
+	_ = manager.withAuction("lot-1", "Test", func(auction *Auction) error {
+		closeTime = auction.CloseTime()
+		return nil
+	})
+	if !closeTime.After(baseTime.Add(10 * time.Minute)) {
+		t.Fatalf("Expected the close time to be extended, got %v", closeTime)
+	}
+
+	clock.Set(closeTime)
+	result, ok := manager.Result("lot-1")
+	if !ok {
+		t.Fatal("Expected extended lot to close automatically")
+	}
+	if result == nil || len(result.Extensions) != 1 {
+		t.Errorf("Expected one recorded extension, got %+v", result)
+	}
+}
@@ -0,0 +1,77 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// MultiUnitBiddingEngine is a BiddingEngine that can also sell several identical units
+// The built-in engine implements it; DetermineAllocation requires it
+type MultiUnitBiddingEngine interface {
+	BiddingEngine
+	AllocateUnitsContext(ctx context.Context, bidders []models.Bidder) (*models.MultiUnitResult, error)
+}
+
+// DetermineAllocation validates the bids and sells the identical units described by the service's
+// configuration, set up with models.NewAuctionConfigWithUnits for a uniform price or
+// models.NewAuctionConfigWithPayAsBid for pay-as-bid pricing. Each bidder asks for Quantity units at up
+// to MaxBid per unit; the result lists every winner with the units won and the amount owed
+func (as *AuctionService) DetermineAllocation(bidders []models.Bidder) (*models.MultiUnitResult, error) {
+	return as.DetermineAllocationContext(context.Background(), bidders)
+}
+
+// DetermineAllocationContext sells identical units like DetermineAllocation, stopping when the context ends
+func (as *AuctionService) DetermineAllocationContext(ctx context.Context, bidders []models.Bidder) (*models.MultiUnitResult, error) {
+	result, err := as.determineAllocation(ctx, bidders)
+	if err != nil {
+		return nil, models.AddRequestContext(ctx, err)
+	}
+	return result, nil
+}
+
+// determineAllocation runs the validation and allocation pipeline for DetermineAllocationContext
+func (as *AuctionService) determineAllocation(ctx context.Context, bidders []models.Bidder) (*models.MultiUnitResult, error) {
+	engine, ok := as.engine.(MultiUnitBiddingEngine)
+	if !ok {
+		auctionErr := models.NewAuctionError(models.ErrorTypeProcessing, "bidding engine does not support multi-unit auctions", nil)
+		auctionErr.WithOperation("DetermineAllocation").WithCode(models.CodeEngineFormatUnsupported)
+		auctionErr.AddContext("service", "AuctionService")
+		return nil, auctionErr
+	}
+
+	bidders = as.ingest(bidders)
+	warnings, err := as.checkBidders(bidders, "DetermineAllocation.Validation")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := engine.AllocateUnitsContext(ctx, bidders)
+	if err != nil {
+		as.warn("unit allocation failed", "bidders", len(bidders), "error", err)
+		var auctionErr *models.AuctionError
+		if errors.As(err, &auctionErr) {
+			auctionErr.WithOperation("DetermineAllocation.Processing")
+			auctionErr.AddContext("service", "AuctionService")
+			return nil, err
+		}
+		wrappedErr := models.NewAuctionErrorWithCause(models.ErrorTypeProcessing, "unexpected processing error", err)
+		wrappedErr.WithOperation("DetermineAllocation.Processing").WithCode(models.CodeServiceUnexpectedError)
+		wrappedErr.AddContext("service", "AuctionService")
+		return nil, wrappedErr
+	}
+	if result == nil {
+		processingErr := models.NewAuctionError(models.ErrorTypeProcessing, "failed to allocate units: result is nil", nil)
+		processingErr.WithOperation("DetermineAllocation.ResultValidation").WithCode(models.CodeServiceNilResult)
+		processingErr.AddContext("service", "AuctionService")
+		processingErr.AddContext("bidder_count", fmt.Sprintf("%d", len(bidders)))
+		return nil, processingErr
+	}
+
+	if len(warnings) > 0 {
+		result.Warnings = warnings
+	}
+	return result, nil
+}
@@ -0,0 +1,136 @@
+package auction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// ticketBids returns sealed per-unit bids for concert tickets, entered a second apart
+func ticketBids() []models.Bidder {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	bid := func(id string, perUnit float64, quantity, offset int) models.Bidder {
+		return models.Bidder{
+			ID:          id,
+			Name:        "Bidder " + id,
+			StartingBid: models.Dollars(perUnit),
+			MaxBid:      models.Dollars(perUnit),
+			Quantity:    quantity,
+			EntryTime:   base.Add(time.Duration(offset) * time.Second),
+		}
+	}
+	return []models.Bidder{bid("alice", 120, 20, 0), bid("bob", 95, 25, 1), bid("carol", 90, 10, 2), bid("dave", 80, 10, 3)}
+}
+
+func TestAuctionService_DetermineAllocation(t *testing.T) {
+	tests := []struct {
+		name          string
+		multiUnit     models.MultiUnitConfig
+		expectedUnits map[string]int
+		expectedPrice models.Money
+	}{
+		{"highest losing bid", models.MultiUnitConfig{Units: 50}, map[string]int{"alice": 20, "bob": 25}, models.Dollars(90)},
+		{"lowest winning bid", models.MultiUnitConfig{Units: 50, Clearing: models.ClearingLowestWinning}, map[string]int{"alice": 20, "bob": 25}, models.Dollars(95)},
+		{"partial fill", models.MultiUnitConfig{Units: 50, PartialFills: true}, map[string]int{"alice": 20, "bob": 25, "carol": 5}, models.Dollars(90)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewAuctionService(WithConfig(models.NewAuctionConfigWithUnits(tt.multiUnit)))
+			result, err := service.DetermineAllocation(ticketBids())
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if len(result.Allocations) != len(tt.expectedUnits) {
+				t.Fatalf("Expected %d winners, got %+v", len(tt.expectedUnits), result.Allocations)
+			}
+			for id, units := range tt.expectedUnits {
+				allocation, ok := result.Allocation(id)
+				if !ok || allocation.Units != units {
+					t.Errorf("Expected %s to win %d units, got %+v", id, units, allocation)
+					continue
+				}
+				owed, _ := tt.expectedPrice.Mul(int64(units))
+				if !allocation.Amount.Equal(owed) {
+					t.Errorf("Expected %s to owe %s, got %s", id, owed, allocation.Amount)
+				}
+			}
+			if !result.ClearingPrice.Equal(tt.expectedPrice) {
+				t.Errorf("Expected clearing price %s, got %s", tt.expectedPrice, result.ClearingPrice)
+			}
+		})
+	}
+}
+
+func TestAuctionService_DetermineAllocationPayAsBid(t *testing.T) {
+	// No bidder may win more than 15 tickets, leaving room for Carol and Dave at their own prices
+	multiUnit := models.MultiUnitConfig{Units: 50, PartialFills: true, MaxUnitsPerBidder: 15}
+	service := NewAuctionService(WithConfig(models.NewAuctionConfigWithPayAsBid(multiUnit)))
+	result, err := service.DetermineAllocation(ticketBids())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := map[string]models.Money{
+		"alice": models.Dollars(1800),
+		"bob":   models.Dollars(1425),
+		"carol": models.Dollars(900),
+		"dave":  models.Dollars(800),
+	}
+	revenue := result.RevenueByWinner()
+	if len(revenue) != len(expected) {
+		t.Fatalf("Expected %d winners, got %v", len(expected), revenue)
+	}
+	for id, owed := range expected {
+		if !revenue[id].Equal(owed) {
+			t.Errorf("Expected %s to owe %s, got %s", id, owed, revenue[id])
+		}
+	}
+	if allocation, _ := result.Allocation("alice"); allocation.Units != 15 || !allocation.IsPartial() {
+		t.Errorf("Expected Alice to be held to 15 of 20 tickets, got %+v", allocation)
+	}
+	if !result.Revenue.Equal(models.Dollars(4925)) || result.UnitsSold != 50 {
+		t.Errorf("Expected 50 tickets sold for 4925.00, got %d for %s", result.UnitsSold, result.Revenue)
+	}
+	if result.Format != models.FormatPayAsBid || !result.ClearingPrice.Equal(models.Dollars(80)) {
+		t.Errorf("Expected a pay-as-bid result stopping at 80.00, got %s at %s", result.Format, result.ClearingPrice)
+	}
+}
+
+func TestAuctionService_DetermineAllocationValidation(t *testing.T) {
+	service := NewAuctionService(WithConfig(models.NewAuctionConfigWithUnits(models.MultiUnitConfig{Units: 50})))
+	bidders := ticketBids()
+	bidders[1].Quantity = -3
+
+	_, err := service.DetermineAllocation(bidders)
+	if !errors.Is(err, models.ErrValidation) || !models.HasCode(err, models.CodeBidQuantityNegative) {
+		t.Fatalf("Expected a validation error with code %s, got: %v", models.CodeBidQuantityNegative, err)
+	}
+	var auctionErr *models.AuctionError
+	if errors.As(err, &auctionErr) && auctionErr.Operation != "DetermineAllocation.Validation" {
+		t.Errorf("Expected operation DetermineAllocation.Validation, got %s", auctionErr.Operation)
+	}
+
+	ctx := models.WithTraceID(context.Background(), "trace-9")
+	_, err = NewAuctionService().DetermineAllocationContext(ctx, ticketBids())
+	if !models.HasCode(err, models.CodeBidIncrementNotPositive) {
+		t.Errorf("Expected single-unit services to require an increment, got: %v", err)
+	}
+	if errors.As(err, &auctionErr) {
+		if traceID, _ := auctionErr.GetContext("trace_id"); traceID != "trace-9" {
+			t.Errorf("Expected the trace ID in the error context, got %q", traceID)
+		}
+	}
+}
+
+func TestAuctionService_DetermineAllocationUnsupportedEngine(t *testing.T) {
+	service := NewAuctionService(WithEngine(&MockBiddingEngine{}))
+
+	_, err := service.DetermineAllocation(ticketBids())
+	if !errors.Is(err, models.ErrProcessing) || !models.HasCode(err, models.CodeEngineFormatUnsupported) {
+		t.Errorf("Expected a processing error with code %s, got: %v", models.CodeEngineFormatUnsupported, err)
+	}
+}
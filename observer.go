@@ -0,0 +1,97 @@
+package auction
+
+import (
+	"fmt"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// Observer event names, reported with observer failures
+const (
+	EventValidationFailed = "validation_failed"
+	EventRoundCompleted   = "round_completed"
+	EventLeaderChanged    = "leader_changed"
+	EventBidderExhausted  = "bidder_exhausted"
+	EventAuctionResolved  = "auction_resolved"
+)
+
+// AuctionObserver is notified as an AuctionService settles an auction
+// Observers receive copies of the auction state, run synchronously on the goroutine calling
+// DetermineWinner, and cannot affect the result: returned errors and panics are contained
+// and reported to the service's logger and observer error handler
+type AuctionObserver interface {
+	// OnValidationFailed is called with the error DetermineWinner or a live auction's Close is about to return for invalid bids
+	OnValidationFailed(err error) error
+	// OnRoundCompleted is called after each bidding round with the bidders' state at its end
+	OnRoundCompleted(round int, bidders []models.Bidder) error
+	// OnLeaderChanged is called when an increment gives the lead to a different bidder
+	OnLeaderChanged(change models.AuditEntry) error
+	// OnBidderExhausted is called when a bidder reaches its maximum bid and stops bidding (IsActive false)
+	OnBidderExhausted(round int, bidder models.Bidder) error
+	// OnAuctionResolved is called with the result DetermineWinner or a live auction's Close is about to return
+	OnAuctionResolved(result *models.BidResult) error
+}
+
+// BaseObserver implements every AuctionObserver callback as a no-op
+// Embed it to implement only the callbacks of interest
+type BaseObserver struct{}
+
+func (BaseObserver) OnValidationFailed(err error) error                        { return nil }
+func (BaseObserver) OnRoundCompleted(round int, bidders []models.Bidder) error { return nil }
+func (BaseObserver) OnLeaderChanged(change models.AuditEntry) error            { return nil }
+func (BaseObserver) OnBidderExhausted(round int, bidder models.Bidder) error   { return nil }
+func (BaseObserver) OnAuctionResolved(result *models.BidResult) error          { return nil }
+
+// ObserverErrorHandler receives the errors and recovered panics of observers
+type ObserverErrorHandler func(event string, err error)
+
+// notify calls fn for every observer, isolating the service from their errors and panics
+func (as *AuctionService) notify(event string, fn func(observer AuctionObserver) error) {
+	for _, observer := range as.observers {
+		err := as.callObserver(event, observer, fn)
+		if err == nil {
+			continue
+		}
+		as.warn("observer failed", "event", event, "observer", fmt.Sprintf("%T", observer), "error", err)
+		if as.observerErrors != nil {
+			as.observerErrors(event, err)
+		}
+	}
+}
+
+// callObserver runs one observer callback and converts a panic into an error
+func (as *AuctionService) callObserver(event string, observer AuctionObserver, fn func(observer AuctionObserver) error) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			systemErr := models.NewSystemError(fmt.Sprintf("observer panicked: %v", recovered), "AuctionObserver", "medium")
+			systemErr.WithOperation("AuctionService.notify").WithCode(models.CodeServiceObserverPanic)
+			systemErr.AddContext("event", event)
+			systemErr.AddContext("observer", fmt.Sprintf("%T", observer))
+			err = systemErr
+		}
+	}()
+	return fn(observer)
+}
+
+// engineEvents forwards the bidding engine's progress to the service's observers
+type engineEvents struct {
+	service *AuctionService
+}
+
+func (ee engineEvents) RoundCompleted(round int, bidders []models.Bidder) {
+	ee.service.notify(EventRoundCompleted, func(observer AuctionObserver) error {
+		return observer.OnRoundCompleted(round, append([]models.Bidder(nil), bidders...))
+	})
+}
+
+func (ee engineEvents) LeaderChanged(entry models.AuditEntry) {
+	ee.service.notify(EventLeaderChanged, func(observer AuctionObserver) error {
+		return observer.OnLeaderChanged(entry)
+	})
+}
+
+func (ee engineEvents) BidderExhausted(round int, bidder models.Bidder) {
+	ee.service.notify(EventBidderExhausted, func(observer AuctionObserver) error {
+		return observer.OnBidderExhausted(round, bidder)
+	})
+}
@@ -0,0 +1,252 @@
+package auction
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/models"
+)
+
+// recordingObserver records the events it receives
+type recordingObserver struct {
+	validationFailures int
+	rounds             []int
+	leaders            []string
+	exhausted          []string
+	resolved           *models.BidResult
+}
+
+func (ro *recordingObserver) OnValidationFailed(err error) error {
+	ro.validationFailures++
+	return nil
+}
+
+func (ro *recordingObserver) OnRoundCompleted(round int, bidders []models.Bidder) error {
+	ro.rounds = append(ro.rounds, round)
+	return nil
+}
+
+func (ro *recordingObserver) OnLeaderChanged(change models.AuditEntry) error {
+	ro.leaders = append(ro.leaders, change.LeaderID)
+	return nil
+}
+
+func (ro *recordingObserver) OnBidderExhausted(round int, bidder models.Bidder) error {
+	ro.exhausted = append(ro.exhausted, bidder.ID)
+	return nil
+}
+
+func (ro *recordingObserver) OnAuctionResolved(result *models.BidResult) error {
+	ro.resolved = result
+	return nil
+}
+
+// vandalObserver panics on some events, fails others and scribbles over everything it is given
+type vandalObserver struct {
+	BaseObserver
+}
+
+func (vandalObserver) OnValidationFailed(err error) error {
+	var auctionErr *models.AuctionError
+	if errors.As(err, &auctionErr) {
+		auctionErr.Message = "all good"
+		auctionErr.AddContext("service", "vandal")
+		for _, detail := range auctionErr.Details {
+			detail.Message = "ignore me"
+		}
+	}
+	return nil
+}
+
+func (vandalObserver) OnRoundCompleted(round int, bidders []models.Bidder) error {
+	for i := range bidders {
+		bidders[i].CurrentBid = models.Dollars(1_000_000)
+	}
+	panic("boom")
+}
+
+func (vandalObserver) OnLeaderChanged(change models.AuditEntry) error {
+	return errors.New("notification service unavailable")
+}
+
+func (vandalObserver) OnAuctionResolved(result *models.BidResult) error {
+	result.Winner.ID = "mallory"
+	result.WinningBid = models.Dollars(0.01)
+	panic("boom")
+}
+
+// observedBidders returns an auction Bob wins after Alice and Carol run out of room
+func observedBidders() []models.Bidder {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	return []models.Bidder{
+		{ID: "alice", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: base},
+		{ID: "bob", Name: "Bob", StartingBid: models.Dollars(105), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10), EntryTime: base.Add(time.Second)},
+		{ID: "carol", Name: "Carol", StartingBid: models.Dollars(100), MaxBid: models.Dollars(120), AutoIncrement: models.Dollars(10), EntryTime: base.Add(2 * time.Second)},
+	}
+}
+
+func TestAuctionService_ObserverEvents(t *testing.T) {
+	observer := &recordingObserver{}
+	service := NewAuctionService(WithObserver(observer))
+
+	result, err := service.DetermineWinner(observedBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(observer.rounds) != result.BiddingRounds {
+		t.Errorf("Expected %d round events, got %v", result.BiddingRounds, observer.rounds)
+	}
+	for i, round := range observer.rounds {
+		if round != i+1 {
+			t.Errorf("Expected rounds numbered from 1, got %v", observer.rounds)
+			break
+		}
+	}
+	if len(observer.leaders) != 10 || observer.leaders[len(observer.leaders)-1] != "bob" {
+		t.Errorf("Expected 10 leader changes ending with Bob, got %v", observer.leaders)
+	}
+	// Carol reaches 120 and Alice 150, their maximum bids
+	if len(observer.exhausted) != 2 || observer.exhausted[0] != "carol" || observer.exhausted[1] != "alice" {
+		t.Errorf("Expected Carol then Alice to be exhausted, got %v", observer.exhausted)
+	}
+	if observer.resolved == nil || observer.resolved.Winner.ID != "bob" || observer.resolved == result {
+		t.Errorf("Expected a copy of the result with Bob winning, got %+v", observer.resolved)
+	}
+	if observer.validationFailures != 0 {
+		t.Errorf("Expected no validation failures, got %d", observer.validationFailures)
+	}
+
+	// Invalid bids only report the validation failure
+	invalid := observedBidders()
+	invalid[0].MaxBid = models.Dollars(50)
+	if _, err := service.DetermineWinner(invalid); err == nil {
+		t.Fatal("Expected a validation error")
+	}
+	if observer.validationFailures != 1 {
+		t.Errorf("Expected one validation failure, got %d", observer.validationFailures)
+	}
+}
+
+// TestAuctionService_ObserverIsolation tests that failing and panicking observers neither change
+// the result nor stop other observers from being notified
+func TestAuctionService_ObserverIsolation(t *testing.T) {
+	expected, err := NewAuctionService().DetermineWinner(observedBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	failures := make(map[string]int)
+	recorder := &recordingObserver{}
+	service := NewAuctionService(
+		WithObserver(vandalObserver{}),
+		WithObserver(recorder),
+		WithObserverErrorHandler(func(event string, err error) {
+			failures[event]++
+		}),
+	)
+
+	result, err := service.DetermineWinner(observedBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != expected.Winner.ID || !result.WinningBid.Equal(expected.WinningBid) || result.BiddingRounds != expected.BiddingRounds {
+		t.Errorf("Expected observers not to affect the result, got %s at %s", result.Winner.ID, result.WinningBid)
+	}
+	if recorder.resolved == nil || len(recorder.rounds) != expected.BiddingRounds {
+		t.Error("Expected later observers to still be notified")
+	}
+
+	if failures[EventRoundCompleted] != expected.BiddingRounds || failures[EventLeaderChanged] != 10 || failures[EventAuctionResolved] != 1 {
+		t.Errorf("Expected every failure to reach the handler, got %v", failures)
+	}
+}
+
+// TestAuction_ObserversHearOnlyClose tests that a live auction's provisional settlements are not
+// reported to observers and that Close reports the final result once, with its extensions
+func TestAuction_ObserversHearOnlyClose(t *testing.T) {
+	recorder := &recordingObserver{}
+	service := NewAuctionService(WithObserver(recorder))
+	baseTime := time.Now()
+	auction := NewAuctionWithService("lot-1", baseTime.Add(time.Hour), service)
+
+	for _, bidder := range observedBidders() {
+		if _, err := auction.PlaceBid(bidder); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+	invalid := liveBidder("dave", 300, 150, 10, baseTime, 3)
+	if _, err := auction.PlaceBid(invalid); err == nil {
+		t.Fatal("Expected the invalid bid to be rejected")
+	}
+	if _, err := auction.Retract("carol"); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if recorder.validationFailures != 0 || len(recorder.rounds) != 0 || len(recorder.leaders) != 0 || recorder.resolved != nil {
+		t.Fatalf("Expected no events while the auction is open, got %+v", recorder)
+	}
+
+	result, err := auction.Close()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if recorder.resolved == nil || recorder.resolved.Winner.ID != result.Winner.ID || len(recorder.rounds) != result.BiddingRounds {
+		t.Errorf("Expected Close to report the final settlement, got %+v", recorder)
+	}
+
+	recorder.resolved = nil
+	_, _ = auction.Close()
+	if recorder.resolved != nil {
+		t.Error("Expected a repeated Close not to report the result again")
+	}
+}
+
+// TestAuctionService_ObserverGetsValidationErrorCopy tests that an observer changing the validation
+// error it is given does not change the error returned to the caller
+func TestAuctionService_ObserverGetsValidationErrorCopy(t *testing.T) {
+	invalid := observedBidders()
+	invalid[0].MaxBid = models.Dollars(50)
+	_, expected := NewAuctionService().DetermineWinner(invalid)
+	_, err := NewAuctionService(WithObserver(vandalObserver{})).DetermineWinner(invalid)
+	if err == nil || expected == nil {
+		t.Fatal("Expected a validation error")
+	}
+
+	var expectedErr, auctionErr *models.AuctionError
+	if !errors.As(expected, &expectedErr) || !errors.As(err, &auctionErr) {
+		t.Fatalf("Expected AuctionErrors, got %T and %T", expected, err)
+	}
+	if auctionErr.Message != expectedErr.Message || auctionErr.Context["service"] != "AuctionService" {
+		t.Errorf("Expected the observer not to change the error, got %q with context %v", auctionErr.Message, auctionErr.Context)
+	}
+	if len(auctionErr.Details) == 0 || auctionErr.Details[0].Message != expectedErr.Details[0].Message {
+		t.Errorf("Expected the observer not to change the details, got %+v", auctionErr.Details)
+	}
+}
+
+func TestAuctionService_ObserverPanicBecomesSystemError(t *testing.T) {
+	var reported error
+	service := NewAuctionService(
+		WithObserver(vandalObserver{}),
+		WithObserverErrorHandler(func(event string, err error) {
+			if event == EventAuctionResolved {
+				reported = err
+			}
+		}),
+	)
+	if _, err := service.DetermineWinner(observedBidders()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	systemErr, ok := reported.(*models.SystemError)
+	if !ok {
+		t.Fatalf("Expected a SystemError, got %T", reported)
+	}
+	if event, _ := systemErr.GetContext("event"); event != EventAuctionResolved {
+		t.Errorf("Expected event context %q, got %q", EventAuctionResolved, event)
+	}
+	if systemErr.Code != models.CodeServiceObserverPanic {
+		t.Errorf("Expected code %s, got %q", models.CodeServiceObserverPanic, systemErr.Code)
+	}
+}
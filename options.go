@@ -0,0 +1,118 @@
+package auction
+
+import (
+	"log/slog"
+	"time"
+
+	"auction-bidding-algorithm/internal"
+	"auction-bidding-algorithm/internal/models"
+	"auction-bidding-algorithm/internal/validation"
+)
+
+// ServiceOption configures an AuctionService created by NewAuctionService
+// Options that tune the engine (round limit, deadline, strategy, tie-breaker, audit, format) apply to
+// the built-in engine and have no effect when a custom engine is supplied with WithEngine
+type ServiceOption func(*AuctionService)
+
+// WithValidator replaces the default bid validator
+func WithValidator(validator validation.BidValidator) ServiceOption {
+	return func(as *AuctionService) {
+		as.validator = validator
+	}
+}
+
+// WithRules runs a rule set on top of the default validator; warning rules are reported in BidResult.Warnings
+// Policy-loaded time rules read the service clock; the set itself is left unchanged
+// It has no effect when a custom validator is supplied with WithValidator (wrap it in validation.NewRuleValidator instead)
+func WithRules(rules *validation.RuleSet) ServiceOption {
+	return func(as *AuctionService) {
+		as.rules = rules
+	}
+}
+
+// WithEngine replaces the built-in bidding engine
+func WithEngine(engine BiddingEngine) ServiceOption {
+	return func(as *AuctionService) {
+		as.engine = engine
+	}
+}
+
+// WithConfig applies lot-level settings to the default validator, the built-in engine and live auctions
+func WithConfig(config models.AuctionConfig) ServiceOption {
+	return func(as *AuctionService) {
+		as.config = config
+	}
+}
+
+// WithClock sets the clock the built-in engine and live auctions read the current time from
+func WithClock(clock models.Clock) ServiceOption {
+	return func(as *AuctionService) {
+		as.clock = clock
+	}
+}
+
+// WithLogger sets the logger the service and the built-in engine report to
+func WithLogger(logger *slog.Logger) ServiceOption {
+	return func(as *AuctionService) {
+		as.logger = logger
+	}
+}
+
+// WithFormat settles auctions under the given format and records its name in the configuration, so the
+// default validator accepts bids without an auto-increment when the format does not raise bids
+// A format can also be selected by name with models.AuctionConfig.Format
+func WithFormat(format Format) ServiceOption {
+	return func(as *AuctionService) {
+		as.format = format
+	}
+}
+
+// WithMaxRounds sets the iterative strategy's round limit; values below 1 keep the default of 1000
+func WithMaxRounds(maxRounds int) ServiceOption {
+	return withEngineOption(internal.WithMaxRounds(maxRounds))
+}
+
+// WithDeadline limits how long the engine may spend settling one auction in real time, whatever clock
+// the service is given; 0 means no limit
+func WithDeadline(deadline time.Duration) ServiceOption {
+	return withEngineOption(internal.WithDeadline(deadline))
+}
+
+// WithStrategy sets how the engine settles proxy bids
+func WithStrategy(strategy ResolutionStrategy) ServiceOption {
+	return withEngineOption(internal.WithStrategy(strategy))
+}
+
+// WithTieBreaker sets how the engine settles ties at the top
+func WithTieBreaker(tieBreaker models.TieBreaker) ServiceOption {
+	return withEngineOption(internal.WithTieBreaker(tieBreaker))
+}
+
+// WithAudit records an audit trail of increments in each result and, when sink is not nil, streams entries to it
+func WithAudit(mode models.AuditMode, sink models.AuditSink) ServiceOption {
+	return withEngineOption(internal.WithAudit(mode, sink))
+}
+
+// WithObserver registers an observer; observers are notified in registration order
+// Round, leader and exhaustion events come from the built-in engine
+func WithObserver(observer AuctionObserver) ServiceOption {
+	return func(as *AuctionService) {
+		if observer != nil {
+			as.observers = append(as.observers, observer)
+		}
+	}
+}
+
+// WithObserverErrorHandler sets the handler for observer errors and panics, which are otherwise only logged
+func WithObserverErrorHandler(handler ObserverErrorHandler) ServiceOption {
+	return func(as *AuctionService) {
+		as.observerErrors = handler
+	}
+}
+
+// withEngineOption defers an option to the built-in engine
+func withEngineOption(option internal.EngineOption) ServiceOption {
+	return func(as *AuctionService) {
+		as.engineOptions = append(as.engineOptions, option)
+	}
+}
@@ -0,0 +1,261 @@
+package auction
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"auction-bidding-algorithm/internal/clocktest"
+	"auction-bidding-algorithm/internal/models"
+	"auction-bidding-algorithm/internal/validation"
+)
+
+// longBidders returns two bidders whose tiny increments need thousands of rounds
+func longBidders() []models.Bidder {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	return []models.Bidder{
+		{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(1.00), MaxBid: models.Dollars(500.00), AutoIncrement: models.Dollars(0.02), EntryTime: base},
+		{ID: "bidder2", Name: "Bob", StartingBid: models.Dollars(1.01), MaxBid: models.Dollars(499.00), AutoIncrement: models.Dollars(0.02), EntryTime: base.Add(time.Second)},
+	}
+}
+
+func TestNewAuctionService_CustomValidatorAndEngine(t *testing.T) {
+	validator := &MockValidator{shouldReturnError: true}
+	service := NewAuctionService(WithValidator(validator))
+	if _, err := service.DetermineWinner(observedBidders()); err == nil {
+		t.Error("Expected the custom validator to reject the bids")
+	}
+
+	engine := &MockEngine{}
+	service = NewAuctionService(WithEngine(engine), WithMaxRounds(1))
+	result, err := service.DetermineWinner(observedBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	// The mock engine awards the first bidder at their starting bid
+	if result.Winner.ID != "alice" || !result.WinningBid.Equal(models.Dollars(100)) {
+		t.Errorf("Expected the custom engine's result, got %s at %s", result.Winner.ID, result.WinningBid)
+	}
+}
+
+func TestNewAuctionService_MaxRounds(t *testing.T) {
+	if _, err := NewAuctionService().DetermineWinner(longBidders()); err == nil {
+		t.Fatal("Expected the default round limit to be exceeded")
+	}
+
+	result, err := NewAuctionService(WithMaxRounds(100000)).DetermineWinner(longBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != "bidder1" {
+		t.Errorf("Expected Alice to win, got %s", result.Winner.Name)
+	}
+}
+
+func TestNewAuctionService_Deadline(t *testing.T) {
+	// The fake clock jumps a second every round, but the deadline is measured in real time
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	observer := &advancingObserver{clock: clock, step: time.Second}
+	service := NewAuctionService(WithClock(clock), WithMaxRounds(100000), WithDeadline(time.Minute), WithObserver(observer))
+	if _, err := service.DetermineWinner(longBidders()); err != nil {
+		t.Fatalf("Expected the fake clock not to count against the deadline, got: %v", err)
+	}
+
+	observer.stall = 2 * time.Millisecond
+	service = NewAuctionService(WithClock(clock), WithMaxRounds(100000), WithDeadline(time.Millisecond), WithObserver(observer))
+	_, err := service.DetermineWinner(longBidders())
+	var timeoutErr *models.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("Expected a TimeoutError, got %T: %v", err, err)
+	}
+	if timeoutErr.TimeoutDuration != "1ms" {
+		t.Errorf("Expected a one millisecond deadline, got %s", timeoutErr.TimeoutDuration)
+	}
+}
+
+// advancingObserver moves a fake clock forward after every round, optionally stalling in real time
+type advancingObserver struct {
+	BaseObserver
+	clock *clocktest.FakeClock
+	step  time.Duration
+	stall time.Duration
+}
+
+func (ao *advancingObserver) OnRoundCompleted(round int, bidders []models.Bidder) error {
+	ao.clock.Advance(ao.step)
+	time.Sleep(ao.stall)
+	return nil
+}
+
+func TestNewAuctionService_Options(t *testing.T) {
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	config := models.NewAuctionConfigWithReserve(50000)
+	service := NewAuctionService(WithConfig(config), WithClock(clock), WithStrategy(StrategyClosedForm), WithTieBreaker(models.NewHighestMaxBidTieBreaker()))
+
+	if service.Clock() != clock || service.Config().ReservePriceCents != 50000 {
+		t.Error("Expected the service to keep its clock and config")
+	}
+
+	// Bob's higher maximum wins the tie; the reserve is above every maximum
+	bidders := []models.Bidder{
+		{ID: "bidder1", Name: "Alice", StartingBid: models.Dollars(100), MaxBid: models.Dollars(150), AutoIncrement: models.Dollars(10), EntryTime: clock.Now()},
+		{ID: "bidder2", Name: "Bob", StartingBid: models.Dollars(100), MaxBid: models.Dollars(200), AutoIncrement: models.Dollars(10), EntryTime: clock.Now().Add(time.Second)},
+	}
+	result, err := service.DetermineWinner(bidders)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.ReserveMet || result.TieBreak != models.TieBreakHighestMaxBid {
+		t.Errorf("Expected an unmet reserve after a max-bid tie-break, got reserve met %v by %q", result.ReserveMet, result.TieBreak)
+	}
+}
+
+func TestNewAuctionService_Logger(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buffer, nil))
+	service := NewAuctionService(WithLogger(logger), WithObserver(vandalObserver{}))
+
+	if _, err := service.DetermineWinner(observedBidders()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(buffer.String(), "observer failed") {
+		t.Errorf("Expected observer failures to be logged, got %q", buffer.String())
+	}
+
+	buffer.Reset()
+	invalid := observedBidders()
+	invalid[0].MaxBid = models.Dollars(50)
+	if _, err := service.DetermineWinner(invalid); err == nil {
+		t.Fatal("Expected a validation error")
+	}
+	if !strings.Contains(buffer.String(), "bid validation failed") {
+		t.Errorf("Expected the validation failure to be logged, got %q", buffer.String())
+	}
+}
+
+func TestNewAuctionService_Rules(t *testing.T) {
+	rules := validation.NewRuleSet().
+		Add(validation.NewMaxBidCapRule(models.Dollars(180)), validation.SeverityWarning).
+		Add(validation.NewMinOpeningBidRule(models.Dollars(100)), validation.SeverityError)
+	service := NewAuctionService(WithRules(rules))
+
+	// Bob's maximum is above the cap, which is only a warning
+	result, err := service.DetermineWinner(observedBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != "bob" {
+		t.Errorf("Expected Bob to win, got %s", result.Winner.ID)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].BidderID != "bob" || result.Warnings[0].Code != models.CodeBidMaxAboveCap {
+		t.Errorf("Expected one max bid warning for Bob, got %v", result.Warnings)
+	}
+
+	// Carol's opening bid below the minimum is an error
+	invalid := observedBidders()
+	invalid[2].StartingBid = models.Dollars(90)
+	_, err = service.DetermineWinner(invalid)
+	if !errors.Is(err, models.ErrValidation) || !models.HasCode(err, models.CodeBidStartBelowMinimum) {
+		t.Errorf("Expected a %s validation error, got: %v", models.CodeBidStartBelowMinimum, err)
+	}
+
+	// Without rules the result carries no warnings
+	result, err = NewAuctionService().DetermineWinner(observedBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Warnings != nil {
+		t.Errorf("Expected no warnings, got %v", result.Warnings)
+	}
+}
+
+func TestNewAuctionService_AuctionRules(t *testing.T) {
+	policy := validation.NewRuleSet().
+		AddAuctionRule(validation.NewDuplicateNameRule(), validation.SeverityError).
+		AddAuctionRule(validation.NewOpeningPriceRule(models.Dollars(250)), validation.SeverityError)
+
+	// No maximum bid reaches the opening price of this lot
+	_, err := NewAuctionService(WithRules(policy)).DetermineWinner(observedBidders())
+	if !models.HasCode(err, models.CodeAuctionBelowOpeningPrice) {
+		t.Errorf("Expected %s, got: %v", models.CodeAuctionBelowOpeningPrice, err)
+	}
+
+	// Another auction switches the opening price check off
+	result, err := NewAuctionService(WithRules(policy.Without(validation.RuleOpeningPrice))).DetermineWinner(observedBidders())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Winner.ID != "bob" {
+		t.Errorf("Expected Bob to win, got %s", result.Winner.ID)
+	}
+}
+
+func TestNewAuctionService_Format(t *testing.T) {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	sealed := []models.Bidder{
+		{ID: "alice", Name: "Alice", StartingBid: models.Dollars(150), MaxBid: models.Dollars(150), EntryTime: base},
+		{ID: "bob", Name: "Bob", StartingBid: models.Dollars(180), MaxBid: models.Dollars(180), EntryTime: base.Add(time.Second)},
+		{ID: "carol", Name: "Carol", StartingBid: models.Dollars(120), MaxBid: models.Dollars(120), EntryTime: base.Add(2 * time.Second)},
+	}
+
+	vickrey, err := NewVickreyFormat(models.SecondPriceExact)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		service       *AuctionService
+		format        models.AuctionFormat
+		expectedPrice models.Money
+	}{
+		{"format option", NewAuctionService(WithFormat(NewFirstPriceSealedFormat())), models.FormatFirstPriceSealed, models.Dollars(180)},
+		{"format named in config", NewAuctionService(WithConfig(models.NewAuctionConfigWithFormat(models.FormatFirstPriceSealed))), models.FormatFirstPriceSealed, models.Dollars(180)},
+		{"vickrey", NewAuctionService(WithFormat(vickrey)), models.FormatVickrey, models.Dollars(150)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.service.Config().Format != tt.format {
+				t.Errorf("Expected the config to name the format, got %q", tt.service.Config().Format)
+			}
+			result, err := tt.service.DetermineWinner(sealed)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if result.Winner.ID != "bob" || !result.WinningBid.Equal(tt.expectedPrice) {
+				t.Errorf("Expected Bob to win at %s, got %s at %s", tt.expectedPrice, result.Winner.ID, result.WinningBid)
+			}
+			if result.Format != tt.format {
+				t.Errorf("Expected the result to record the format, got %q", result.Format)
+			}
+		})
+	}
+
+	// English auctions still require an auto-increment
+	if _, err := NewAuctionService().DetermineWinner(sealed); !models.HasCode(err, models.CodeBidIncrementNotPositive) {
+		t.Errorf("Expected English auctions to reject bids without an increment, got: %v", err)
+	}
+}
+
+func TestNewAuctionService_PolicyReadsServiceClock(t *testing.T) {
+	policy, err := validation.ParsePolicy([]byte(`{"rules": [{"rule": "entry_time"}]}`), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Every bid is entered after the service clock's current time
+	clock := clocktest.NewFakeClock(time.Date(2024, 6, 1, 11, 0, 0, 0, time.UTC))
+	_, err = NewAuctionService(WithClock(clock), WithRules(policy)).DetermineWinner(observedBidders())
+	if !models.HasCode(err, models.CodeBidEntryInFuture) {
+		t.Errorf("Expected %s, got: %v", models.CodeBidEntryInFuture, err)
+	}
+
+	clock.Set(time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC))
+	if _, err := NewAuctionService(WithClock(clock), WithRules(policy)).DetermineWinner(observedBidders()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+}